@@ -53,6 +53,7 @@ type PlatformRelease struct {
 	ToolDependencies        ToolDependencies
 	DiscoveryDependencies   DiscoveryDependencies
 	MonitorDependencies     MonitorDependencies
+	PlatformDependencies    PlatformDependencies
 	Help                    PlatformReleaseHelp           `json:"-"`
 	Platform                *Platform                     `json:"-"`
 	Properties              *properties.Map               `json:"-"`
@@ -170,6 +171,33 @@ func (d *MonitorDependency) String() string {
 	return fmt.Sprintf("%s:%s", d.Packager, d.Name)
 }
 
+// PlatformDependencies is a list of PlatformDependency
+type PlatformDependencies []*PlatformDependency
+
+// PlatformDependency identifies a platform required by another platform, a nil
+// VersionConstraint is satisfied by any release
+type PlatformDependency struct {
+	Packager          string
+	Architecture      string
+	VersionConstraint semver.Constraint
+}
+
+// Match returns true if the PlatformRelease satisfies the dependency
+func (d *PlatformDependency) Match(release *PlatformRelease) bool {
+	if release.Platform.Package.Name != d.Packager || release.Platform.Architecture != d.Architecture {
+		return false
+	}
+	return d.VersionConstraint == nil || d.VersionConstraint.Match(release.Version)
+}
+
+func (d *PlatformDependency) String() string {
+	res := d.Packager + ":" + d.Architecture
+	if d.VersionConstraint != nil && d.VersionConstraint.String() != "" {
+		res += " (" + d.VersionConstraint.String() + ")"
+	}
+	return res
+}
+
 // GetOrCreateRelease returns the specified release corresponding the provided version,
 // or creates a new one if not found.
 func (platform *Platform) GetOrCreateRelease(version *semver.Version) *PlatformRelease {
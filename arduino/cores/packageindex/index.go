@@ -62,6 +62,7 @@ type indexPlatformRelease struct {
 	ToolDependencies      []indexToolDependency      `json:"toolsDependencies"`
 	DiscoveryDependencies []indexDiscoveryDependency `json:"discoveryDependencies"`
 	MonitorDependencies   []indexMonitorDependency   `json:"monitorDependencies"`
+	PlatformDependencies  []indexPlatformDependency  `json:"platformDependencies"`
 }
 
 // indexToolDependency represents a single dependency of a core from a tool.
@@ -83,6 +84,14 @@ type indexMonitorDependency struct {
 	Name     string `json:"name"`
 }
 
+// indexPlatformDependency represents a single dependency of a core from another core.
+// The version is a constraint, like ">=1.8.0", an empty version matches any release.
+type indexPlatformDependency struct {
+	Packager     string `json:"packager,required"`
+	Architecture string `json:"architecture,required"`
+	Version      string `json:"version,omitempty"`
+}
+
 // indexToolRelease represents a single Tool from package_index.json file.
 type indexToolRelease struct {
 	Name    string                    `json:"name,required"`
@@ -167,6 +176,18 @@ func IndexFromPlatformRelease(pr *cores.PlatformRelease) Index {
 		})
 	}
 
+	platforms := []indexPlatformDependency{}
+	for _, p := range pr.PlatformDependencies {
+		dep := indexPlatformDependency{
+			Packager:     p.Packager,
+			Architecture: p.Architecture,
+		}
+		if p.VersionConstraint != nil {
+			dep.Version = p.VersionConstraint.String()
+		}
+		platforms = append(platforms, dep)
+	}
+
 	packageTools := []*indexToolRelease{}
 	for name, tool := range pr.Platform.Package.Tools {
 		for _, toolRelease := range tool.Releases {
@@ -212,6 +233,7 @@ func IndexFromPlatformRelease(pr *cores.PlatformRelease) Index {
 					ToolDependencies:      tools,
 					DiscoveryDependencies: discoveries,
 					MonitorDependencies:   monitors,
+					PlatformDependencies:  platforms,
 				}},
 				Tools: packageTools,
 				Help:  indexHelp{Online: pr.Platform.Package.Help.Online},
@@ -254,6 +276,10 @@ func (inPlatformRelease indexPlatformRelease) extractPlatformIn(outPackage *core
 	if err != nil {
 		return fmt.Errorf(tr("invalid platform archive size: %s"), err)
 	}
+	platformDependencies, err := inPlatformRelease.extractPlatformDependencies()
+	if err != nil {
+		return err
+	}
 	outPlatformRelease := outPlatform.GetOrCreateRelease(inPlatformRelease.Version)
 	outPlatformRelease.IsTrusted = trusted
 	outPlatformRelease.Resource = &resources.DownloadResource{
@@ -268,6 +294,7 @@ func (inPlatformRelease indexPlatformRelease) extractPlatformIn(outPackage *core
 	outPlatformRelease.ToolDependencies = inPlatformRelease.extractToolDependencies()
 	outPlatformRelease.DiscoveryDependencies = inPlatformRelease.extractDiscoveryDependencies()
 	outPlatformRelease.MonitorDependencies = inPlatformRelease.extractMonitorDependencies()
+	outPlatformRelease.PlatformDependencies = platformDependencies
 	return nil
 }
 
@@ -305,6 +332,23 @@ func (inPlatformRelease indexPlatformRelease) extractMonitorDependencies() cores
 	return res
 }
 
+func (inPlatformRelease indexPlatformRelease) extractPlatformDependencies() (cores.PlatformDependencies, error) {
+	res := make(cores.PlatformDependencies, len(inPlatformRelease.PlatformDependencies))
+	for i, platform := range inPlatformRelease.PlatformDependencies {
+		constraint, err := semver.ParseConstraint(platform.Version)
+		if err != nil {
+			return nil, fmt.Errorf(tr("invalid version constraint %[1]s for platform dependency %[2]s:%[3]s: %[4]s"),
+				platform.Version, platform.Packager, platform.Architecture, err)
+		}
+		res[i] = &cores.PlatformDependency{
+			Packager:          platform.Packager,
+			Architecture:      platform.Architecture,
+			VersionConstraint: constraint,
+		}
+	}
+	return res, nil
+}
+
 func (inPlatformRelease indexPlatformRelease) extractBoardsManifest() []*cores.BoardManifest {
 	boards := make([]*cores.BoardManifest, len(inPlatformRelease.Boards))
 	for i, board := range inPlatformRelease.Boards {
@@ -347,6 +391,13 @@ func (inToolRelease indexToolRelease) extractFlavours() []*cores.Flavor {
 
 // LoadIndex reads a package_index.json from a file and returns the corresponding Index structure.
 func LoadIndex(jsonIndexFile *paths.Path) (*Index, error) {
+	return LoadIndexWithKeyring(jsonIndexFile, nil)
+}
+
+// LoadIndexWithKeyring reads a package_index.json from a file and returns the
+// corresponding Index structure. The index is trusted if it's signed with the
+// Arduino key or with one of the keys of the keyring, that may be nil.
+func LoadIndexWithKeyring(jsonIndexFile *paths.Path, keyring *security.Keyring) (*Index, error) {
 	buff, err := jsonIndexFile.ReadFile()
 	if err != nil {
 		return nil, err
@@ -358,7 +409,7 @@ func LoadIndex(jsonIndexFile *paths.Path) (*Index, error) {
 	}
 
 	jsonSignatureFile := jsonIndexFile.Parent().Join(jsonIndexFile.Base() + ".sig")
-	trusted, _, err := security.VerifyArduinoDetachedSignature(jsonIndexFile, jsonSignatureFile)
+	trusted, _, err := keyring.VerifyDetachedSignature(jsonIndexFile, jsonSignatureFile)
 	if err != nil {
 		logrus.
 			WithField("index", jsonIndexFile).
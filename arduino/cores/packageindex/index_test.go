@@ -91,6 +91,17 @@ func TestIndexFromPlatformRelease(t *testing.T) {
 				Name:     "serial-monitor",
 			},
 		},
+		PlatformDependencies: cores.PlatformDependencies{
+			{
+				Packager:          "arduino",
+				Architecture:      "samd",
+				VersionConstraint: &semver.GreaterThanOrEqual{Version: semver.MustParse("1.8.0")},
+			},
+			{
+				Packager:     "arduino",
+				Architecture: "sam",
+			},
+		},
 		Platform: &cores.Platform{
 			Name:         "Arduino AVR Boards",
 			Architecture: "avr",
@@ -375,6 +386,17 @@ func TestIndexFromPlatformRelease(t *testing.T) {
 						Name:     "serial-monitor",
 					},
 				},
+				PlatformDependencies: []indexPlatformDependency{
+					{
+						Packager:     "arduino",
+						Architecture: "samd",
+						Version:      ">=1.8.0",
+					},
+					{
+						Packager:     "arduino",
+						Architecture: "sam",
+					},
+				},
 			}},
 			Tools: []*indexToolRelease{
 				{
@@ -573,6 +595,7 @@ func TestIndexFromPlatformRelease(t *testing.T) {
 			require.ElementsMatch(t, expectedPlatform.ToolDependencies, indexPlatform.ToolDependencies)
 			require.ElementsMatch(t, expectedPlatform.DiscoveryDependencies, indexPlatform.DiscoveryDependencies)
 			require.ElementsMatch(t, expectedPlatform.MonitorDependencies, indexPlatform.MonitorDependencies)
+			require.ElementsMatch(t, expectedPlatform.PlatformDependencies, indexPlatform.PlatformDependencies)
 		}
 	}
 }
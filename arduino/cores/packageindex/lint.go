@@ -0,0 +1,517 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package packageindex
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/arduino/arduino-cli/arduino/cores"
+	"github.com/arduino/arduino-cli/arduino/lint"
+	"github.com/arduino/arduino-cli/arduino/resources"
+	paths "github.com/arduino/go-paths-helper"
+	semver "go.bug.st/relaxed-semver"
+)
+
+// Lint checks the package index in the given file against the package index
+// specification and returns the problems found. The archives referenced by
+// the index are not downloaded, see Index.Archives.
+func Lint(indexFile *paths.Path) (lint.Findings, error) {
+	buff, err := indexFile.ReadFile()
+	if err != nil {
+		return nil, err
+	}
+	findings := lint.Findings{}
+
+	decoder := json.NewDecoder(bytes.NewReader(buff))
+	decoder.UseNumber()
+	var raw interface{}
+	if err := decoder.Decode(&raw); err != nil {
+		finding := &lint.Finding{
+			Level:   lint.Error,
+			RuleID:  "invalid-json",
+			Message: tr("invalid JSON: %s", err),
+			File:    indexFile,
+		}
+		var syntaxErr *json.SyntaxError
+		if errors.As(err, &syntaxErr) {
+			finding.Line = 1 + bytes.Count(buff[:syntaxErr.Offset], []byte("\n"))
+		}
+		return append(findings, finding), nil
+	}
+	lintSchema(indexFile, "", raw, indexSchema, &findings)
+
+	var index Index
+	if err := json.Unmarshal(buff, &index); err != nil {
+		if !findings.HasErrors() {
+			findings.Add(lint.Error, "invalid-index", indexFile, tr("loading package index: %s"), err)
+		}
+		return findings, nil
+	}
+	lintIndex(indexFile, &index, &findings)
+	return findings, nil
+}
+
+// jsonKind is the type of a value in the package index schema
+type jsonKind int
+
+const (
+	jsonString jsonKind = iota
+	jsonBool
+	jsonObject
+	jsonArray
+	// jsonSize is a non-negative integer, either as a number or as a string
+	jsonSize
+)
+
+func (k jsonKind) String() string {
+	switch k {
+	case jsonString:
+		return "string"
+	case jsonBool:
+		return "boolean"
+	case jsonObject:
+		return "object"
+	case jsonArray:
+		return "array"
+	case jsonSize:
+		return "size"
+	}
+	return "unknown"
+}
+
+// schemaField describes the expected content of a value of the package index
+type schemaField struct {
+	kind     jsonKind
+	required bool
+	// fields are the fields of a jsonObject
+	fields map[string]*schemaField
+	// items is the schema of the elements of a jsonArray
+	items *schemaField
+	// check is an additional validation of a jsonString
+	check func(string) error
+}
+
+var checksumFormat = regexp.MustCompile(`^(SHA-256:[0-9a-fA-F]{64}|SHA-1:[0-9a-fA-F]{40}|MD5:[0-9a-fA-F]{32})$`)
+
+func checkChecksum(checksum string) error {
+	if !checksumFormat.MatchString(checksum) {
+		return errors.New(tr("invalid checksum %s, the expected format is ALGORITHM:HEX where ALGORITHM is one of SHA-256, SHA-1 or MD5", checksum))
+	}
+	return nil
+}
+
+func checkURL(u string) error {
+	parsed, err := url.Parse(u)
+	if err != nil {
+		return err
+	}
+	if parsed.Scheme != "http" && parsed.Scheme != "https" {
+		return errors.New(tr("invalid URL %s, only http and https are supported", u))
+	}
+	return nil
+}
+
+func checkStrictVersion(version string) error {
+	_, err := semver.Parse(version)
+	return err
+}
+
+func checkConstraint(constraint string) error {
+	_, err := semver.ParseConstraint(constraint)
+	return err
+}
+
+func checkNotEmpty(s string) error {
+	if strings.TrimSpace(s) == "" {
+		return errors.New(tr("the value is empty"))
+	}
+	return nil
+}
+
+func arrayOf(required bool, fields map[string]*schemaField) *schemaField {
+	return &schemaField{kind: jsonArray, required: required, items: &schemaField{kind: jsonObject, fields: fields}}
+}
+
+var helpSchema = &schemaField{kind: jsonObject, fields: map[string]*schemaField{
+	"online": {kind: jsonString, check: checkURL},
+}}
+
+var pluggableDependencySchema = map[string]*schemaField{
+	"packager": {kind: jsonString, required: true, check: checkNotEmpty},
+	"name":     {kind: jsonString, required: true, check: checkNotEmpty},
+}
+
+var platformSchema = map[string]*schemaField{
+	"name":            {kind: jsonString, required: true, check: checkNotEmpty},
+	"architecture":    {kind: jsonString, required: true, check: checkNotEmpty},
+	"version":         {kind: jsonString, required: true, check: checkStrictVersion},
+	"deprecated":      {kind: jsonBool},
+	"category":        {kind: jsonString},
+	"url":             {kind: jsonString, required: true, check: checkURL},
+	"archiveFileName": {kind: jsonString, required: true, check: checkNotEmpty},
+	"checksum":        {kind: jsonString, required: true, check: checkChecksum},
+	"size":            {kind: jsonSize, required: true},
+	"boards": arrayOf(false, map[string]*schemaField{
+		"name": {kind: jsonString, required: true},
+		"id": arrayOf(false, map[string]*schemaField{
+			"usb": {kind: jsonString},
+		}),
+	}),
+	"help": helpSchema,
+	"toolsDependencies": arrayOf(false, map[string]*schemaField{
+		"packager": {kind: jsonString, required: true, check: checkNotEmpty},
+		"name":     {kind: jsonString, required: true, check: checkNotEmpty},
+		"version":  {kind: jsonString, required: true, check: checkNotEmpty},
+	}),
+	"discoveryDependencies": arrayOf(false, pluggableDependencySchema),
+	"monitorDependencies":   arrayOf(false, pluggableDependencySchema),
+	"platformDependencies": arrayOf(false, map[string]*schemaField{
+		"packager":     {kind: jsonString, required: true, check: checkNotEmpty},
+		"architecture": {kind: jsonString, required: true, check: checkNotEmpty},
+		"version":      {kind: jsonString, check: checkConstraint},
+	}),
+}
+
+var toolSchema = map[string]*schemaField{
+	"name":    {kind: jsonString, required: true, check: checkNotEmpty},
+	"version": {kind: jsonString, required: true, check: checkNotEmpty},
+	"systems": arrayOf(true, map[string]*schemaField{
+		"host":            {kind: jsonString, required: true, check: checkNotEmpty},
+		"url":             {kind: jsonString, required: true, check: checkURL},
+		"archiveFileName": {kind: jsonString, required: true, check: checkNotEmpty},
+		"checksum":        {kind: jsonString, required: true, check: checkChecksum},
+		"size":            {kind: jsonSize, required: true},
+	}),
+}
+
+var indexSchema = &schemaField{kind: jsonObject, fields: map[string]*schemaField{
+	"packages": arrayOf(true, map[string]*schemaField{
+		"name":       {kind: jsonString, required: true, check: checkNotEmpty},
+		"maintainer": {kind: jsonString, required: true, check: checkNotEmpty},
+		"websiteURL": {kind: jsonString, check: checkURL},
+		"email":      {kind: jsonString},
+		"help":       helpSchema,
+		"platforms":  arrayOf(true, platformSchema),
+		"tools":      arrayOf(true, toolSchema),
+	}),
+}}
+
+// lintSchema checks the value found at the given JSON path against the schema
+func lintSchema(file *paths.Path, path string, value interface{}, schema *schemaField, findings *lint.Findings) {
+	location := path
+	if location == "" {
+		location = "/"
+	}
+	wrongType := func() {
+		findings.Add(lint.Error, "invalid-type", file, tr("%[1]s: expected a value of type %[2]s"), location, schema.kind)
+	}
+
+	switch schema.kind {
+	case jsonString:
+		s, ok := value.(string)
+		if !ok {
+			wrongType()
+			return
+		}
+		if s == "" && !schema.required {
+			return
+		}
+		if schema.check != nil {
+			if err := schema.check(s); err != nil {
+				findings.Add(lint.Error, "invalid-value", file, "%s: %s", location, err)
+			}
+		}
+
+	case jsonBool:
+		if _, ok := value.(bool); !ok {
+			wrongType()
+		}
+
+	case jsonSize:
+		var size json.Number
+		switch v := value.(type) {
+		case json.Number:
+			size = v
+		case string:
+			size = json.Number(v)
+		default:
+			wrongType()
+			return
+		}
+		if n, err := size.Int64(); err != nil || n < 0 {
+			findings.Add(lint.Error, "invalid-value", file, tr("%[1]s: invalid size %[2]s"), location, size)
+		}
+
+	case jsonArray:
+		items, ok := value.([]interface{})
+		if !ok {
+			wrongType()
+			return
+		}
+		for i, item := range items {
+			lintSchema(file, fmt.Sprintf("%s[%d]", path, i), item, schema.items, findings)
+		}
+
+	case jsonObject:
+		obj, ok := value.(map[string]interface{})
+		if !ok {
+			wrongType()
+			return
+		}
+		names := []string{}
+		for name := range schema.fields {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			if field := schema.fields[name]; field.required {
+				if _, ok := obj[name]; !ok {
+					findings.Add(lint.Error, "missing-field", file, tr("%[1]s: missing required field %[2]s"), location, name)
+				}
+			}
+		}
+
+		keys := []string{}
+		for key := range obj {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			fieldPath := path + "/" + key
+			if field, ok := schema.fields[key]; ok {
+				lintSchema(file, fieldPath, obj[key], field, findings)
+				continue
+			}
+			// encoding/json matches the field names ignoring the case
+			matched := false
+			for _, name := range names {
+				if strings.EqualFold(name, key) {
+					findings.Add(lint.Warning, "field-case", file, tr("%[1]s: the field should be spelled %[2]s"), fieldPath, name)
+					lintSchema(file, fieldPath, obj[key], schema.fields[name], findings)
+					matched = true
+				}
+			}
+			if !matched {
+				findings.Add(lint.Warning, "unknown-field", file, tr("%s: unknown field"), fieldPath)
+			}
+		}
+	}
+}
+
+// lintIndex checks the consistency of the releases of the index
+func lintIndex(file *paths.Path, index *Index, findings *lint.Findings) {
+	packages := map[string]*indexPackage{}
+	tools := map[string]*indexToolRelease{}
+	toolNames := map[string]bool{}
+	for _, pack := range index.Packages {
+		if _, ok := packages[pack.Name]; ok {
+			findings.Add(lint.Error, "duplicate-package", file, tr("package %s is defined more than once"), pack.Name)
+		}
+		packages[pack.Name] = pack
+
+		for _, tool := range pack.Tools {
+			id := pack.Name + ":" + tool.Name + "@" + tool.Version.String()
+			if _, ok := tools[id]; ok {
+				findings.Add(lint.Error, "duplicate-version", file, tr("tool %s is defined more than once"), id)
+			}
+			tools[id] = tool
+			toolNames[pack.Name+":"+tool.Name] = true
+			lintToolSystems(file, id, tool, findings)
+		}
+	}
+
+	// maps "PACKAGER:ARCH" => versions
+	platformVersions := map[string][]*semver.Version{}
+	for _, pack := range index.Packages {
+		for _, platform := range pack.Platforms {
+			id := pack.Name + ":" + platform.Architecture
+			platformVersions[id] = append(platformVersions[id], platform.Version)
+		}
+	}
+
+	for _, pack := range index.Packages {
+		platforms := map[string]bool{}
+		for _, platform := range pack.Platforms {
+			id := pack.Name + ":" + platform.Architecture + "@" + platform.Version.String()
+			if platforms[id] {
+				findings.Add(lint.Error, "duplicate-version", file, tr("platform %s is defined more than once"), id)
+			}
+			platforms[id] = true
+
+			// The tools of the platform must be available for all the hosts
+			// supported by at least one of them
+			deps := map[string]*indexToolRelease{}
+			hosts := map[string]bool{}
+			for _, dep := range platform.ToolDependencies {
+				depID := dep.Packager + ":" + dep.Name + "@" + dep.Version.String()
+				if _, ok := packages[dep.Packager]; !ok {
+					findings.Add(lint.Note, "external-dependency", file, tr("platform %[1]s: tool %[2]s is provided by another package index and has not been checked"), id, depID)
+					continue
+				}
+				tool, ok := tools[depID]
+				if !ok {
+					findings.Add(lint.Error, "missing-tool-dependency", file, tr("platform %[1]s: required tool %[2]s not found in the index"), id, depID)
+					continue
+				}
+				deps[depID] = tool
+				for host := range tool.hostIDs() {
+					hosts[host] = true
+				}
+			}
+			delete(hosts, "all")
+			for depID, tool := range deps {
+				release := &cores.ToolRelease{Flavors: tool.extractFlavours()}
+				for _, host := range sortedKeys(hosts) {
+					osArch := strings.SplitN(host, ",", 2)
+					if release.GetFlavourCompatibleWith(osArch[0], osArch[1]) == nil {
+						findings.Add(lint.Error, "missing-tool-flavor", file, tr("platform %[1]s: tool %[2]s is not available for %[3]s"), id, depID, host)
+					}
+				}
+			}
+
+			discoveries := []string{}
+			for _, dep := range platform.DiscoveryDependencies {
+				discoveries = append(discoveries, dep.Packager+":"+dep.Name)
+			}
+			lintPluggableDependencies(file, id, "discovery", discoveries, packages, toolNames, findings)
+			monitors := []string{}
+			for _, dep := range platform.MonitorDependencies {
+				monitors = append(monitors, dep.Packager+":"+dep.Name)
+			}
+			lintPluggableDependencies(file, id, "monitor", monitors, packages, toolNames, findings)
+			lintPlatformDependencies(file, id, platform, packages, platformVersions, findings)
+		}
+	}
+}
+
+// lintPlatformDependencies checks that the platforms required by a platform
+// have at least a release in the index satisfying the version constraint
+func lintPlatformDependencies(file *paths.Path, id string, platform *indexPlatformRelease, packages map[string]*indexPackage, platformVersions map[string][]*semver.Version, findings *lint.Findings) {
+	for _, dep := range platform.PlatformDependencies {
+		constraint, err := semver.ParseConstraint(dep.Version)
+		if err != nil {
+			// Already reported by the schema check
+			continue
+		}
+		depID := (&cores.PlatformDependency{Packager: dep.Packager, Architecture: dep.Architecture, VersionConstraint: constraint}).String()
+		if _, ok := packages[dep.Packager]; !ok {
+			findings.Add(lint.Note, "external-dependency", file, tr("platform %[1]s: platform %[2]s is provided by another package index and has not been checked"), id, depID)
+			continue
+		}
+		found := false
+		for _, version := range platformVersions[dep.Packager+":"+dep.Architecture] {
+			if constraint.Match(version) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			findings.Add(lint.Error, "missing-platform-dependency", file, tr("platform %[1]s: required platform %[2]s not found in the index"), id, depID)
+		}
+	}
+}
+
+func lintToolSystems(file *paths.Path, id string, tool *indexToolRelease, findings *lint.Findings) {
+	hosts := map[string]bool{}
+	for _, system := range tool.Systems {
+		if hosts[system.OS] {
+			findings.Add(lint.Error, "duplicate-host", file, tr("tool %[1]s: host %[2]s is defined more than once"), id, system.OS)
+		}
+		hosts[system.OS] = true
+		if cores.FlavorHostID(system.OS) == "" {
+			findings.Add(lint.Warning, "unknown-host", file, tr("tool %[1]s: host %[2]s is not recognized and will never be installed"), id, system.OS)
+		}
+	}
+}
+
+// lintPluggableDependencies checks that the given discoveries or monitors,
+// in the PACKAGER:NAME form, are tools of the index
+func lintPluggableDependencies(file *paths.Path, id, kind string, refs []string, packages map[string]*indexPackage, toolNames map[string]bool, findings *lint.Findings) {
+	for _, ref := range refs {
+		packager := strings.SplitN(ref, ":", 2)[0]
+		if _, ok := packages[packager]; !ok {
+			findings.Add(lint.Note, "external-dependency", file, tr("platform %[1]s: %[2]s %[3]s is provided by another package index and has not been checked"), id, kind, ref)
+			continue
+		}
+		if !toolNames[ref] {
+			findings.Add(lint.Error, "missing-"+kind+"-dependency", file, tr("platform %[1]s: required %[2]s %[3]s not found in the index"), id, kind, ref)
+		}
+	}
+}
+
+// hostIDs returns the set of "os,arch" pairs supported by the tool release
+func (inToolRelease indexToolRelease) hostIDs() map[string]bool {
+	res := map[string]bool{}
+	for _, system := range inToolRelease.Systems {
+		if id := cores.FlavorHostID(system.OS); id != "" {
+			res[id] = true
+		}
+	}
+	return res
+}
+
+func sortedKeys(m map[string]bool) []string {
+	res := []string{}
+	for k := range m {
+		res = append(res, k)
+	}
+	sort.Strings(res)
+	return res
+}
+
+// Archive is an archive referenced by a package index
+type Archive struct {
+	// Name is the platform or tool release the archive belongs to
+	Name     string
+	Resource *resources.DownloadResource
+}
+
+// Archives returns all the archives of the platforms and the tools of the index
+func (index Index) Archives() []*Archive {
+	res := []*Archive{}
+	for _, pack := range index.Packages {
+		for _, platform := range pack.Platforms {
+			size, _ := platform.Size.Int64()
+			res = append(res, &Archive{
+				Name: pack.Name + ":" + platform.Architecture + "@" + platform.Version.String(),
+				Resource: &resources.DownloadResource{
+					URL:             platform.URL,
+					ArchiveFileName: platform.ArchiveFileName,
+					Checksum:        platform.Checksum,
+					Size:            size,
+					CachePath:       "packages",
+				},
+			})
+		}
+		for _, tool := range pack.Tools {
+			name := pack.Name + ":" + tool.Name + "@" + tool.Version.String()
+			for _, flavor := range tool.extractFlavours() {
+				res = append(res, &Archive{
+					Name:     name + " (" + flavor.OS + ")",
+					Resource: flavor.Resource,
+				})
+			}
+		}
+	}
+	return res
+}
@@ -0,0 +1,85 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package packageindex
+
+import (
+	"testing"
+
+	"github.com/arduino/arduino-cli/arduino/lint"
+	paths "github.com/arduino/go-paths-helper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLint(t *testing.T) {
+	findings, err := Lint(paths.New("testdata", "lint", "package_lint_index.json"))
+	require.NoError(t, err)
+	messages := []string{}
+	for _, f := range findings {
+		messages = append(messages, string(f.Level)+" "+f.RuleID+" "+f.Message)
+	}
+	require.ElementsMatch(t, []string{
+		"error invalid-value /packages[0]/platforms[1]/checksum: invalid checksum SHA-256:1234, the expected format is ALGORITHM:HEX where ALGORITHM is one of SHA-256, SHA-1 or MD5",
+		"error invalid-value /packages[0]/platforms[1]/size: invalid size -1",
+		"warning unknown-field /packages[0]/platforms[1]/unsupported: unknown field",
+		"error missing-field /packages[0]/tools[1]/systems[2]: missing required field checksum",
+		"error invalid-value /packages[0]/tools[1]/systems[2]/url: invalid URL ftp://example.com/uploader.tar.bz2, only http and https are supported",
+		"error duplicate-host tool test:uploader@1.0.0: host x86_64-linux-gnu is defined more than once",
+		"warning unknown-host tool test:uploader@1.0.0: host x86_64-unknown-os is not recognized and will never be installed",
+		"error duplicate-version platform test:avr@1.0.0 is defined more than once",
+		"error missing-tool-dependency platform test:avr@1.0.0: required tool test:missing@1.0.0 not found in the index",
+		"note external-dependency platform test:avr@1.0.0: tool arduino:avrdude@6.3.0 is provided by another package index and has not been checked",
+		"error missing-tool-flavor platform test:avr@1.0.0: tool test:uploader@1.0.0 is not available for windows,386",
+		"error missing-discovery-dependency platform test:avr@1.0.0: required discovery test:nodiscovery not found in the index",
+		"error missing-monitor-dependency platform test:avr@1.0.0: required monitor test:nomonitor not found in the index",
+		"error invalid-value /packages[0]/platforms[0]/platformDependencies[3]/version: unexpected char at: ~1.0",
+		"error missing-platform-dependency platform test:avr@1.0.0: required platform test:avr (<1.0.0) not found in the index",
+		"error missing-platform-dependency platform test:avr@1.0.0: required platform test:sam not found in the index",
+		"note external-dependency platform test:avr@1.0.0: platform arduino:samd is provided by another package index and has not been checked",
+	}, messages)
+
+	findings, err = Lint(paths.New("testdata", "lint", "package_broken_index.json"))
+	require.NoError(t, err)
+	require.Len(t, findings, 1)
+	require.Equal(t, lint.Error, findings[0].Level)
+	require.Equal(t, "invalid-json", findings[0].RuleID)
+
+	// A real world index
+	findings, err = Lint(paths.New("testdata", "package_adafruit_index.json"))
+	require.NoError(t, err)
+	require.False(t, findings.HasErrors(), "%v", findings)
+
+	_, err = Lint(paths.New("testdata", "lint", "missing.json"))
+	require.Error(t, err)
+}
+
+func TestIndexArchives(t *testing.T) {
+	index, err := LoadIndexNoSign(paths.New("testdata", "lint", "package_lint_index.json"))
+	require.NoError(t, err)
+	names := []string{}
+	for _, archive := range index.Archives() {
+		names = append(names, archive.Name+" "+archive.Resource.ArchiveFileName)
+	}
+	require.Equal(t, []string{
+		"test:avr@1.0.0 avr-1.0.0.tar.bz2",
+		"test:avr@1.0.0 avr-1.0.0.tar.bz2",
+		"test:gcc@1.0.0 (x86_64-linux-gnu) gcc-linux.tar.bz2",
+		"test:gcc@1.0.0 (i686-mingw32) gcc-win.zip",
+		"test:uploader@1.0.0 (x86_64-linux-gnu) uploader.tar.bz2",
+		"test:uploader@1.0.0 (x86_64-linux-gnu) uploader.tar.bz2",
+		"test:uploader@1.0.0 (x86_64-unknown-os) uploader.tar.bz2",
+		"test:discovery@1.0.0 (all) discovery.zip",
+	}, names)
+}
@@ -0,0 +1,286 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package packagemanager
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/arduino/arduino-cli/arduino/cores"
+	paths "github.com/arduino/go-paths-helper"
+)
+
+// maxPlatformResolutionSteps bounds the number of times the selected platform
+// releases are revised, to fail in a reasonable time on pathological indexes
+const maxPlatformResolutionSteps = 100
+
+// PlatformDependencyConflictError is returned when the requirements on a
+// platform can't be satisfied at the same time
+type PlatformDependencyConflictError struct {
+	Platform     string
+	Requirements []string
+}
+
+func (e *PlatformDependencyConflictError) Error() string {
+	return tr("no release of platform %[1]s satisfies all the requirements:\n%[2]s",
+		e.Platform, "  "+strings.Join(e.Requirements, "\n  "))
+}
+
+// platformRequirement is a dependency declared by a platform release
+type platformRequirement struct {
+	dependency *cores.PlatformDependency
+	requiredBy *cores.PlatformRelease
+}
+
+func (r *platformRequirement) String() string {
+	return tr("%[1]s required by %[2]s", r.dependency, r.requiredBy)
+}
+
+func matchRequirements(release *cores.PlatformRelease, requirements []*platformRequirement) bool {
+	for _, req := range requirements {
+		if !req.dependency.Match(release) {
+			return false
+		}
+	}
+	return true
+}
+
+func newPlatformDependencyConflictError(platform *cores.Platform, requirements []*platformRequirement) error {
+	res := &PlatformDependencyConflictError{Platform: platform.String()}
+	for _, req := range requirements {
+		res.Requirements = append(res.Requirements, req.String())
+	}
+	return res
+}
+
+// resolvePlatformDependencies returns the releases of the platforms required,
+// directly or through other platforms, by the given release. The installed
+// release of a platform is kept if it satisfies all the requirements, otherwise
+// the latest release satisfying them is selected. The version ranges declared
+// by the installed platforms requiring the given release, or the selected
+// ones, must be satisfied too. Each platform in the result comes after the
+// platforms it requires.
+func (pm *PackageManager) resolvePlatformDependencies(release *cores.PlatformRelease) ([]*cores.PlatformRelease, error) {
+	selected := map[*cores.Platform]*cores.PlatformRelease{release.Platform: release}
+	for step := 0; step < maxPlatformResolutionSteps; step++ {
+		// Collect the requirements declared by the selected releases reachable
+		// from the given release
+		requirements := map[*cores.Platform][]*platformRequirement{}
+		required := []*cores.Platform{}
+		queue := []*cores.PlatformRelease{release}
+		for len(queue) > 0 {
+			current := queue[0]
+			queue = queue[1:]
+			for _, dep := range current.PlatformDependencies {
+				platform := pm.FindPlatform(&PlatformReference{Package: dep.Packager, PlatformArchitecture: dep.Architecture})
+				if platform == nil {
+					return nil, fmt.Errorf(tr("platform %[1]s, required by %[2]s, not found"), dep, current)
+				}
+				if _, visited := requirements[platform]; !visited && platform != release.Platform {
+					required = append(required, platform)
+					if next, ok := selected[platform]; ok {
+						queue = append(queue, next)
+					}
+				}
+				requirements[platform] = append(requirements[platform], &platformRequirement{dependency: dep, requiredBy: current})
+			}
+		}
+
+		// The installed platforms outside of the resolution must keep working
+		// with the releases being installed
+		resolved := map[*cores.Platform]bool{release.Platform: true}
+		for _, platform := range required {
+			resolved[platform] = true
+		}
+		for _, platform := range append([]*cores.Platform{release.Platform}, required...) {
+			requirements[platform] = append(requirements[platform], pm.installedDependentsRequirements(platform, resolved)...)
+		}
+
+		// A platform can't replace itself through a circular dependency
+		if !matchRequirements(release, requirements[release.Platform]) {
+			return nil, newPlatformDependencyConflictError(release.Platform, requirements[release.Platform])
+		}
+
+		changed := false
+		for _, platform := range required {
+			candidate := pm.selectPlatformRelease(platform, requirements[platform])
+			if candidate == nil {
+				return nil, newPlatformDependencyConflictError(platform, requirements[platform])
+			}
+			if selected[platform] != candidate {
+				selected[platform] = candidate
+				changed = true
+			}
+		}
+		if changed {
+			// The new selections may declare different requirements
+			continue
+		}
+
+		res := []*cores.PlatformRelease{}
+		added := map[*cores.Platform]bool{release.Platform: true}
+		var addDependencies func(*cores.PlatformRelease)
+		addDependencies = func(current *cores.PlatformRelease) {
+			for _, dep := range current.PlatformDependencies {
+				platform := pm.FindPlatform(&PlatformReference{Package: dep.Packager, PlatformArchitecture: dep.Architecture})
+				if added[platform] {
+					continue
+				}
+				added[platform] = true
+				addDependencies(selected[platform])
+				res = append(res, selected[platform])
+			}
+		}
+		addDependencies(release)
+		return res, nil
+	}
+	return nil, fmt.Errorf(tr("cannot find a stable set of releases for the platforms required by %s"), release)
+}
+
+// selectPlatformRelease returns the installed release of the platform if it
+// satisfies the requirements, otherwise the latest installable release that
+// satisfies them. If no release satisfies the requirements nil is returned.
+func (pm *PackageManager) selectPlatformRelease(platform *cores.Platform, requirements []*platformRequirement) *cores.PlatformRelease {
+	if installed := pm.GetInstalledPlatformRelease(platform); installed != nil && matchRequirements(installed, requirements) {
+		return installed
+	}
+	return latestPlatformRelease(platform, requirements)
+}
+
+// latestPlatformRelease returns the latest installable release of the
+// platform that satisfies the requirements, or nil if none satisfies them
+func latestPlatformRelease(platform *cores.Platform, requirements []*platformRequirement) *cores.PlatformRelease {
+	var res *cores.PlatformRelease
+	for _, candidate := range platform.GetAllReleases() {
+		if candidate.Resource == nil && !candidate.IsInstalled() {
+			continue
+		}
+		if !matchRequirements(candidate, requirements) {
+			continue
+		}
+		if res == nil || candidate.Version.GreaterThan(res.Version) {
+			res = candidate
+		}
+	}
+	return res
+}
+
+// FindLatestCompatiblePlatformRelease returns the latest installable release
+// of the platform that satisfies the version ranges declared by the installed
+// platforms requiring it, or nil if none satisfies them.
+func (pm *PackageManager) FindLatestCompatiblePlatformRelease(platform *cores.Platform) *cores.PlatformRelease {
+	return latestPlatformRelease(platform, pm.installedDependentsRequirements(platform, nil))
+}
+
+// installedDependentsRequirements returns the requirements on the platform
+// declared by the installed releases of the other platforms. The platforms in
+// skip are ignored, their requirements are computed from the releases being
+// installed.
+func (pm *PackageManager) installedDependentsRequirements(platform *cores.Platform, skip map[*cores.Platform]bool) []*platformRequirement {
+	res := []*platformRequirement{}
+	for _, dependent := range pm.InstalledPlatformReleasesRequiring(platform) {
+		if dependent.Platform == platform || skip[dependent.Platform] {
+			continue
+		}
+		for _, dep := range dependent.PlatformDependencies {
+			if dep.Packager == platform.Package.Name && dep.Architecture == platform.Architecture {
+				res = append(res, &platformRequirement{dependency: dep, requiredBy: dependent})
+			}
+		}
+	}
+	return res
+}
+
+// InstalledPlatformReleasesRequiring returns the installed platform releases
+// that declare a dependency on the given platform.
+func (pm *PackageManager) InstalledPlatformReleasesRequiring(platform *cores.Platform) []*cores.PlatformRelease {
+	res := []*cores.PlatformRelease{}
+	for _, targetPackage := range pm.Packages {
+		for _, other := range targetPackage.Platforms {
+			installed := pm.GetInstalledPlatformRelease(other)
+			if installed == nil {
+				continue
+			}
+			for _, dep := range installed.PlatformDependencies {
+				if dep.Packager == platform.Package.Name && dep.Architecture == platform.Architecture {
+					res = append(res, installed)
+					break
+				}
+			}
+		}
+	}
+	return res
+}
+
+// FindInstalledPlatformDependencies returns the installed releases of the
+// platforms required, directly or through other platforms, by the given
+// release. Each platform in the result comes after the platforms it requires.
+func (pm *PackageManager) FindInstalledPlatformDependencies(release *cores.PlatformRelease) []*cores.PlatformRelease {
+	res := []*cores.PlatformRelease{}
+	added := map[*cores.Platform]bool{release.Platform: true}
+	var addDependencies func(*cores.PlatformRelease)
+	addDependencies = func(current *cores.PlatformRelease) {
+		for _, dep := range current.PlatformDependencies {
+			platform := pm.FindPlatform(&PlatformReference{Package: dep.Packager, PlatformArchitecture: dep.Architecture})
+			if platform == nil || added[platform] {
+				continue
+			}
+			added[platform] = true
+			if installed := pm.GetInstalledPlatformRelease(platform); installed != nil {
+				addDependencies(installed)
+				res = append(res, installed)
+			}
+		}
+	}
+	addDependencies(release)
+	return res
+}
+
+// platformDependencyMarker returns the file that marks the platform as
+// installed only because required by other platforms. The folder is hidden to
+// not be loaded as a package.
+func (pm *PackageManager) platformDependencyMarker(platform *cores.Platform) *paths.Path {
+	return pm.PackagesDir.Join(".dependencies", platform.Package.Name, platform.Architecture)
+}
+
+// IsPlatformInstalledAsDependency returns true if the platform has been
+// installed automatically because required by another platform, and not
+// explicitly by the user.
+func (pm *PackageManager) IsPlatformInstalledAsDependency(platform *cores.Platform) bool {
+	if pm.PackagesDir == nil {
+		return false
+	}
+	return pm.platformDependencyMarker(platform).Exist()
+}
+
+// SetPlatformInstalledAsDependency records if the platform has been installed
+// automatically because required by another platform. Only the platforms
+// installed this way are removed together with the platforms requiring them.
+func (pm *PackageManager) SetPlatformInstalledAsDependency(platform *cores.Platform, asDependency bool) error {
+	marker := pm.platformDependencyMarker(platform)
+	if !asDependency {
+		if err := marker.RemoveAll(); err != nil {
+			return err
+		}
+		removeIfEmpty(marker.Parent())
+		removeIfEmpty(marker.Parent().Parent())
+		return nil
+	}
+	if err := marker.Parent().MkdirAll(); err != nil {
+		return err
+	}
+	return marker.WriteFile([]byte{})
+}
@@ -65,42 +65,64 @@ func (pm *PackageManager) FindPlatformRelease(ref *PlatformReference) *cores.Pla
 	return platformRelease
 }
 
-// FindPlatformReleaseDependencies takes a PlatformReference and returns a set of items to download and
-// a set of outputs for non existing platforms.
-func (pm *PackageManager) FindPlatformReleaseDependencies(item *PlatformReference) (*cores.PlatformRelease, []*cores.ToolRelease, error) {
+// FindPlatformReleaseDependencies takes a PlatformReference and returns the PlatformRelease, the
+// tools it requires and the releases of the platforms it requires, directly or through other
+// platforms. The required platforms are sorted so that each one comes after its own dependencies.
+func (pm *PackageManager) FindPlatformReleaseDependencies(item *PlatformReference) (*cores.PlatformRelease, []*cores.ToolRelease, []*cores.PlatformRelease, error) {
 	targetPackage, exists := pm.Packages[item.Package]
 	if !exists {
-		return nil, nil, fmt.Errorf(tr("package %s not found"), item.Package)
+		return nil, nil, nil, fmt.Errorf(tr("package %s not found"), item.Package)
 	}
 	platform, exists := targetPackage.Platforms[item.PlatformArchitecture]
 	if !exists {
-		return nil, nil, fmt.Errorf(tr("platform %[1]s not found in package %[2]s"), item.PlatformArchitecture, targetPackage.String())
+		return nil, nil, nil, fmt.Errorf(tr("platform %[1]s not found in package %[2]s"), item.PlatformArchitecture, targetPackage.String())
 	}
 
 	var release *cores.PlatformRelease
 	if item.PlatformVersion != nil {
 		release = platform.FindReleaseWithVersion(item.PlatformVersion)
 		if release == nil {
-			return nil, nil, fmt.Errorf(tr("required version %[1]s not found for platform %[2]s"), item.PlatformVersion, platform.String())
+			return nil, nil, nil, fmt.Errorf(tr("required version %[1]s not found for platform %[2]s"), item.PlatformVersion, platform.String())
 		}
 	} else {
-		release = platform.GetLatestRelease()
+		// Prefer the latest release compatible with the installed platforms
+		// requiring it, otherwise the resolution explains the conflict
+		release = pm.FindLatestCompatiblePlatformRelease(platform)
 		if release == nil {
-			return nil, nil, fmt.Errorf(tr("platform %s has no available releases"), platform.String())
+			release = platform.GetLatestRelease()
 		}
+		if release == nil {
+			return nil, nil, nil, fmt.Errorf(tr("platform %s has no available releases"), platform.String())
+		}
+	}
+
+	toolDeps, err := pm.FindPlatformReleaseTools(release)
+	if err != nil {
+		return nil, nil, nil, err
 	}
 
+	platformDeps, err := pm.resolvePlatformDependencies(release)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf(tr("getting platform dependencies for platform %[1]s: %[2]s"), release.String(), err)
+	}
+
+	return release, toolDeps, platformDeps, nil
+}
+
+// FindPlatformReleaseTools returns the tools, the pluggable discoveries and the pluggable
+// monitors required by the PlatformRelease.
+func (pm *PackageManager) FindPlatformReleaseTools(release *cores.PlatformRelease) ([]*cores.ToolRelease, error) {
 	// replaces "latest" with latest version too
 	toolDeps, err := pm.Packages.GetPlatformReleaseToolDependencies(release)
 	if err != nil {
-		return nil, nil, fmt.Errorf(tr("getting tool dependencies for platform %[1]s: %[2]s"), release.String(), err)
+		return nil, fmt.Errorf(tr("getting tool dependencies for platform %[1]s: %[2]s"), release.String(), err)
 	}
 
 	// discovery dependencies differ from normal tool since we always want to use the latest
 	// available version for the platform package
 	discoveryDependencies, err := pm.Packages.GetPlatformReleaseDiscoveryDependencies(release)
 	if err != nil {
-		return nil, nil, fmt.Errorf(tr("getting discovery dependencies for platform %[1]s: %[2]s"), release.String(), err)
+		return nil, fmt.Errorf(tr("getting discovery dependencies for platform %[1]s: %[2]s"), release.String(), err)
 	}
 	toolDeps = append(toolDeps, discoveryDependencies...)
 
@@ -108,11 +130,11 @@ func (pm *PackageManager) FindPlatformReleaseDependencies(item *PlatformReferenc
 	// available version for the platform package
 	monitorDependencies, err := pm.Packages.GetPlatformReleaseMonitorDependencies(release)
 	if err != nil {
-		return nil, nil, fmt.Errorf(tr("getting monitor dependencies for platform %[1]s: %[2]s"), release.String(), err)
+		return nil, fmt.Errorf(tr("getting monitor dependencies for platform %[1]s: %[2]s"), release.String(), err)
 	}
 	toolDeps = append(toolDeps, monitorDependencies...)
 
-	return release, toolDeps, nil
+	return toolDeps, nil
 }
 
 // DownloadToolRelease downloads a ToolRelease. If the tool is already downloaded a nil Downloader
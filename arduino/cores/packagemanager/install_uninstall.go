@@ -19,20 +19,19 @@ import (
 	"encoding/json"
 	"fmt"
 	"runtime"
+	"sort"
 
 	"github.com/arduino/arduino-cli/arduino/cores"
 	"github.com/arduino/arduino-cli/arduino/cores/packageindex"
+	"github.com/arduino/arduino-cli/arduino/resources"
 	"github.com/arduino/arduino-cli/executils"
+	paths "github.com/arduino/go-paths-helper"
 	"github.com/pkg/errors"
 )
 
 // InstallPlatform installs a specific release of a platform.
 func (pm *PackageManager) InstallPlatform(platformRelease *cores.PlatformRelease) error {
-	destDir := pm.PackagesDir.Join(
-		platformRelease.Platform.Package.Name,
-		"hardware",
-		platformRelease.Platform.Architecture,
-		platformRelease.Version.String())
+	destDir := pm.platformReleaseInstallDir(platformRelease)
 	if err := platformRelease.Resource.Install(pm.DownloadDir, pm.TempDir, destDir); err != nil {
 		return errors.Errorf(tr("installing platform %[1]s: %[2]s"), platformRelease, err)
 	}
@@ -47,6 +46,26 @@ func (pm *PackageManager) InstallPlatform(platformRelease *cores.PlatformRelease
 	return nil
 }
 
+// platformReleaseInstallDir returns the folder where the PlatformRelease is
+// installed by the PackageManager
+func (pm *PackageManager) platformReleaseInstallDir(platformRelease *cores.PlatformRelease) *paths.Path {
+	return pm.PackagesDir.Join(
+		platformRelease.Platform.Package.Name,
+		"hardware",
+		platformRelease.Platform.Architecture,
+		platformRelease.Version.String())
+}
+
+// toolReleaseInstallDir returns the folder where the ToolRelease is installed
+// by the PackageManager
+func (pm *PackageManager) toolReleaseInstallDir(toolRelease *cores.ToolRelease) *paths.Path {
+	return pm.PackagesDir.Join(
+		toolRelease.Tool.Package.Name,
+		"tools",
+		toolRelease.Tool.Name,
+		toolRelease.Version.String())
+}
+
 func (pm *PackageManager) cacheInstalledJSON(platformRelease *cores.PlatformRelease) error {
 	index := packageindex.IndexFromPlatformRelease(platformRelease)
 	platformJSON, err := json.MarshalIndent(index, "", "  ")
@@ -120,17 +139,23 @@ func (pm *PackageManager) UninstallPlatform(platformRelease *cores.PlatformRelea
 	return nil
 }
 
+// VerifyPlatform checks the files of an installed PlatformRelease against the
+// ones recorded when the platform has been installed.
+func (pm *PackageManager) VerifyPlatform(platformRelease *cores.PlatformRelease) (*resources.InstalledFilesReport, error) {
+	if platformRelease.InstallDir == nil {
+		return nil, fmt.Errorf(tr("platform not installed"))
+	}
+	// installed.json is written after the platform has been extracted
+	return resources.VerifyInstalledFiles(platformRelease.InstallDir, "installed.json")
+}
+
 // InstallTool installs a specific release of a tool.
 func (pm *PackageManager) InstallTool(toolRelease *cores.ToolRelease) error {
 	toolResource := toolRelease.GetCompatibleFlavour()
 	if toolResource == nil {
 		return fmt.Errorf(tr("no compatible version of %s tools found for the current os"), toolRelease.Tool.Name)
 	}
-	destDir := pm.PackagesDir.Join(
-		toolRelease.Tool.Package.Name,
-		"tools",
-		toolRelease.Tool.Name,
-		toolRelease.Version.String())
+	destDir := pm.toolReleaseInstallDir(toolRelease)
 	return toolResource.Install(pm.DownloadDir, pm.TempDir, destDir)
 }
 
@@ -172,6 +197,15 @@ func (pm *PackageManager) UninstallTool(toolRelease *cores.ToolRelease) error {
 	return nil
 }
 
+// VerifyTool checks the files of an installed ToolRelease against the ones
+// recorded when the tool has been installed.
+func (pm *PackageManager) VerifyTool(toolRelease *cores.ToolRelease) (*resources.InstalledFilesReport, error) {
+	if toolRelease.InstallDir == nil {
+		return nil, fmt.Errorf(tr("tool not installed"))
+	}
+	return resources.VerifyInstalledFiles(toolRelease.InstallDir)
+}
+
 // IsToolRequired returns true if any of the installed platforms requires the toolRelease
 // passed as parameter
 func (pm *PackageManager) IsToolRequired(toolRelease *cores.ToolRelease) bool {
@@ -187,3 +221,55 @@ func (pm *PackageManager) IsToolRequired(toolRelease *cores.ToolRelease) bool {
 	}
 	return false
 }
+
+// FindUnusedToolReleases returns the installed tool releases, managed by the
+// PackageManager, that are not required by any of the installed platforms.
+// The latest release of the builtin tools is always considered required, as
+// well as the latest installed release of every tool if a platform not
+// coming from a package index is installed (it may use any of them).
+func (pm *PackageManager) FindUnusedToolReleases() ([]*cores.ToolRelease, error) {
+	required := map[*cores.ToolRelease]bool{}
+	for _, platformRelease := range pm.InstalledPlatformReleases() {
+		if platformRelease.Resource == nil {
+			tools, err := pm.FindToolsRequiredFromPlatformRelease(platformRelease)
+			if err != nil {
+				return nil, fmt.Errorf(tr("finding tools required by %[1]s: %[2]s"), platformRelease, err)
+			}
+			for _, tool := range tools {
+				required[tool] = true
+			}
+			continue
+		}
+		for _, toolDep := range platformRelease.ToolDependencies {
+			if tool := pm.FindToolDependency(toolDep); tool != nil {
+				required[tool] = true
+			}
+		}
+		for _, discoveryDep := range platformRelease.DiscoveryDependencies {
+			if tool := pm.FindDiscoveryDependency(discoveryDep); tool != nil {
+				required[tool] = true
+			}
+		}
+		for _, monitorDep := range platformRelease.MonitorDependencies {
+			if tool := pm.FindMonitorDependency(monitorDep); tool != nil {
+				required[tool] = true
+			}
+		}
+	}
+	if builtin, ok := pm.Packages["builtin"]; ok {
+		for _, tool := range builtin.Tools {
+			if latest := tool.LatestRelease(); latest != nil {
+				required[latest] = true
+			}
+		}
+	}
+
+	unused := []*cores.ToolRelease{}
+	for _, toolRelease := range pm.GetAllInstalledToolsReleases() {
+		if !required[toolRelease] && pm.IsManagedToolRelease(toolRelease) {
+			unused = append(unused, toolRelease)
+		}
+	}
+	sort.Slice(unused, func(i, j int) bool { return unused[i].String() < unused[j].String() })
+	return unused, nil
+}
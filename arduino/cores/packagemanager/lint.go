@@ -0,0 +1,461 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package packagemanager
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/arduino/arduino-cli/arduino/cores"
+	"github.com/arduino/arduino-cli/arduino/lint"
+	paths "github.com/arduino/go-paths-helper"
+	properties "github.com/arduino/go-properties-orderedmap"
+	semver "go.bug.st/relaxed-semver"
+)
+
+// builderProperties are the properties set by the builder and by the uploader
+// when the recipes are run, they can be used in recipes without being defined
+// in the platform
+var builderProperties = []string{
+	"build.path", "build.project_name", "build.arch", "build.board", "build.fqbn",
+	"build.core.path", "build.system.path", "build.variant.path", "build.source.path",
+	"build.library_discovery_phase",
+	"compiler.optimization_flags", "compiler.warning_flags", "compiler.libraries.ldflags",
+	"source_file", "object_file", "object_files", "includes",
+	"archive_file", "archive_file_path", "preprocessed_file_path",
+	"ide_version", "software", "codecomplete",
+	"serial.port", "serial.port.file",
+	"upload.verbose", "upload.verify", "program.verbose", "program.verify",
+	"erase.verbose", "erase.verify", "bootloader.verbose", "bootloader.verify",
+	"read.verbose", "read.region", "read.output.path",
+}
+
+// builderPropertiesPrefixes are the prefixes of the families of properties
+// set by the builder and by the uploader
+var builderPropertiesPrefixes = []string{"runtime.", "extra.time.", "upload.port.", "upload.field."}
+
+var propertyReference = regexp.MustCompile(`\{([a-zA-Z_][a-zA-Z0-9_.\-]*)\}`)
+
+var hexID = regexp.MustCompile(`^0[xX][0-9a-fA-F]{4}$`)
+
+// toolActions are the actions that can be run with a tool and the recipes of
+// the tool needed by each of them
+var toolActions = map[string][]string{
+	"upload":     {"upload"},
+	"program":    {"program"},
+	"bootloader": {"erase", "bootloader"},
+}
+
+// LintPlatform checks the platform in the given folder against the platform
+// specification and returns the problems found. The platform.keys.rewrite.txt
+// file, if any, is searched in hardwareDirs like the builder does. The
+// pluggable discoveries and monitors required by the platform are resolved
+// against the tools known by the PackageManager.
+func (pm *PackageManager) LintPlatform(platformDir *paths.Path, hardwareDirs paths.PathList) (lint.Findings, error) {
+	if !platformDir.IsDir() {
+		return nil, fmt.Errorf(tr("%s is not a directory"), platformDir)
+	}
+	findings := lint.Findings{}
+
+	boardsTxtPath := platformDir.Join("boards.txt")
+	boardsProperties, err := properties.SafeLoadFromPath(boardsTxtPath)
+	if err != nil {
+		findings.Add(lint.Error, "invalid-boards-txt", boardsTxtPath, tr("loading boards.txt: %s"), err)
+		return findings, nil
+	}
+	if !boardsTxtPath.Exist() {
+		findings.Add(lint.Error, "missing-boards-txt", platformDir, tr("boards.txt not found"))
+		return findings, nil
+	}
+	lintMenus(boardsTxtPath, boardsProperties, &findings)
+
+	// The platform is loaded in a scratch PackageManager to not alter the
+	// installed platforms
+	release, err := loadPlatformForLint(platformDir, pm.userAgent, &findings)
+	if err != nil {
+		findings.Add(lint.Error, "invalid-platform", platformDir, tr("loading platform: %s"), err)
+		return findings, nil
+	}
+
+	boardIDs := []string{}
+	for boardID := range release.Boards {
+		boardIDs = append(boardIDs, boardID)
+	}
+	sort.Strings(boardIDs)
+
+	platformTxtPath := platformDir.Join("platform.txt")
+	boardsFindings := newBoardsFindings()
+	for _, boardID := range boardIDs {
+		board := release.Boards[boardID]
+		lintBoardFolders(platformDir, boardsTxtPath, board, boardsFindings)
+		lintUploadPort(boardsTxtPath, board, &findings)
+		lintRecipes(platformTxtPath, release, board, boardsFindings)
+	}
+	findings = append(findings, boardsFindings.findings()...)
+
+	if err := lintKeysRewrite(platformTxtPath, release, hardwareDirs, &findings); err != nil {
+		return nil, err
+	}
+	pm.lintPluggableDependencies(platformTxtPath, release, &findings)
+	return findings, nil
+}
+
+// loadPlatformForLint loads the platform in the given folder. The packager and
+// the architecture are taken from the folder structure, that can be either
+// PACKAGER/ARCHITECTURE or PACKAGER/hardware/ARCHITECTURE/VERSION.
+func loadPlatformForLint(platformDir *paths.Path, userAgent string, findings *lint.Findings) (*cores.PlatformRelease, error) {
+	platformTxtPath := platformDir.Join("platform.txt")
+	platformProperties, err := properties.SafeLoadFromPath(platformTxtPath)
+	if err != nil {
+		return nil, err
+	}
+	for _, prop := range []string{"name", "version"} {
+		if platformProperties.Get(prop) == "" {
+			findings.Add(lint.Warning, "missing-property", platformTxtPath, tr("missing property %s"), prop)
+		}
+	}
+
+	architecture := platformDir.Base()
+	packager := platformDir.Parent().Base()
+	version, err := semver.Parse(platformDir.Base())
+	if err == nil {
+		architecture = platformDir.Parent().Base()
+		packager = platformDir.Parent().Parent().Parent().Base()
+	} else if v := platformProperties.Get("version"); v == "" {
+		version = semver.MustParse("0.0.0")
+	} else if version, err = semver.Parse(v); err != nil {
+		findings.Add(lint.Error, "invalid-version", platformTxtPath, tr("invalid version %[1]s: %[2]s"), v, err)
+		version = semver.MustParse("0.0.0")
+	}
+
+	scratch := NewPackageManager(nil, nil, nil, nil, userAgent)
+	platform := scratch.Packages.GetOrCreatePackage(packager).GetOrCreatePlatform(architecture)
+	release := platform.GetOrCreateRelease(version)
+	if err := scratch.loadPlatformRelease(release, platformDir); err != nil {
+		return nil, err
+	}
+	return release, nil
+}
+
+// lintMenus checks the custom board options declared in boards.txt
+func lintMenus(boardsTxtPath *paths.Path, boardsProperties *properties.Map, findings *lint.Findings) {
+	menus := boardsProperties.SubTree("menu")
+	usedMenus := map[string]bool{}
+	for _, menuID := range menus.Keys() {
+		if strings.Contains(menuID, ".") {
+			continue
+		}
+		if strings.TrimSpace(menus.Get(menuID)) == "" {
+			findings.Add(lint.Error, "missing-menu-label", boardsTxtPath, tr("menu %s has an empty label"), menuID)
+		}
+	}
+
+	for _, boardID := range boardsProperties.FirstLevelKeys() {
+		if boardID == "menu" {
+			continue
+		}
+		boardMenus := boardsProperties.SubTree(boardID).SubTree("menu")
+		for _, menuID := range boardMenus.FirstLevelKeys() {
+			usedMenus[menuID] = true
+			if !menus.ContainsKey(menuID) {
+				findings.Add(lint.Error, "undeclared-menu", boardsTxtPath, tr("board %[1]s: menu %[2]s is not declared, add a menu.%[2]s=LABEL line"), boardID, menuID)
+			}
+			options := boardMenus.SubTree(menuID)
+			for _, option := range options.FirstLevelKeys() {
+				// The label can be OS specific
+				label := options.Get(option)
+				for _, os := range []string{"linux", "macosx", "windows"} {
+					label += options.Get(option + "." + os)
+				}
+				if strings.TrimSpace(label) == "" {
+					findings.Add(lint.Error, "missing-menu-label", boardsTxtPath, tr("board %[1]s: option %[2]s of menu %[3]s has no label"), boardID, option, menuID)
+				}
+			}
+		}
+	}
+
+	for _, menuID := range menus.Keys() {
+		if !strings.Contains(menuID, ".") && !usedMenus[menuID] {
+			findings.Add(lint.Note, "unused-menu", boardsTxtPath, tr("menu %s is not used by any board"), menuID)
+		}
+	}
+}
+
+// lintBoardFolders checks that the core and the variant used by the board, in
+// any of its configurations, are available in the platform
+func lintBoardFolders(platformDir, boardsTxtPath *paths.Path, board *cores.Board, boardsFindings *boardsFindings) {
+	if !board.Properties.ContainsKey("build.core") {
+		boardsFindings.add(board.BoardID, lint.Error, "missing-core", boardsTxtPath, tr("missing build.core property"))
+	}
+	for _, key := range board.Properties.Keys() {
+		var dir string
+		if key == "build.core" || (strings.HasPrefix(key, "menu.") && strings.HasSuffix(key, ".build.core")) {
+			dir = "cores"
+		} else if key == "build.variant" || (strings.HasPrefix(key, "menu.") && strings.HasSuffix(key, ".build.variant")) {
+			dir = "variants"
+		} else {
+			continue
+		}
+		value := board.Properties.Get(key)
+		if strings.Contains(value, ":") {
+			// A core or a variant of another platform
+			continue
+		}
+		if value == "" {
+			if dir == "cores" {
+				boardsFindings.add(board.BoardID, lint.Error, "missing-core", boardsTxtPath, tr("%s is empty"), key)
+			}
+			continue
+		}
+		if !platformDir.Join(dir, value).IsDir() {
+			boardsFindings.add(board.BoardID, lint.Error, "missing-"+strings.TrimSuffix(dir, "s")+"-folder", boardsTxtPath,
+				tr("folder %[1]s, required by %[2]s, not found"), dir+"/"+value, key)
+		}
+	}
+}
+
+// lintUploadPort checks the upload_port.N.* board identification properties
+func lintUploadPort(boardsTxtPath *paths.Path, board *cores.Board, findings *lint.Findings) {
+	uploadPort := board.Properties.SubTree("upload_port")
+	if uploadPort.Size() == 0 {
+		return
+	}
+
+	indexes := []int{}
+	unindexed := []string{}
+	for _, key := range uploadPort.FirstLevelKeys() {
+		if idx, err := strconv.Atoi(key); err == nil && idx >= 0 {
+			indexes = append(indexes, idx)
+		} else {
+			unindexed = append(unindexed, key)
+		}
+	}
+	sort.Ints(indexes)
+	if len(indexes) > 0 && len(unindexed) > 0 {
+		findings.Add(lint.Error, "invalid-upload-port", boardsTxtPath,
+			tr("board %[1]s: upload_port.%[2]s is ignored because indexed upload_port.N blocks are defined"), board.BoardID, unindexed[0])
+	}
+
+	// The blocks after a gap in the indexes are ignored, the index 0 is optional
+	present := map[int]bool{}
+	for _, idx := range indexes {
+		present[idx] = true
+	}
+	accepted := map[int]bool{}
+	for idx := 0; present[idx] || idx == 0; idx++ {
+		accepted[idx] = true
+	}
+	for _, idx := range indexes {
+		if !accepted[idx] {
+			findings.Add(lint.Error, "invalid-upload-port", boardsTxtPath,
+				tr("board %[1]s: upload_port.%[2]d is ignored because the indexes are not contiguous"), board.BoardID, idx)
+		}
+	}
+
+	for _, idProps := range board.GetIdentificationProperties() {
+		_, hasVid := idProps.GetOk("vid")
+		_, hasPid := idProps.GetOk("pid")
+		if hasVid != hasPid {
+			findings.Add(lint.Error, "invalid-upload-port", boardsTxtPath, tr("board %s: upload_port vid and pid must be defined together"), board.BoardID)
+		}
+		for _, key := range []string{"vid", "pid"} {
+			if value, ok := idProps.GetOk(key); ok && !hexID.MatchString(value) {
+				findings.Add(lint.Warning, "invalid-upload-port", boardsTxtPath, tr("board %[1]s: invalid upload_port %[2]s %[3]s, the expected format is 0xHHHH"), board.BoardID, key, value)
+			}
+		}
+	}
+}
+
+// boardsFindings collects the problems shared by many boards, each one is
+// reported once with the list of the affected boards
+type boardsFindings struct {
+	keys   []lint.Finding
+	boards map[lint.Finding][]string
+}
+
+func newBoardsFindings() *boardsFindings {
+	return &boardsFindings{boards: map[lint.Finding][]string{}}
+}
+
+func (b *boardsFindings) add(boardID string, level lint.Level, ruleID string, file *paths.Path, format string, args ...interface{}) {
+	key := lint.Finding{Level: level, RuleID: ruleID, Message: fmt.Sprintf(format, args...), File: file}
+	boards, ok := b.boards[key]
+	if !ok {
+		b.keys = append(b.keys, key)
+	}
+	if len(boards) == 0 || boards[len(boards)-1] != boardID {
+		b.boards[key] = append(boards, boardID)
+	}
+}
+
+func (b *boardsFindings) findings() lint.Findings {
+	res := lint.Findings{}
+	for _, key := range b.keys {
+		finding := key
+		finding.Message += " " + tr("(boards: %s)", strings.Join(b.boards[key], ", "))
+		res = append(res, &finding)
+	}
+	return res
+}
+
+// lintRecipes checks the recipes used by the board in its default
+// configuration for undefined properties and missing tool recipes
+func lintRecipes(platformTxtPath *paths.Path, release *cores.PlatformRelease, board *cores.Board, boardsFindings *boardsFindings) {
+	config := release.Properties.Clone()
+	config.Merge(board.Properties)
+	// Apply the first option of each menu, as the default configuration does
+	for _, menuID := range board.Properties.SubTree("menu").FirstLevelKeys() {
+		options := board.Properties.SubTree("menu").SubTree(menuID)
+		if keys := options.FirstLevelKeys(); len(keys) > 0 {
+			config.Merge(options.SubTree(keys[0]))
+		}
+	}
+	for _, prop := range builderProperties {
+		if !config.ContainsKey(prop) {
+			config.Set(prop, "")
+		}
+	}
+
+	addUnresolved := func(recipe, pattern string, config *properties.Map) {
+		expanded := config.ExpandPropsInString(pattern)
+		for _, match := range propertyReference.FindAllStringSubmatch(expanded, -1) {
+			if !isBuilderProperty(match[1]) {
+				boardsFindings.add(board.BoardID, lint.Error, "unresolved-property", platformTxtPath,
+					tr("%[1]s: the property {%[2]s} is not defined"), recipe, match[1])
+			}
+		}
+	}
+
+	for _, key := range config.Keys() {
+		if strings.HasPrefix(key, "recipe.") && !strings.Contains(key, ".regex") {
+			addUnresolved(key, config.Get(key), config)
+		}
+	}
+
+	for _, key := range board.Properties.Keys() {
+		split := strings.SplitN(key, ".", 3)
+		patterns, ok := toolActions[split[0]]
+		if !ok || len(split) < 2 || split[1] != "tool" {
+			continue
+		}
+		if split[0] == "upload" && !config.ContainsKey("upload.protocol") {
+			// The board is uploaded with a programmer using program.tool
+			continue
+		}
+		toolName := board.Properties.Get(key)
+		if strings.Contains(toolName, ":") {
+			// A tool of another platform
+			continue
+		}
+		toolConfig := config.Clone()
+		usesProgrammer := split[0] != "upload"
+		if usesProgrammer {
+			// Any of the programmers may be chosen
+			for _, programmer := range release.Programmers {
+				toolConfig.Merge(programmer.Properties)
+			}
+		}
+		toolConfig.Merge(release.Properties.SubTree("tools").SubTree(toolName))
+		for _, pattern := range patterns {
+			recipe := "tools." + toolName + "." + pattern + ".pattern"
+			if !release.Properties.ContainsKey(recipe) {
+				boardsFindings.add(board.BoardID, lint.Error, "missing-tool-recipe", platformTxtPath,
+					tr("the recipe %s is not defined"), recipe)
+				continue
+			}
+			if usesProgrammer && len(release.Programmers) == 0 {
+				// The programmers are provided by another platform
+				continue
+			}
+			addUnresolved(recipe, toolConfig.Get(pattern+".pattern"), toolConfig)
+		}
+	}
+}
+
+func isBuilderProperty(prop string) bool {
+	for _, prefix := range builderPropertiesPrefixes {
+		if strings.HasPrefix(prop, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// lintKeysRewrite reports the platform properties that the builder rewrites
+// because they are listed in platform.keys.rewrite.txt
+func lintKeysRewrite(platformTxtPath *paths.Path, release *cores.PlatformRelease, hardwareDirs paths.PathList, findings *lint.Findings) error {
+	if release.Properties.Get("rewriting") == "disabled" {
+		return nil
+	}
+	for _, dir := range hardwareDirs {
+		rewriteTxtPath := dir.Join("platform.keys.rewrite.txt")
+		if !rewriteTxtPath.Exist() {
+			continue
+		}
+		rewrites, err := properties.LoadFromPath(rewriteTxtPath)
+		if err != nil {
+			return fmt.Errorf(tr("loading %[1]s: %[2]s"), rewriteTxtPath, err)
+		}
+		olds := rewrites.SubTree("old")
+		for _, idx := range olds.FirstLevelKeys() {
+			for key, oldValue := range olds.SubTree(idx).AsMap() {
+				if release.Properties.Get(key) == oldValue {
+					findings.Add(lint.Warning, "deprecated-key", platformTxtPath,
+						tr("%[1]s is rewritten by the builder to: %[2]s"), key, rewrites.Get("new."+idx+"."+key))
+				}
+			}
+		}
+		// Only the first file found is used
+		return nil
+	}
+	return nil
+}
+
+// lintPluggableDependencies checks that the pluggable discoveries and monitors
+// required by the platform are known tools
+func (pm *PackageManager) lintPluggableDependencies(platformTxtPath *paths.Path, release *cores.PlatformRelease, findings *lint.Findings) {
+	if !release.PluggableDiscoveryAware {
+		return
+	}
+	discoveryProperties := release.Properties.SubTree("pluggable_discovery")
+	for _, ref := range discoveryProperties.ExtractSubIndexLists("required") {
+		if len(strings.Split(ref, ":")) != 2 {
+			findings.Add(lint.Error, "invalid-discovery", platformTxtPath, tr("invalid pluggable discovery reference %s, the expected format is PACKAGER:NAME"), ref)
+		} else if pm.GetTool(ref) == nil {
+			findings.Add(lint.Error, "unresolved-discovery", platformTxtPath, tr("pluggable discovery %s not found"), ref)
+		}
+	}
+	discoveryIDs := discoveryProperties.FirstLevelOf()
+	delete(discoveryIDs, "required")
+	for _, discoveryID := range discoveryProperties.FirstLevelKeys() {
+		if props, ok := discoveryIDs[discoveryID]; ok && !props.ContainsKey("pattern") {
+			findings.Add(lint.Error, "invalid-discovery", platformTxtPath, tr("pluggable discovery %s has no pattern"), discoveryID)
+		}
+	}
+
+	monitors := release.Properties.SubTree("pluggable_monitor.required")
+	for _, protocol := range monitors.Keys() {
+		ref := monitors.Get(protocol)
+		if len(strings.Split(ref, ":")) != 2 {
+			findings.Add(lint.Error, "invalid-monitor", platformTxtPath, tr("invalid pluggable monitor reference %s, the expected format is PACKAGER:NAME"), ref)
+		} else if pm.GetTool(ref) == nil {
+			findings.Add(lint.Error, "unresolved-monitor", platformTxtPath, tr("pluggable monitor %[1]s for protocol %[2]s not found"), ref, protocol)
+		}
+	}
+}
@@ -0,0 +1,67 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package packagemanager_test
+
+import (
+	"testing"
+
+	"github.com/arduino/arduino-cli/arduino/cores/packagemanager"
+	"github.com/arduino/go-paths-helper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLintPlatform(t *testing.T) {
+	pm := packagemanager.NewPackageManager(nil, nil, nil, nil, "test")
+	builtin := pm.Packages.GetOrCreatePackage("builtin")
+	builtin.GetOrCreateTool("serial-discovery")
+	builtin.GetOrCreateTool("serial-monitor")
+
+	lintHardware := paths.New("testdata", "lint_hardware")
+	findings, err := pm.LintPlatform(lintHardware.Join("test", "avr"), paths.NewPathList(lintHardware.String()))
+	require.NoError(t, err)
+	messages := []string{}
+	for _, f := range findings {
+		messages = append(messages, string(f.Level)+" "+f.RuleID+" "+f.File.Base()+" "+f.Message)
+	}
+	require.Equal(t, []string{
+		"error missing-menu-label boards.txt board uno: option slow of menu cpu has no label",
+		"note unused-menu boards.txt menu unused is not used by any board",
+		"error invalid-upload-port boards.txt board mega: upload_port vid and pid must be defined together",
+		"warning invalid-upload-port boards.txt board mega: invalid upload_port vid 2341, the expected format is 0xHHHH",
+		"error invalid-upload-port boards.txt board uno: upload_port.board is ignored because indexed upload_port.N blocks are defined",
+		"error invalid-upload-port boards.txt board uno: upload_port.2 is ignored because the indexes are not contiguous",
+		"error missing-core-folder boards.txt folder cores/mega, required by build.core, not found (boards: mega)",
+		"error unresolved-property platform.txt recipe.c.o.pattern: the property {build.mcu} is not defined (boards: mega)",
+		"error unresolved-property platform.txt recipe.c.o.pattern: the property {build.extra_flags} is not defined (boards: mega, uno)",
+		"error missing-tool-recipe platform.txt the recipe tools.missing.upload.pattern is not defined (boards: mega)",
+		"error missing-variant-folder boards.txt folder variants/slow, required by menu.cpu.slow.build.variant, not found (boards: uno)",
+		"warning deprecated-key platform.txt compiler.path is rewritten by the builder to: {runtime.tools.gcc.path}/bin/",
+		"error unresolved-discovery platform.txt pluggable discovery test:missing-discovery not found",
+		"error invalid-discovery platform.txt invalid pluggable discovery reference invalid, the expected format is PACKAGER:NAME",
+		"error invalid-discovery platform.txt pluggable discovery net has no pattern",
+		"error unresolved-monitor platform.txt pluggable monitor test:missing-monitor for protocol net not found",
+	}, messages)
+
+	// The keys are rewritten only if platform.keys.rewrite.txt is found
+	findings, err = pm.LintPlatform(lintHardware.Join("test", "avr"), nil)
+	require.NoError(t, err)
+	for _, f := range findings {
+		require.NotEqual(t, "deprecated-key", f.RuleID)
+	}
+
+	_, err = pm.LintPlatform(lintHardware.Join("test", "missing"), nil)
+	require.Error(t, err)
+}
@@ -573,7 +573,7 @@ func convertVidPidIdentificationPropertiesToPluggableDiscovery(boardProperties *
 }
 
 func convertUploadToolsToPluggableDiscovery(props *properties.Map) {
-	actions := []string{"upload", "bootloader", "program"}
+	actions := []string{"upload", "bootloader", "program", "read"}
 	propsToAdd := properties.NewMap()
 	for _, action := range actions {
 		action += ".tool"
@@ -597,6 +597,7 @@ func convertUploadToolsToPluggableDiscovery(props *properties.Map) {
 				// Just skip it, ideally this must never happen but if a platform
 				// doesn't define an expected upload.tool, bootloader.tool or program.tool
 				// there will be other issues further down the road after this conversion
+				// (read.tool is optional)
 				continue
 			}
 			propsToAdd.Set(defaultAction, tool)
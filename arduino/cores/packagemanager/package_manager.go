@@ -24,6 +24,7 @@ import (
 	"github.com/arduino/arduino-cli/arduino/cores"
 	"github.com/arduino/arduino-cli/arduino/cores/packageindex"
 	"github.com/arduino/arduino-cli/arduino/discovery/discoverymanager"
+	"github.com/arduino/arduino-cli/arduino/security"
 	"github.com/arduino/arduino-cli/i18n"
 	paths "github.com/arduino/go-paths-helper"
 	properties "github.com/arduino/go-properties-orderedmap"
@@ -44,8 +45,14 @@ type PackageManager struct {
 	DownloadDir            *paths.Path
 	TempDir                *paths.Path
 	CustomGlobalProperties *properties.Map
-	discoveryManager       *discoverymanager.DiscoveryManager
-	userAgent              string
+	// Keyring contains the keys trusted, in addition to the Arduino one, to
+	// sign the package indexes
+	Keyring *security.Keyring
+	// RequireSignedIndexes refuses to load package indexes not signed with
+	// a trusted key
+	RequireSignedIndexes bool
+	discoveryManager     *discoverymanager.DiscoveryManager
+	userAgent            string
 }
 
 var tr = i18n.Tr
@@ -238,10 +245,13 @@ func (pm *PackageManager) ResolveFQBN(fqbn *cores.FQBN) (
 // LoadPackageIndex loads a package index by looking up the local cached file from the specified URL
 func (pm *PackageManager) LoadPackageIndex(URL *url.URL) error {
 	indexPath := pm.IndexDir.Join(path.Base(URL.Path))
-	index, err := packageindex.LoadIndex(indexPath)
+	index, err := packageindex.LoadIndexWithKeyring(indexPath, pm.Keyring)
 	if err != nil {
 		return fmt.Errorf(tr("loading json index file %[1]s: %[2]s"), indexPath, err)
 	}
+	if pm.RequireSignedIndexes && !index.IsTrusted {
+		return fmt.Errorf(tr("loading json index file %[1]s: %[2]s"), indexPath, tr("the index is not signed with a trusted key"))
+	}
 
 	for _, p := range index.Packages {
 		p.URL = URL.String()
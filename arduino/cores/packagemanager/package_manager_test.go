@@ -23,6 +23,7 @@ import (
 
 	"github.com/arduino/arduino-cli/arduino/cores"
 	"github.com/arduino/arduino-cli/arduino/cores/packagemanager"
+	"github.com/arduino/arduino-cli/arduino/resources"
 	"github.com/arduino/arduino-cli/configuration"
 	"github.com/arduino/go-paths-helper"
 	"github.com/arduino/go-properties-orderedmap"
@@ -447,11 +448,131 @@ func TestFindToolsRequiredFromPlatformRelease(t *testing.T) {
 func TestFindPlatformReleaseDependencies(t *testing.T) {
 	pm := packagemanager.NewPackageManager(nil, nil, nil, nil, "test")
 	pm.LoadPackageIndexFromFile(paths.New("testdata", "package_tooltest_index.json"))
-	pl, tools, err := pm.FindPlatformReleaseDependencies(&packagemanager.PlatformReference{Package: "test", PlatformArchitecture: "avr"})
+	pl, tools, platforms, err := pm.FindPlatformReleaseDependencies(&packagemanager.PlatformReference{Package: "test", PlatformArchitecture: "avr"})
 	require.NoError(t, err)
 	require.NotNil(t, pl)
 	require.Len(t, tools, 3)
 	require.Equal(t, "[test:some-tool@0.42.0 test:discovery-tool@0.42.0 test:monitor-tool@0.42.0]", fmt.Sprint(tools))
+	require.Empty(t, platforms)
+}
+
+func TestFindPlatformReleasePlatformDependencies(t *testing.T) {
+	pm := packagemanager.NewPackageManager(nil, nil, nil, nil, "test")
+	_, err := pm.LoadPackageIndexFromFile(paths.New("testdata", "package_platformdeps_index.json"))
+	require.NoError(t, err)
+
+	find := func(packager, arch string) ([]*cores.PlatformRelease, error) {
+		_, _, platforms, err := pm.FindPlatformReleaseDependencies(&packagemanager.PlatformReference{Package: packager, PlatformArchitecture: arch})
+		return platforms, err
+	}
+
+	// The constraints of the platforms required transitively are combined
+	platforms, err := find("derived", "samd")
+	require.NoError(t, err)
+	require.Equal(t, "[arduino:samd@1.8.12 base:common@1.0.0]", fmt.Sprint(platforms))
+
+	// An installed release satisfying the constraints is preferred
+	samd := pm.FindPlatform(&packagemanager.PlatformReference{Package: "arduino", PlatformArchitecture: "samd"})
+	installed := samd.FindReleaseWithVersion(semver.MustParse("1.8.6"))
+	installed.InstallDir = paths.New("testdata")
+	platforms, err = find("derived", "samd")
+	require.NoError(t, err)
+	require.Equal(t, "[arduino:samd@1.8.6 base:common@1.0.0]", fmt.Sprint(platforms))
+	require.Empty(t, pm.InstalledPlatformReleasesRequiring(samd))
+	common := platforms[1]
+	common.InstallDir = paths.New("testdata")
+	require.Equal(t, "[base:common@1.0.0]", fmt.Sprint(pm.InstalledPlatformReleasesRequiring(samd)))
+	derived := pm.FindPlatform(&packagemanager.PlatformReference{Package: "derived", PlatformArchitecture: "samd"}).GetLatestRelease()
+	require.Equal(t, "[arduino:samd@1.8.6 base:common@1.0.0]", fmt.Sprint(pm.FindInstalledPlatformDependencies(derived)))
+	common.InstallDir = nil
+
+	// Otherwise the installed release is replaced
+	installed.InstallDir = nil
+	installed = samd.FindReleaseWithVersion(semver.MustParse("1.6.0"))
+	installed.InstallDir = paths.New("testdata")
+	platforms, err = find("derived", "samd")
+	require.NoError(t, err)
+	require.Equal(t, "[arduino:samd@1.8.12 base:common@1.0.0]", fmt.Sprint(platforms))
+	installed.InstallDir = nil
+
+	_, err = find("derived", "conflict")
+	require.EqualError(t, err, "getting platform dependencies for platform derived:conflict@1.0.0: "+
+		"no release of platform arduino:samd satisfies all the requirements:\n"+
+		"  arduino:samd (>=2.1.0) required by derived:conflict@1.0.0")
+
+	_, err = find("derived", "missing")
+	require.EqualError(t, err, "getting platform dependencies for platform derived:missing@1.0.0: "+
+		"platform unknown:avr, required by derived:missing@1.0.0, not found")
+
+	// Circular dependencies are allowed if the constraints are satisfied
+	platforms, err = find("cycle", "a")
+	require.NoError(t, err)
+	require.Equal(t, "[cycle:b@1.0.0]", fmt.Sprint(platforms))
+	_, err = find("cycle", "c")
+	require.Error(t, err)
+}
+
+func TestFindPlatformReleaseInstalledDependents(t *testing.T) {
+	pm := packagemanager.NewPackageManager(nil, nil, nil, nil, "test")
+	_, err := pm.LoadPackageIndexFromFile(paths.New("testdata", "package_platformdeps_index.json"))
+	require.NoError(t, err)
+
+	samdRef := &packagemanager.PlatformReference{Package: "arduino", PlatformArchitecture: "samd"}
+	samd := pm.FindPlatform(samdRef)
+	require.Equal(t, "arduino:samd@2.0.0", samd.GetLatestRelease().String())
+	require.Equal(t, "arduino:samd@2.0.0", pm.FindLatestCompatiblePlatformRelease(samd).String())
+
+	// base:common, installed, requires arduino:samd <2.0.0
+	installed := samd.FindReleaseWithVersion(semver.MustParse("1.8.6"))
+	installed.InstallDir = paths.New("testdata")
+	defer func() { installed.InstallDir = nil }()
+	common := pm.FindPlatform(&packagemanager.PlatformReference{Package: "base", PlatformArchitecture: "common"}).GetLatestRelease()
+	common.InstallDir = paths.New("testdata")
+	defer func() { common.InstallDir = nil }()
+
+	// The latest release compatible with the installed dependents is selected
+	require.Equal(t, "arduino:samd@1.8.12", pm.FindLatestCompatiblePlatformRelease(samd).String())
+	release, _, platforms, err := pm.FindPlatformReleaseDependencies(samdRef)
+	require.NoError(t, err)
+	require.Equal(t, "arduino:samd@1.8.12", release.String())
+	require.Empty(t, platforms)
+
+	// Releases breaking the installed dependents are refused
+	_, _, _, err = pm.FindPlatformReleaseDependencies(&packagemanager.PlatformReference{
+		Package:              "arduino",
+		PlatformArchitecture: "samd",
+		PlatformVersion:      semver.MustParse("2.0.0"),
+	})
+	require.EqualError(t, err, "getting platform dependencies for platform arduino:samd@2.0.0: "+
+		"no release of platform arduino:samd satisfies all the requirements:\n"+
+		"  arduino:samd (<2.0.0) required by base:common@1.0.0")
+
+	// Installed dependents that are part of the resolution are not counted twice
+	_, _, platforms, err = pm.FindPlatformReleaseDependencies(&packagemanager.PlatformReference{Package: "derived", PlatformArchitecture: "samd"})
+	require.NoError(t, err)
+	require.Equal(t, "[arduino:samd@1.8.6 base:common@1.0.0]", fmt.Sprint(platforms))
+}
+
+func TestPlatformInstalledAsDependency(t *testing.T) {
+	packagesDir := paths.New(t.TempDir()).Join("packages")
+	pm := packagemanager.NewPackageManager(nil, packagesDir, nil, nil, "test")
+	_, err := pm.LoadPackageIndexFromFile(paths.New("testdata", "package_platformdeps_index.json"))
+	require.NoError(t, err)
+	samd := pm.FindPlatform(&packagemanager.PlatformReference{Package: "arduino", PlatformArchitecture: "samd"})
+	require.NotNil(t, samd)
+
+	require.False(t, pm.IsPlatformInstalledAsDependency(samd))
+	require.NoError(t, pm.SetPlatformInstalledAsDependency(samd, true))
+	require.True(t, pm.IsPlatformInstalledAsDependency(samd))
+
+	// The marker is hidden, it's not loaded as a package
+	require.Empty(t, pm.LoadHardwareFromDirectory(packagesDir))
+	require.Nil(t, pm.Packages["dependencies"])
+
+	require.NoError(t, pm.SetPlatformInstalledAsDependency(samd, false))
+	require.False(t, pm.IsPlatformInstalledAsDependency(samd))
+	require.NoError(t, pm.SetPlatformInstalledAsDependency(samd, false))
+	require.False(t, packagesDir.Join(".dependencies").Exist())
 }
 
 func TestLegacyPackageConversionToPluggableDiscovery(t *testing.T) {
@@ -519,3 +640,60 @@ func TestLegacyPackageConversionToPluggableDiscovery(t *testing.T) {
 		require.Equal(t, `"{network_cmd}" -address {upload.port.address} -port {upload.port.properties.port} -sketch "{build.path}/{build.project_name}.hex" -upload {upload.port.properties.endpoint_upload} -sync {upload.port.properties.endpoint_sync} -reset {upload.port.properties.endpoint_reset} -sync_exp {upload.port.properties.sync_return}`, platformProps.Get("tools.avrdude__pluggable_network.upload.pattern"))
 	}
 }
+
+func TestFindUnusedToolReleases(t *testing.T) {
+	packagesDir, err := paths.MkTempDir("", "test_unused_tools")
+	require.NoError(t, err)
+	defer packagesDir.RemoveAll()
+	pm := packagemanager.NewPackageManager(packagesDir, packagesDir, packagesDir, packagesDir, "test")
+
+	installTool := func(packager, name, version string, dir *paths.Path) *cores.ToolRelease {
+		tool := pm.Packages.GetOrCreatePackage(packager).GetOrCreateTool(name)
+		release := tool.GetOrCreateRelease(semver.ParseRelaxed(version))
+		release.InstallDir = dir.Join(packager, "tools", name, version)
+		release.InstallDir.MkdirAll()
+		return release
+	}
+	gcc1 := installTool("test", "gcc", "1.0.0", packagesDir)
+	gcc2 := installTool("test", "gcc", "2.0.0", packagesDir)
+	gcc3 := installTool("test", "gcc", "3.0.0", packagesDir)
+	avrdude := installTool("test", "avrdude", "1.0.0", packagesDir)
+	discovery1 := installTool("test", "discovery", "1.0.0", packagesDir)
+	discovery2 := installTool("test", "discovery", "2.0.0", packagesDir)
+	ctags1 := installTool("builtin", "ctags", "1.0.0", packagesDir)
+	installTool("builtin", "ctags", "2.0.0", packagesDir)
+	// Tools outside the packages dir are not managed by the PackageManager
+	otherDir, err := paths.MkTempDir("", "test_unused_tools")
+	require.NoError(t, err)
+	defer otherDir.RemoveAll()
+	installTool("test", "bossac", "1.0.0", otherDir)
+
+	platform := pm.Packages.GetOrCreatePackage("test").GetOrCreatePlatform("avr").GetOrCreateRelease(semver.MustParse("1.0.0"))
+	platform.InstallDir = packagesDir.Join("test", "hardware", "avr", "1.0.0")
+	platform.Resource = &resources.DownloadResource{URL: "http://example.com/avr-1.0.0.zip", ArchiveFileName: "avr-1.0.0.zip"}
+	platform.ToolDependencies = cores.ToolDependencies{
+		{ToolPackager: "test", ToolName: "gcc", ToolVersion: semver.ParseRelaxed("2.0.0")},
+	}
+	platform.DiscoveryDependencies = cores.DiscoveryDependencies{
+		{Packager: "test", Name: "discovery"},
+	}
+
+	// Tools not referenced by the platform are unused, for the discoveries
+	// only the latest release is used
+	unused, err := pm.FindUnusedToolReleases()
+	require.NoError(t, err)
+	require.Equal(t, []*cores.ToolRelease{ctags1, avrdude, discovery1, gcc1, gcc3}, unused)
+
+	// A platform not coming from a package index may use the latest release
+	// of any tool
+	platform.Resource = nil
+	unused, err = pm.FindUnusedToolReleases()
+	require.NoError(t, err)
+	require.Equal(t, []*cores.ToolRelease{ctags1, discovery1, gcc1, gcc3}, unused)
+
+	// Without installed platforms only the builtin tools are kept
+	platform.InstallDir = nil
+	unused, err = pm.FindUnusedToolReleases()
+	require.NoError(t, err)
+	require.Equal(t, []*cores.ToolRelease{ctags1, avrdude, discovery1, discovery2, gcc1, gcc2, gcc3}, unused)
+}
@@ -0,0 +1 @@
+#define NUM_DIGITAL_PINS 20
@@ -0,0 +1,337 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package packagemanager
+
+import (
+	"fmt"
+
+	"github.com/arduino/arduino-cli/arduino/cores"
+	"github.com/arduino/arduino-cli/arduino/resources"
+	paths "github.com/arduino/go-paths-helper"
+	semver "go.bug.st/relaxed-semver"
+)
+
+// Transaction groups changes to the installed platforms and tools that must
+// be applied all together. The new releases are extracted in a staging folder
+// before touching the installed ones, and the releases replaced or removed by
+// the transaction are kept until the transaction is committed, so that the
+// previous state can be restored if any step fails.
+type Transaction struct {
+	pm       *PackageManager
+	stageDir *paths.Path
+	staged   []*stagedRelease
+	removed  []*removedRelease
+	applied  bool
+}
+
+// stagedRelease is a release ready to be moved in its installation folder
+type stagedRelease struct {
+	dir      *paths.Path
+	destDir  *paths.Path
+	platform *cores.PlatformRelease
+	tool     *cores.ToolRelease
+	// restored is true if dir is a folder kept for rollback, that must be
+	// moved back instead of being deleted if the transaction is rolled back
+	restored bool
+	moved    bool
+}
+
+// removedRelease is an installed release moved in the staging folder
+type removedRelease struct {
+	dir        *paths.Path
+	backup     *paths.Path
+	platform   *cores.PlatformRelease
+	tool       *cores.ToolRelease
+	keep       bool
+	installDir *paths.Path
+}
+
+// NewTransaction starts a new Transaction
+func (pm *PackageManager) NewTransaction() (*Transaction, error) {
+	if err := pm.TempDir.MkdirAll(); err != nil {
+		return nil, fmt.Errorf(tr("creating temp dir for transaction: %s"), err)
+	}
+	stageDir, err := pm.TempDir.MkTempDir("transaction-")
+	if err != nil {
+		return nil, fmt.Errorf(tr("creating temp dir for transaction: %s"), err)
+	}
+	return &Transaction{pm: pm, stageDir: stageDir}, nil
+}
+
+// StagePlatform extracts the PlatformRelease archive in the staging folder,
+// the platform is installed when the transaction is applied.
+func (t *Transaction) StagePlatform(platformRelease *cores.PlatformRelease) error {
+	if platformRelease.Resource == nil {
+		return fmt.Errorf(tr("platform %s not available for download"), platformRelease)
+	}
+	dir, err := t.extract(platformRelease.Resource)
+	if err != nil {
+		return fmt.Errorf(tr("installing platform %[1]s: %[2]s"), platformRelease, err)
+	}
+	t.staged = append(t.staged, &stagedRelease{
+		dir:      dir,
+		destDir:  t.pm.platformReleaseInstallDir(platformRelease),
+		platform: platformRelease,
+	})
+	return nil
+}
+
+// StageTool extracts the ToolRelease archive for the current OS in the
+// staging folder, the tool is installed when the transaction is applied.
+func (t *Transaction) StageTool(toolRelease *cores.ToolRelease) error {
+	toolResource := toolRelease.GetCompatibleFlavour()
+	if toolResource == nil {
+		return fmt.Errorf(tr("no compatible version of %s tools found for the current os"), toolRelease.Tool.Name)
+	}
+	dir, err := t.extract(toolResource)
+	if err != nil {
+		return fmt.Errorf(tr("installing tool %[1]s: %[2]s"), toolRelease, err)
+	}
+	t.staged = append(t.staged, &stagedRelease{
+		dir:     dir,
+		destDir: t.pm.toolReleaseInstallDir(toolRelease),
+		tool:    toolRelease,
+	})
+	return nil
+}
+
+// extract unpacks the resource in the staging folder and records the
+// installed files
+func (t *Transaction) extract(resource *resources.DownloadResource) (*paths.Path, error) {
+	root, err := resource.Extract(t.pm.DownloadDir, t.stageDir)
+	if err != nil {
+		return nil, err
+	}
+	if err := resources.CreateInstalledFilesManifest(root); err != nil {
+		return nil, fmt.Errorf(tr("creating installed files manifest: %s"), err)
+	}
+	return root, nil
+}
+
+// RestorePlatform stages the PlatformRelease kept by a previous upgrade of
+// the platform, the platform is installed when the transaction is applied.
+func (t *Transaction) RestorePlatform(platformRelease *cores.PlatformRelease) error {
+	dir := t.pm.platformRollbackDir(platformRelease.Platform).Join(platformRelease.Version.String())
+	if !dir.IsDir() {
+		return fmt.Errorf(tr("no previous installation of %s available"), platformRelease)
+	}
+	t.staged = append(t.staged, &stagedRelease{
+		dir:      dir,
+		destDir:  t.pm.platformReleaseInstallDir(platformRelease),
+		platform: platformRelease,
+		restored: true,
+	})
+	return nil
+}
+
+// RemovePlatform moves an installed PlatformRelease in the staging folder,
+// it's deleted when the transaction is committed. If keep is true the
+// release is kept instead, to allow a later rollback of the platform to this
+// release, otherwise any release previously kept for the platform is deleted.
+func (t *Transaction) RemovePlatform(platformRelease *cores.PlatformRelease, keep bool) error {
+	if platformRelease.InstallDir == nil {
+		return fmt.Errorf(tr("platform not installed"))
+	}
+	if !t.pm.IsManagedPlatformRelease(platformRelease) {
+		return fmt.Errorf(tr("%s is not managed by package manager"), platformRelease)
+	}
+	removed := &removedRelease{dir: platformRelease.InstallDir, platform: platformRelease, keep: keep, installDir: platformRelease.InstallDir}
+	if err := t.remove(removed); err != nil {
+		return fmt.Errorf(tr("removing platform files: %s"), err)
+	}
+	platformRelease.InstallDir = nil
+	return nil
+}
+
+// RemoveTool moves an installed ToolRelease in the staging folder, it's
+// deleted when the transaction is committed.
+func (t *Transaction) RemoveTool(toolRelease *cores.ToolRelease) error {
+	if toolRelease.InstallDir == nil {
+		return fmt.Errorf(tr("tool not installed"))
+	}
+	if !t.pm.IsManagedToolRelease(toolRelease) {
+		return fmt.Errorf(tr("tool %s is not managed by package manager"), toolRelease)
+	}
+	removed := &removedRelease{dir: toolRelease.InstallDir, tool: toolRelease, installDir: toolRelease.InstallDir}
+	if err := t.remove(removed); err != nil {
+		return fmt.Errorf(tr("removing tool files: %s"), err)
+	}
+	toolRelease.InstallDir = nil
+	return nil
+}
+
+func (t *Transaction) remove(removed *removedRelease) error {
+	removed.backup = t.stageDir.Join(fmt.Sprintf("removed-%d", len(t.removed)))
+	if err := removed.dir.Rename(removed.backup); err != nil {
+		return err
+	}
+	t.removed = append(t.removed, removed)
+	removeIfEmpty(removed.dir.Parent())
+	return nil
+}
+
+// Apply moves the staged releases in their installation folders. If a folder
+// is already present it's moved in the staging folder and restored in case
+// of rollback.
+func (t *Transaction) Apply() error {
+	t.applied = true
+	for _, staged := range t.staged {
+		if staged.destDir.IsDir() {
+			removed := &removedRelease{dir: staged.destDir, platform: staged.platform, tool: staged.tool}
+			if err := t.remove(removed); err != nil {
+				return fmt.Errorf(tr("moving previous installation of %[1]s: %[2]s"), staged.destDir, err)
+			}
+		}
+		if err := staged.destDir.Parent().MkdirAll(); err != nil {
+			return err
+		}
+		if err := staged.dir.Rename(staged.destDir); err != nil {
+			return fmt.Errorf(tr("moving extracted archive to destination dir: %s"), err)
+		}
+		staged.moved = true
+
+		installDir, err := staged.destDir.Abs()
+		if err != nil {
+			return err
+		}
+		if staged.platform != nil {
+			staged.platform.InstallDir = installDir
+			if err := t.pm.cacheInstalledJSON(staged.platform); err != nil {
+				return fmt.Errorf(tr("creating installed.json in %[1]s: %[2]s"), installDir, err)
+			}
+		} else {
+			staged.tool.InstallDir = installDir
+		}
+	}
+	return nil
+}
+
+// Rollback restores the installed platforms and tools as they were before
+// the transaction started.
+func (t *Transaction) Rollback() error {
+	var firstErr error
+	setErr := func(err error) {
+		if err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+	for i := len(t.staged) - 1; i >= 0; i-- {
+		staged := t.staged[i]
+		if !staged.moved {
+			continue
+		}
+		if staged.platform != nil {
+			staged.platform.InstallDir = nil
+		} else {
+			staged.tool.InstallDir = nil
+		}
+		if staged.restored {
+			setErr(staged.destDir.Rename(staged.dir))
+		} else {
+			setErr(staged.destDir.RemoveAll())
+		}
+		removeIfEmpty(staged.destDir.Parent())
+	}
+	for i := len(t.removed) - 1; i >= 0; i-- {
+		removed := t.removed[i]
+		if err := removed.dir.Parent().MkdirAll(); err != nil {
+			setErr(err)
+			continue
+		}
+		if err := removed.backup.Rename(removed.dir); err != nil {
+			setErr(err)
+			continue
+		}
+		if removed.platform != nil && removed.installDir != nil {
+			removed.platform.InstallDir = removed.installDir
+		} else if removed.tool != nil && removed.installDir != nil {
+			removed.tool.InstallDir = removed.installDir
+		}
+	}
+	setErr(t.stageDir.RemoveAll())
+	return firstErr
+}
+
+// Commit deletes the releases removed by the transaction, or keeps them for
+// a later rollback, and cleans up the staging folder.
+func (t *Transaction) Commit() error {
+	for _, removed := range t.removed {
+		if removed.platform == nil || removed.installDir == nil {
+			continue
+		}
+		rollbackDir := t.pm.platformRollbackDir(removed.platform.Platform)
+		if err := rollbackDir.RemoveAll(); err != nil {
+			return err
+		}
+		if !removed.keep {
+			removeIfEmpty(rollbackDir.Parent())
+			continue
+		}
+		if err := rollbackDir.MkdirAll(); err != nil {
+			return err
+		}
+		if err := removed.backup.Rename(rollbackDir.Join(removed.platform.Version.String())); err != nil {
+			return err
+		}
+	}
+	return t.stageDir.RemoveAll()
+}
+
+// platformRollbackDir returns the folder where the release replaced by the
+// last upgrade of the platform is kept. The folder is hidden to not be
+// loaded as a package.
+func (pm *PackageManager) platformRollbackDir(platform *cores.Platform) *paths.Path {
+	return pm.PackagesDir.Join(".rollback", platform.Package.Name, platform.Architecture)
+}
+
+// FindPlatformRollbackRelease returns the PlatformRelease replaced by the
+// last upgrade of the platform, if it has been kept to allow a rollback.
+// If the release is no longer in the package index it's loaded from the
+// installed.json kept with it.
+func (pm *PackageManager) FindPlatformRollbackRelease(platform *cores.Platform) (*cores.PlatformRelease, error) {
+	dirs, err := pm.platformRollbackDir(platform).ReadDir()
+	if err != nil {
+		return nil, fmt.Errorf(tr("no previous installation of %s available"), platform)
+	}
+	dirs.FilterDirs()
+	if len(dirs) != 1 {
+		return nil, fmt.Errorf(tr("no previous installation of %s available"), platform)
+	}
+	version, err := semver.Parse(dirs[0].Base())
+	if err != nil {
+		return nil, fmt.Errorf(tr("invalid version of the previous installation: %s"), err)
+	}
+	release := platform.FindReleaseWithVersion(version)
+	if release == nil {
+		installedJSON := dirs[0].Join("installed.json")
+		if installedJSON.Exist() {
+			if _, err := pm.LoadPackageIndexFromFile(installedJSON); err != nil {
+				return nil, fmt.Errorf(tr("loading %[1]s: %[2]s"), installedJSON, err)
+			}
+			release = platform.FindReleaseWithVersion(version)
+		}
+	}
+	if release == nil {
+		return nil, fmt.Errorf(tr("release %[1]s of platform %[2]s not found in the package index"), version, platform)
+	}
+	return release, nil
+}
+
+func removeIfEmpty(dir *paths.Path) {
+	if empty, err := resources.IsDirEmpty(dir); err == nil && empty {
+		dir.RemoveAll()
+	}
+}
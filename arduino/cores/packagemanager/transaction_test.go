@@ -0,0 +1,147 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package packagemanager_test
+
+import (
+	"archive/zip"
+	"crypto/sha256"
+	"fmt"
+	"testing"
+
+	"github.com/arduino/arduino-cli/arduino/cores"
+	"github.com/arduino/arduino-cli/arduino/cores/packagemanager"
+	"github.com/arduino/arduino-cli/arduino/resources"
+	"github.com/arduino/go-paths-helper"
+	"github.com/stretchr/testify/require"
+	semver "go.bug.st/relaxed-semver"
+)
+
+// createPlatformArchive creates in downloadDir a platform archive containing
+// a platform.txt with the given version and returns its DownloadResource
+func createPlatformArchive(t *testing.T, downloadDir *paths.Path, version string) *resources.DownloadResource {
+	archiveName := "test-" + version + ".zip"
+	f, err := downloadDir.Join(archiveName).Create()
+	require.NoError(t, err)
+	w := zip.NewWriter(f)
+	_, err = w.Create("test-" + version + "/")
+	require.NoError(t, err)
+	platformTxt, err := w.Create("test-" + version + "/platform.txt")
+	require.NoError(t, err)
+	_, err = platformTxt.Write([]byte("version=" + version + "\n"))
+	require.NoError(t, err)
+	require.NoError(t, w.Close())
+	require.NoError(t, f.Close())
+
+	data, err := downloadDir.Join(archiveName).ReadFile()
+	require.NoError(t, err)
+	return &resources.DownloadResource{
+		URL:             "http://example.com/" + archiveName,
+		ArchiveFileName: archiveName,
+		Checksum:        fmt.Sprintf("SHA-256:%x", sha256.Sum256(data)),
+		Size:            int64(len(data)),
+	}
+}
+
+func TestTransaction(t *testing.T) {
+	dataDir, err := paths.MkTempDir("", "test_transaction")
+	require.NoError(t, err)
+	defer dataDir.RemoveAll()
+	packagesDir := dataDir.Join("packages")
+	downloadDir := dataDir.Join("staging")
+	require.NoError(t, downloadDir.MkdirAll())
+	pm := packagemanager.NewPackageManager(dataDir, packagesDir, downloadDir, dataDir.Join("tmp"), "test")
+
+	platform := pm.Packages.GetOrCreatePackage("test").GetOrCreatePlatform("avr")
+	release1 := platform.GetOrCreateRelease(semver.MustParse("1.0.0"))
+	release1.Resource = createPlatformArchive(t, downloadDir, "1.0.0")
+	release2 := platform.GetOrCreateRelease(semver.MustParse("2.0.0"))
+	release2.Resource = createPlatformArchive(t, downloadDir, "2.0.0")
+	installDir1 := packagesDir.Join("test", "hardware", "avr", "1.0.0")
+	installDir2 := packagesDir.Join("test", "hardware", "avr", "2.0.0")
+
+	install := func(release *cores.PlatformRelease, replaced *cores.PlatformRelease) *packagemanager.Transaction {
+		tx, err := pm.NewTransaction()
+		require.NoError(t, err)
+		require.NoError(t, tx.StagePlatform(release))
+		if replaced != nil {
+			require.NoError(t, tx.RemovePlatform(replaced, true))
+		}
+		require.NoError(t, tx.Apply())
+		return tx
+	}
+
+	// Install 1.0.0
+	require.NoError(t, install(release1, nil).Commit())
+	require.Equal(t, installDir1.String(), release1.InstallDir.String())
+	require.FileExists(t, installDir1.Join("platform.txt").String())
+	require.FileExists(t, installDir1.Join("installed.json").String())
+	require.FileExists(t, installDir1.Join(resources.InstalledFilesManifestName).String())
+
+	// An upgrade rolled back leaves the installed release untouched
+	tx := install(release2, release1)
+	require.Nil(t, release1.InstallDir)
+	require.DirExists(t, installDir2.String())
+	require.NoError(t, tx.Rollback())
+	require.Nil(t, release2.InstallDir)
+	require.NoDirExists(t, installDir2.String())
+	require.Equal(t, installDir1.String(), release1.InstallDir.String())
+	require.FileExists(t, installDir1.Join("platform.txt").String())
+	_, err = pm.FindPlatformRollbackRelease(platform)
+	require.Error(t, err)
+
+	// A committed upgrade keeps the previous release for rollback
+	require.NoError(t, install(release2, release1).Commit())
+	require.NoDirExists(t, installDir1.String())
+	require.Equal(t, installDir2.String(), release2.InstallDir.String())
+	previous, err := pm.FindPlatformRollbackRelease(platform)
+	require.NoError(t, err)
+	require.Equal(t, release1, previous)
+
+	// Rollback to the previous release, the replaced one is kept in turn
+	tx, err = pm.NewTransaction()
+	require.NoError(t, err)
+	require.NoError(t, tx.RestorePlatform(previous))
+	require.NoError(t, tx.RemovePlatform(release2, true))
+	require.NoError(t, tx.Apply())
+	require.NoError(t, tx.Commit())
+	require.Equal(t, installDir1.String(), release1.InstallDir.String())
+	require.Nil(t, release2.InstallDir)
+	require.NoDirExists(t, installDir2.String())
+	previous, err = pm.FindPlatformRollbackRelease(platform)
+	require.NoError(t, err)
+	require.Equal(t, release2, previous)
+
+	// The kept release is found even if it's no longer in the package index
+	pm2 := packagemanager.NewPackageManager(dataDir, packagesDir, downloadDir, dataDir.Join("tmp"), "test")
+	platform2 := pm2.Packages.GetOrCreatePackage("test").GetOrCreatePlatform("avr")
+	platform2.GetOrCreateRelease(semver.MustParse("1.0.0"))
+	previous, err = pm2.FindPlatformRollbackRelease(platform2)
+	require.NoError(t, err)
+	require.Equal(t, "2.0.0", previous.Version.String())
+	require.Equal(t, release2.Resource.Checksum, previous.Resource.Checksum)
+
+	// Uninstall removes the release kept for rollback too
+	tx, err = pm.NewTransaction()
+	require.NoError(t, err)
+	require.NoError(t, tx.RemovePlatform(release1, false))
+	require.NoError(t, tx.Commit())
+	require.NoDirExists(t, installDir1.String())
+	_, err = pm.FindPlatformRollbackRelease(platform)
+	require.Error(t, err)
+	empty, err := resources.IsDirEmpty(dataDir.Join("tmp"))
+	require.NoError(t, err)
+	require.True(t, empty)
+}
@@ -18,6 +18,7 @@ package cores
 import (
 	"regexp"
 	"runtime"
+	"strings"
 
 	"github.com/arduino/arduino-cli/arduino/resources"
 	"github.com/arduino/go-paths-helper"
@@ -138,6 +139,27 @@ var (
 	regexpFreeBSD64  = regexp.MustCompile("amd64-freebsd[0-9]*")
 )
 
+// FlavorHostID returns the "os,arch" pair of the platform targeted by a
+// flavor with the given host triplet, "all" if the flavor runs everywhere or
+// an empty string if the host is not recognized.
+func FlavorHostID(host string) string {
+	if host == "all" {
+		return "all"
+	}
+	for _, id := range []string{
+		"linux,arm", "linux,arm64", "linux,amd64", "linux,386",
+		"windows,386", "windows,amd64",
+		"darwin,arm64", "darwin,amd64", "darwin,386",
+		"freebsd,arm", "freebsd,386", "freebsd,amd64",
+	} {
+		osArch := strings.SplitN(id, ",", 2)
+		if (&Flavor{OS: host}).isExactMatchWith(osArch[0], osArch[1]) {
+			return id
+		}
+	}
+	return ""
+}
+
 func (f *Flavor) isExactMatchWith(osName, osArch string) bool {
 	if f.OS == "all" {
 		return true
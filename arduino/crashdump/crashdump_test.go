@@ -0,0 +1,86 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package crashdump
+
+import (
+	"bytes"
+	"path"
+	"strings"
+	"testing"
+
+	"github.com/arduino/go-paths-helper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSymbolizer(t *testing.T) {
+	s, err := NewSymbolizer(paths.New("testdata", "crash.elf"))
+	require.NoError(t, err)
+
+	loc := s.Lookup(0x401010)
+	require.NotNil(t, loc)
+	require.Equal(t, "blink", loc.Function)
+	require.Equal(t, "crash.c", path.Base(loc.File))
+	require.Equal(t, 8, loc.Line)
+
+	loc = s.Lookup(0x401044)
+	require.NotNil(t, loc)
+	require.Equal(t, "loop", loc.Function)
+	require.Equal(t, 14, loc.Line)
+	require.Equal(t, "0x00401044: loop at /tmp/crash/crash.c:14", loc.String())
+
+	require.Nil(t, s.Lookup(0x10))
+	require.Nil(t, s.Lookup(0x500000))
+
+	_, err = NewSymbolizer(paths.New("testdata", "crash.c"))
+	require.Error(t, err)
+}
+
+func TestDecoder(t *testing.T) {
+	s, err := NewSymbolizer(paths.New("testdata", "crash.elf"))
+	require.NoError(t, err)
+
+	out := &bytes.Buffer{}
+	d := NewDecoder(out, s)
+
+	// ESP backtraces, split across multiple writes
+	d.Write([]byte("Guru Meditation Error: Core  1 panic'ed (LoadProhibited)\r\n"))
+	d.Write([]byte("Backtrace: 0x00401044:0x3ffb1f60 0x00401"))
+	require.Equal(t, "Guru Meditation Error: Core  1 panic'ed (LoadProhibited)\r\nBacktrace: 0x00401044:0x3ffb1f60 0x00401", out.String())
+	d.Write([]byte("051:0x3ffb1f80 0x40000000:0x3ffb1fa0\r\n"))
+	require.Equal(t, ""+
+		"Guru Meditation Error: Core  1 panic'ed (LoadProhibited)\r\n"+
+		"Backtrace: 0x00401044:0x3ffb1f60 0x00401051:0x3ffb1f80 0x40000000:0x3ffb1fa0\r\n"+
+		"  0x00401044: loop at /tmp/crash/crash.c:14\r\n"+
+		"  0x00401051: _start at /tmp/crash/crash.c:19\r\n", out.String())
+
+	// Register dumps
+	locs := d.DecodeLine("PC      : 0x00401010  PS      : 0x00060030  A0      : 0x00401044")
+	require.Len(t, locs, 1)
+	require.Equal(t, "blink", locs[0].Function)
+	locs = d.DecodeLine("HardFault! PC = 0x0040103d LR: 0x00401051 R0 = 0x00401010")
+	require.Len(t, locs, 2)
+	require.Equal(t, "loop", locs[0].Function)
+	require.Equal(t, "_start", locs[1].Function)
+	locs = d.DecodeLine("Exception (28): epc1=0x00401023 epc2=0x00000000 epc3=0x00000000 excvaddr=0x00000000")
+	require.Len(t, locs, 1)
+	require.Equal(t, 7, locs[0].Line)
+	require.Empty(t, d.DecodeLine("Hello world! counter=0x00401010"))
+
+	out.Reset()
+	err = Decode(strings.NewReader("boot\nepc1=0x00401023"), out, s)
+	require.NoError(t, err)
+	require.Equal(t, "boot\nepc1=0x00401023\n  0x00401023: blink at /tmp/crash/crash.c:7\n", out.String())
+}
@@ -0,0 +1,149 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package crashdump
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// ESP8266/ESP32 backtrace: "Backtrace: 0x400d1234:0x3ffb1f60 0x400d5678:0x3ffb1f80"
+var backtraceRegexp = regexp.MustCompile(`Backtrace:((?:\s*0x[0-9a-fA-F]+:0x[0-9a-fA-F]+)+)`)
+var backtracePCRegexp = regexp.MustCompile(`(0x[0-9a-fA-F]+):0x[0-9a-fA-F]+`)
+
+// Registers containing code addresses in exception dumps:
+// - ESP32 "PC      : 0x400d1234  PS      : 0x00060030"
+// - ESP8266 "epc1=0x40201234 epc2=0x00000000"
+// - Cortex-M HardFault handlers "PC = 0x000012ab", "LR: 0x00001235"
+var registerRegexp = regexp.MustCompile(`\b(?i:PC|LR|R14|R15|EPC[1-3])\s*[:=]\s*(0x[0-9a-fA-F]+)`)
+
+// Decoder is an io.Writer that forwards everything it receives to the
+// underlying writer and, after each line containing a crash dump (a backtrace
+// or a register dump), writes the source locations of the code addresses
+// found in the line.
+type Decoder struct {
+	out        io.Writer
+	symbolizer *Symbolizer
+
+	mutex sync.Mutex
+	line  []byte
+}
+
+// NewDecoder creates a new Decoder writing to out and using the given
+// Symbolizer to resolve addresses.
+func NewDecoder(out io.Writer, symbolizer *Symbolizer) *Decoder {
+	return &Decoder{out: out, symbolizer: symbolizer}
+}
+
+// Write implements io.Writer. Data is forwarded immediately, the decoded
+// locations are written as soon as the line is complete.
+func (d *Decoder) Write(data []byte) (int, error) {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
+	n, err := d.out.Write(data)
+	if err != nil {
+		return n, err
+	}
+	for len(data) > 0 {
+		nl := bytes.IndexByte(data, '\n')
+		if nl == -1 {
+			d.line = append(d.line, data...)
+			// Avoid unbounded growth on streams without newlines
+			if len(d.line) > 4096 {
+				d.line = d.line[len(d.line)-4096:]
+			}
+			break
+		}
+		d.line = append(d.line, data[:nl]...)
+		data = data[nl+1:]
+		err := d.writeLocations(string(d.line), "")
+		d.line = d.line[:0]
+		if err != nil {
+			return n, err
+		}
+	}
+	return n, nil
+}
+
+// writeLocations decodes the given line and writes the locations found
+func (d *Decoder) writeLocations(line string, prefix string) error {
+	decoded := d.DecodeLine(line)
+	if len(decoded) == 0 {
+		return nil
+	}
+	// Use the same line terminator of the input
+	eol := "\n"
+	if strings.HasSuffix(line, "\r") {
+		eol = "\r\n"
+	}
+	if _, err := io.WriteString(d.out, prefix); err != nil {
+		return err
+	}
+	for _, loc := range decoded {
+		if _, err := fmt.Fprintf(d.out, "  %s%s", loc, eol); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// DecodeLine returns the source locations of the code addresses contained in
+// the crash dump in the given line, if any.
+func (d *Decoder) DecodeLine(line string) []*Location {
+	addresses := []string{}
+	if m := backtraceRegexp.FindStringSubmatch(line); m != nil {
+		for _, pc := range backtracePCRegexp.FindAllStringSubmatch(m[1], -1) {
+			addresses = append(addresses, pc[1])
+		}
+	} else {
+		for _, reg := range registerRegexp.FindAllStringSubmatch(line, -1) {
+			addresses = append(addresses, reg[1])
+		}
+	}
+
+	res := []*Location{}
+	for _, address := range addresses {
+		addr, err := strconv.ParseUint(address[2:], 16, 64)
+		if err != nil || addr == 0 {
+			continue
+		}
+		if loc := d.symbolizer.Lookup(addr); loc != nil {
+			res = append(res, loc)
+		}
+	}
+	return res
+}
+
+// Decode copies the given input to the output, adding the decoded source
+// locations after each crash dump line.
+func Decode(in io.Reader, out io.Writer, symbolizer *Symbolizer) error {
+	decoder := NewDecoder(out, symbolizer)
+	_, err := io.Copy(decoder, in)
+	if err != nil {
+		return err
+	}
+	// Decode the last line if not terminated
+	if len(decoder.line) > 0 {
+		return decoder.writeLocations(string(decoder.line), "\n")
+	}
+	return nil
+}
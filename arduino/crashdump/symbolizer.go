@@ -0,0 +1,221 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package crashdump
+
+import (
+	"debug/dwarf"
+	"debug/elf"
+	"fmt"
+	"io"
+	"sort"
+
+	"github.com/arduino/arduino-cli/arduino/sketch"
+	"github.com/arduino/arduino-cli/i18n"
+	"github.com/arduino/go-paths-helper"
+	"github.com/pkg/errors"
+)
+
+var tr = i18n.Tr
+
+// Location is the source location of an address
+type Location struct {
+	Address  uint64
+	Function string
+	File     string
+	Line     int
+}
+
+func (l *Location) String() string {
+	res := fmt.Sprintf("0x%08x: ", l.Address)
+	if l.Function != "" {
+		res += l.Function
+	} else {
+		res += "??"
+	}
+	if l.File != "" {
+		res += fmt.Sprintf(" at %s:%d", l.File, l.Line)
+	}
+	return res
+}
+
+type lineEntry struct {
+	address     uint64
+	file        string
+	line        int
+	endSequence bool
+}
+
+type function struct {
+	name      string
+	low, high uint64
+}
+
+// Symbolizer resolves addresses to function names and source lines using
+// the symbols and the DWARF line tables of an ELF file.
+type Symbolizer struct {
+	thumb     bool
+	lines     []*lineEntry
+	functions []*function
+}
+
+// NewSymbolizer loads the symbols of the given ELF file
+func NewSymbolizer(elfPath *paths.Path) (*Symbolizer, error) {
+	f, err := elf.Open(elfPath.String())
+	if err != nil {
+		return nil, errors.Wrap(err, tr("opening ELF file"))
+	}
+	defer f.Close()
+
+	s := &Symbolizer{
+		// On ARM the lowest bit of code addresses selects the Thumb instruction set
+		thumb: f.Machine == elf.EM_ARM,
+	}
+	if d, err := f.DWARF(); err == nil {
+		if err := s.loadDWARF(d); err != nil {
+			return nil, errors.Wrap(err, tr("reading DWARF debug info"))
+		}
+	}
+	if syms, err := f.Symbols(); err == nil {
+		// Use the symbol table for the functions not described in DWARF
+		// (for example precompiled libraries)
+		known := map[uint64]bool{}
+		for _, fn := range s.functions {
+			known[fn.low] = true
+		}
+		for _, sym := range syms {
+			if elf.ST_TYPE(sym.Info) != elf.STT_FUNC || sym.Value == 0 {
+				continue
+			}
+			low := sym.Value
+			if s.thumb {
+				low &^= 1
+			}
+			if known[low] {
+				continue
+			}
+			size := sym.Size
+			if size == 0 {
+				size = 1
+			}
+			s.functions = append(s.functions, &function{name: sym.Name, low: low, high: low + size})
+		}
+	}
+	if len(s.functions) == 0 && len(s.lines) == 0 {
+		return nil, errors.New(tr("no symbols found in %s", elfPath))
+	}
+
+	sort.Slice(s.lines, func(i, j int) bool {
+		if s.lines[i].address == s.lines[j].address {
+			// Sequence ends must come before the start of the next sequence
+			return s.lines[i].endSequence && !s.lines[j].endSequence
+		}
+		return s.lines[i].address < s.lines[j].address
+	})
+	sort.Slice(s.functions, func(i, j int) bool {
+		return s.functions[i].low < s.functions[j].low
+	})
+	return s, nil
+}
+
+func (s *Symbolizer) loadDWARF(d *dwarf.Data) error {
+	r := d.Reader()
+	for {
+		entry, err := r.Next()
+		if err != nil {
+			return err
+		}
+		if entry == nil {
+			return nil
+		}
+		switch entry.Tag {
+		case dwarf.TagCompileUnit:
+			lr, err := d.LineReader(entry)
+			if err != nil {
+				return err
+			}
+			if lr == nil {
+				continue
+			}
+			var le dwarf.LineEntry
+			for {
+				if err := lr.Next(&le); err == io.EOF {
+					break
+				} else if err != nil {
+					return err
+				}
+				e := &lineEntry{address: le.Address, line: le.Line, endSequence: le.EndSequence}
+				if le.File != nil {
+					e.file = le.File.Name
+				}
+				s.lines = append(s.lines, e)
+			}
+		case dwarf.TagSubprogram:
+			name, _ := entry.Val(dwarf.AttrName).(string)
+			if name == "" {
+				continue
+			}
+			ranges, err := d.Ranges(entry)
+			if err != nil {
+				continue
+			}
+			for _, rng := range ranges {
+				s.functions = append(s.functions, &function{name: name, low: rng[0], high: rng[1]})
+			}
+		}
+	}
+}
+
+// SketchELF returns the path to the ELF file produced by the last build of
+// the given sketch in its default build path.
+func SketchELF(sk *sketch.Sketch) (*paths.Path, error) {
+	elfPath := sk.BuildPath.Join(sk.Name + ".ino.elf")
+	if !elfPath.Exist() {
+		return nil, errors.New(tr("ELF file %s not found, please compile the sketch first", elfPath))
+	}
+	return elfPath, nil
+}
+
+// Lookup returns the source location of the given address, or nil if the
+// address is not part of the program code.
+func (s *Symbolizer) Lookup(address uint64) *Location {
+	addr := address
+	if s.thumb {
+		addr &^= 1
+	}
+
+	loc := &Location{Address: address}
+	// Find the innermost function containing the address
+	i := sort.Search(len(s.functions), func(i int) bool { return s.functions[i].low > addr })
+	for j := i - 1; j >= 0; j-- {
+		fn := s.functions[j]
+		if addr >= fn.low && addr < fn.high {
+			loc.Function = fn.name
+			break
+		}
+	}
+
+	// Find the last line entry at or before the address
+	i = sort.Search(len(s.lines), func(i int) bool { return s.lines[i].address > addr })
+	if i > 0 && !s.lines[i-1].endSequence {
+		loc.File = s.lines[i-1].file
+		loc.Line = s.lines[i-1].line
+	}
+
+	if loc.Function == "" && loc.File == "" {
+		return nil
+	}
+	return loc
+}
@@ -0,0 +1,21 @@
+// Source of crash.elf, built with:
+// gcc -g -O0 -fdebug-prefix-map=$PWD=/tmp/crash -nostdlib -static -fno-asynchronous-unwind-tables -Wl,--build-id=none -o crash.elf crash.c
+
+volatile int counter;
+
+void blink(int times) {
+  for (int i = 0; i < times; i++) {
+    counter++;
+  }
+}
+
+void loop(void) {
+  blink(3);
+  counter = *(volatile int *)0;
+}
+
+void _start(void) {
+  for (;;) {
+    loop();
+  }
+}
@@ -376,6 +376,22 @@ func (e *LibraryDependenciesResolutionFailedError) Unwrap() error {
 	return e.Cause
 }
 
+// LibraryRequiredByOthersError is returned when a library can't be
+// uninstalled because other installed libraries depend on it
+type LibraryRequiredByOthersError struct {
+	Library    string
+	Dependents []string
+}
+
+func (e *LibraryRequiredByOthersError) Error() string {
+	return tr("Library %[1]s is required by: %[2]s", e.Library, strings.Join(e.Dependents, ", "))
+}
+
+// ToRPCStatus converts the error into a *status.Status
+func (e *LibraryRequiredByOthersError) ToRPCStatus() *status.Status {
+	return status.New(codes.FailedPrecondition, e.Error())
+}
+
 // PlatformAlreadyAtTheLatestVersionError is returned when a platform is up to date
 type PlatformAlreadyAtTheLatestVersionError struct {
 	Platform string
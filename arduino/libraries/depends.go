@@ -0,0 +1,89 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package libraries
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	paths "github.com/arduino/go-paths-helper"
+	semver "go.bug.st/relaxed-semver"
+)
+
+// DependencyMarkerFileName is the name of the file, in the root of the
+// library, that marks a library installed only to satisfy the dependencies
+// of other libraries
+const DependencyMarkerFileName = ".arduino-dependency"
+
+var (
+	validLibraryName = regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9 _.\-]*$`)
+	dependencyRegexp = regexp.MustCompile(`^([^()]+?)\s*(?:\((.*)\))?$`)
+)
+
+// Dependency is a library required by another library, as declared in the
+// depends property of library.properties
+type Dependency struct {
+	Name string
+	// VersionConstraint is empty if any version is accepted
+	VersionConstraint string
+}
+
+// ParseDepends parses the comma separated list of dependencies of the
+// depends property, in the form "Name" or "Name (version constraint)"
+func ParseDepends(depends string) ([]*Dependency, error) {
+	res := []*Dependency{}
+	if strings.TrimSpace(depends) == "" {
+		return res, nil
+	}
+	for _, dep := range strings.Split(depends, ",") {
+		dep = strings.TrimSpace(dep)
+		match := dependencyRegexp.FindStringSubmatch(dep)
+		if match == nil || !validLibraryName.MatchString(match[1]) {
+			return nil, fmt.Errorf(tr("invalid dependency %q"), dep)
+		}
+		constraint := strings.TrimSpace(match[2])
+		if constraint != "" {
+			if _, err := semver.ParseConstraint(constraint); err != nil {
+				return nil, fmt.Errorf(tr("invalid version constraint in dependency %[1]q: %[2]s"), dep, err)
+			}
+		}
+		res = append(res, &Dependency{Name: match[1], VersionConstraint: constraint})
+	}
+	return res, nil
+}
+
+// Dependencies returns the dependencies declared in the library.properties
+// of the library
+func (library *Library) Dependencies() ([]*Dependency, error) {
+	if library.Properties == nil {
+		return []*Dependency{}, nil
+	}
+	return ParseDepends(library.Properties.Get("depends"))
+}
+
+// SetInstalledAsDependency marks the library installed in libDir as installed
+// only to satisfy the dependencies of other libraries, or removes the mark.
+func SetInstalledAsDependency(libDir *paths.Path, dependency bool) error {
+	marker := libDir.Join(DependencyMarkerFileName)
+	if dependency {
+		return marker.WriteFile([]byte{})
+	}
+	if marker.Exist() {
+		return marker.Remove()
+	}
+	return nil
+}
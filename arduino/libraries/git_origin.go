@@ -0,0 +1,78 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package libraries
+
+import (
+	"encoding/json"
+	"fmt"
+
+	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
+	paths "github.com/arduino/go-paths-helper"
+)
+
+// GitOriginFileName is the name of the file, in the root of the library, that
+// records the origin of a library installed from a git repository
+const GitOriginFileName = ".arduino-git.json"
+
+// GitOrigin describes the git repository a library has been installed from
+type GitOrigin struct {
+	// URL is the URL of the repository
+	URL string `json:"url"`
+	// Ref is the tag, branch or commit requested by the user, empty if the
+	// default branch has been installed
+	Ref string `json:"ref,omitempty"`
+	// Commit is the hash of the installed commit
+	Commit string `json:"commit"`
+}
+
+// LoadGitOrigin reads the git origin of the library installed in libDir.
+// It returns nil if the library has not been installed from git.
+func LoadGitOrigin(libDir *paths.Path) (*GitOrigin, error) {
+	originFile := libDir.Join(GitOriginFileName)
+	if !originFile.Exist() {
+		return nil, nil
+	}
+	data, err := originFile.ReadFile()
+	if err != nil {
+		return nil, fmt.Errorf(tr("reading %[1]s: %[2]s"), originFile, err)
+	}
+	var origin GitOrigin
+	if err := json.Unmarshal(data, &origin); err != nil {
+		return nil, fmt.Errorf(tr("reading %[1]s: %[2]s"), originFile, err)
+	}
+	return &origin, nil
+}
+
+// Save writes the git origin in the root of the library installed in libDir
+func (o *GitOrigin) Save(libDir *paths.Path) error {
+	data, err := json.MarshalIndent(o, "", "  ")
+	if err != nil {
+		return err
+	}
+	return libDir.Join(GitOriginFileName).WriteFile(data)
+}
+
+// ToRPCGitOrigin converts this GitOrigin into an rpc.LibraryGitOrigin
+func (o *GitOrigin) ToRPCGitOrigin() *rpc.LibraryGitOrigin {
+	if o == nil {
+		return nil
+	}
+	return &rpc.LibraryGitOrigin{
+		Url:    o.URL,
+		Ref:    o.Ref,
+		Commit: o.Commit,
+	}
+}
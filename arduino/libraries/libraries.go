@@ -81,6 +81,8 @@ type Library struct {
 	declaredHeaders        []string
 	sourceHeaders          []string
 	CompatibleWith         map[string]bool
+	GitOrigin              *GitOrigin
+	InstalledAsDependency  bool
 }
 
 func (library *Library) String() string {
@@ -142,6 +144,7 @@ func (library *Library) ToRPCLibrary() (*rpc.Library, error) {
 		Examples:          library.Examples.AsStrings(),
 		ProvidesIncludes:  headers,
 		CompatibleWith:    library.CompatibleWith,
+		GitOrigin:         library.GitOrigin.ToRPCGitOrigin(),
 	}, nil
 }
 
@@ -207,7 +210,9 @@ func (library *Library) SourceDirs() []SourceDir {
 // LocationPriorityFor returns a number representing the location priority for the given library
 // using the given platform and referenced-platform. Higher value means higher priority.
 func (library *Library) LocationPriorityFor(platformRelease, refPlatformRelease *cores.PlatformRelease) int {
-	if library.Location == IDEBuiltIn {
+	if library.Location == Sketch {
+		return 5
+	} else if library.Location == IDEBuiltIn {
 		return 1
 	} else if library.ContainerPlatform == refPlatformRelease {
 		return 2
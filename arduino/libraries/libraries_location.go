@@ -38,6 +38,8 @@ const (
 	// Unmanaged is for libraries set manually by the user in the CLI command or from the gRPC function.
 	// Ideally it's used for `libraries` outside folders managed by the CLI.
 	Unmanaged
+	// Sketch are libraries vendored in the `libraries` folder of the sketch being built
+	Sketch
 )
 
 func (d *LibraryLocation) String() string {
@@ -52,6 +54,8 @@ func (d *LibraryLocation) String() string {
 		return "user"
 	case Unmanaged:
 		return "unmanaged"
+	case Sketch:
+		return "sketch"
 	}
 	panic(fmt.Sprintf("invalid LibraryLocation value %d", *d))
 }
@@ -69,6 +73,8 @@ func (d *LibraryLocation) MarshalJSON() ([]byte, error) {
 		return json.Marshal("user")
 	case Unmanaged:
 		return json.Marshal("unmanaged")
+	case Sketch:
+		return json.Marshal("sketch")
 	}
 	return nil, fmt.Errorf(tr("invalid library location value: %d"), *d)
 }
@@ -90,6 +96,8 @@ func (d *LibraryLocation) UnmarshalJSON(b []byte) error {
 		*d = User
 	case "unmanaged":
 		*d = Unmanaged
+	case "sketch":
+		*d = Sketch
 	}
 	return fmt.Errorf(tr("invalid library location: %s"), s)
 }
@@ -107,6 +115,8 @@ func (d *LibraryLocation) ToRPCLibraryLocation() rpc.LibraryLocation {
 		return rpc.LibraryLocation_LIBRARY_LOCATION_USER
 	case Unmanaged:
 		return rpc.LibraryLocation_LIBRARY_LOCATION_UNMANAGED
+	case Sketch:
+		return rpc.LibraryLocation_LIBRARY_LOCATION_SKETCH
 	}
 	panic(fmt.Sprintf("invalid LibraryLocation value %d", *d))
 }
@@ -124,6 +134,8 @@ func FromRPCLibraryLocation(l rpc.LibraryLocation) LibraryLocation {
 		return User
 	case rpc.LibraryLocation_LIBRARY_LOCATION_UNMANAGED:
 		return Unmanaged
+	case rpc.LibraryLocation_LIBRARY_LOCATION_SKETCH:
+		return Sketch
 	}
 	panic(fmt.Sprintf("invalid rpc.LibraryLocation value %d", l))
 }
@@ -88,7 +88,7 @@ func TestIndexer(t *testing.T) {
 	rtcInexistent2 := index.FindLibraryUpdate(&libraries.Library{Name: "RTCZero-blah", Version: semver.MustParse("1.0.0")})
 	require.Nil(t, rtcInexistent2)
 
-	resolve1 := index.ResolveDependencies(alp.Releases["1.2.1"])
+	resolve1 := resolveReleases(t, index, alp.Releases["1.2.1"])
 	require.Len(t, resolve1, 2)
 	require.Contains(t, resolve1, alp.Releases["1.2.1"])
 	require.Contains(t, resolve1, rtc.Releases["1.6.0"])
@@ -106,10 +106,20 @@ func TestIndexer(t *testing.T) {
 	require.NotNil(t, http040)
 	require.Equal(t, "ArduinoHttpClient@0.4.0", http040.String())
 
-	resolve2 := index.ResolveDependencies(oauth010)
+	resolve2 := resolveReleases(t, index, oauth010)
 	require.Len(t, resolve2, 4)
 	require.Contains(t, resolve2, oauth010)
 	require.Contains(t, resolve2, eccx133)
 	require.Contains(t, resolve2, bear130)
 	require.Contains(t, resolve2, http040)
 }
+
+func resolveReleases(t *testing.T, index *Index, release *Release) []*Release {
+	resolved, err := index.NewDependencyResolver(nil, nil).Resolve(&Reference{Name: release.GetName(), Version: release.Version})
+	require.NoError(t, err)
+	res := []*Release{}
+	for _, lib := range resolved {
+		res = append(res, lib.Release)
+	}
+	return res
+}
@@ -16,9 +16,11 @@
 package librariesindex
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
 
+	"github.com/arduino/arduino-cli/arduino/libraries"
 	"github.com/arduino/arduino-cli/arduino/resources"
 	"github.com/arduino/arduino-cli/i18n"
 	"github.com/arduino/go-paths-helper"
@@ -146,3 +148,54 @@ func (indexDep *indexDependency) extractDependency() *Dependency {
 		VersionConstraint: constraint,
 	}
 }
+
+// ReleaseEntry returns the library_index.json entry of the release of a
+// library, the resource is the archive of the release.
+func ReleaseEntry(lib *libraries.Library, resource *resources.DownloadResource) ([]byte, error) {
+	if lib.Version == nil {
+		return nil, fmt.Errorf(tr("missing library version"))
+	}
+	depends, err := libraries.ParseDepends(lib.Properties.Get("depends"))
+	if err != nil {
+		return nil, err
+	}
+	dependencies := []*indexDependency{}
+	for _, dep := range depends {
+		dependencies = append(dependencies, &indexDependency{Name: dep.Name, Version: dep.VersionConstraint})
+	}
+	includes := lib.DeclaredHeaders()
+	if len(includes) == 0 {
+		if includes, err = lib.SourceHeaders(); err != nil {
+			return nil, fmt.Errorf(tr("reading library headers: %w"), err)
+		}
+	}
+
+	entry := &indexRelease{
+		Name:             lib.RealName,
+		Version:          lib.Version,
+		Author:           lib.Author,
+		Maintainer:       lib.Maintainer,
+		Sentence:         lib.Sentence,
+		Paragraph:        lib.Paragraph,
+		Website:          lib.Website,
+		Category:         lib.Category,
+		Architectures:    lib.Architectures,
+		Types:            []string{"Contributed"},
+		URL:              resource.URL,
+		ArchiveFileName:  resource.ArchiveFileName,
+		Size:             resource.Size,
+		Checksum:         resource.Checksum,
+		Dependencies:     dependencies,
+		License:          lib.License,
+		ProvidesIncludes: includes,
+	}
+	// Version constraints must not be HTML escaped to keep the entry readable
+	buff := &bytes.Buffer{}
+	encoder := json.NewEncoder(buff)
+	encoder.SetEscapeHTML(false)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(entry); err != nil {
+		return nil, err
+	}
+	return bytes.TrimSpace(buff.Bytes()), nil
+}
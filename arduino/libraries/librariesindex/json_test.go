@@ -0,0 +1,67 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package librariesindex
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/arduino/arduino-cli/arduino/libraries"
+	"github.com/arduino/arduino-cli/arduino/resources"
+	"github.com/arduino/go-paths-helper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReleaseEntry(t *testing.T) {
+	libDir := paths.New(t.TempDir()).Join("My_Lib")
+	require.NoError(t, libDir.Join("src").MkdirAll())
+	require.NoError(t, libDir.Join("src", "MyLib.h").WriteFile([]byte{}))
+	require.NoError(t, libDir.Join("library.properties").WriteFile([]byte(
+		"name=My Lib\nversion=1.2.3\nauthor=Me\nmaintainer=Me <me@example.com>\n"+
+			"sentence=A library\nparagraph=Does things\nurl=https://example.com\n"+
+			"category=Timing\narchitectures=avr\ndepends=Servo, Wire (>=1.0.0)\n")))
+	lib, err := libraries.Load(libDir, libraries.User)
+	require.NoError(t, err)
+
+	entry, err := ReleaseEntry(lib, &resources.DownloadResource{
+		URL:             "https://example.com/My_Lib-1.2.3.zip",
+		ArchiveFileName: "My_Lib-1.2.3.zip",
+		Size:            1234,
+		Checksum:        "SHA-256:abcd",
+	})
+	require.NoError(t, err)
+
+	// The entry must be loadable as part of a library index
+	var i indexJSON
+	require.NoError(t, json.Unmarshal([]byte(`{"libraries":[`+string(entry)+`]}`), &i))
+	index, err := i.extractIndex()
+	require.NoError(t, err)
+	release := index.FindRelease(&Reference{Name: "My Lib"})
+	require.NotNil(t, release)
+	require.Equal(t, "1.2.3", release.Version.String())
+	require.Equal(t, "Me <me@example.com>", release.Maintainer)
+	require.Equal(t, "https://example.com", release.Website)
+	require.Equal(t, "Timing", release.Category)
+	require.Equal(t, []string{"avr"}, release.Architectures)
+	require.Equal(t, []string{"MyLib.h"}, release.ProvidesIncludes)
+	require.Equal(t, "My_Lib-1.2.3.zip", release.Resource.ArchiveFileName)
+	require.Equal(t, "SHA-256:abcd", release.Resource.Checksum)
+	require.Equal(t, int64(1234), release.Resource.Size)
+	require.Len(t, release.Dependencies, 2)
+	require.Equal(t, "Servo", release.Dependencies[0].GetName())
+	require.Equal(t, "Wire", release.Dependencies[1].GetName())
+	require.Equal(t, ">=1.0.0", release.Dependencies[1].GetConstraint().String())
+}
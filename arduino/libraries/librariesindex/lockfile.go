@@ -0,0 +1,53 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package librariesindex
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/arduino/go-paths-helper"
+	semver "go.bug.st/relaxed-semver"
+)
+
+// Lockfile pins the versions of the libraries used by a project, it's a
+// JSON file in the form:
+//
+//	{
+//	  "libraries": {
+//	    "Servo": "1.1.8",
+//	    "ArduinoJson": "6.18.5"
+//	  }
+//	}
+type Lockfile struct {
+	Libraries map[string]*semver.Version `json:"libraries"`
+}
+
+// LoadLockfile reads the lockfile at the given path
+func LoadLockfile(path *paths.Path) (*Lockfile, error) {
+	data, err := path.ReadFile()
+	if err != nil {
+		return nil, fmt.Errorf(tr("reading lockfile: %s"), err)
+	}
+	lockfile := &Lockfile{}
+	if err := json.Unmarshal(data, lockfile); err != nil {
+		return nil, fmt.Errorf(tr("parsing lockfile %[1]s: %[2]s"), path, err)
+	}
+	if lockfile.Libraries == nil {
+		lockfile.Libraries = map[string]*semver.Version{}
+	}
+	return lockfile, nil
+}
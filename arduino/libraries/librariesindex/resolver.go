@@ -0,0 +1,357 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package librariesindex
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/arduino/arduino-cli/arduino/libraries"
+	"github.com/sirupsen/logrus"
+	semver "go.bug.st/relaxed-semver"
+)
+
+// maxResolutionSteps bounds the number of candidates tried by the resolver,
+// to fail in a reasonable time on pathological indexes
+const maxResolutionSteps = 100000
+
+// ResolvedLibrary is a library selected by the dependency resolver
+type ResolvedLibrary struct {
+	Name    string
+	Version *semver.Version
+	// Release is the release in the index, nil if the library is not indexed
+	Release *Release
+	// Installed is the installed library satisfying the requirements, nil if
+	// the library must be installed
+	Installed *libraries.Library
+}
+
+// DependencyConflictError is returned when the requirements on a library
+// can't be satisfied at the same time
+type DependencyConflictError struct {
+	Library      string
+	Requirements []string
+	Available    []*semver.Version
+}
+
+func (e *DependencyConflictError) Error() string {
+	if len(e.Available) == 0 {
+		return tr("library %[1]s is not available, it's required by:\n%[2]s",
+			e.Library, "  "+strings.Join(e.Requirements, "\n  "))
+	}
+	versions := []string{}
+	for _, v := range e.Available {
+		versions = append(versions, v.String())
+	}
+	return tr("no version of %[1]s satisfies all the requirements:\n%[2]s\navailable versions: %[3]s",
+		e.Library, "  "+strings.Join(e.Requirements, "\n  "), strings.Join(versions, ", "))
+}
+
+// requirement is a constraint on the version of a library
+type requirement struct {
+	name       string
+	constraint semver.Constraint
+	// requiredBy is the library that declared the requirement, empty if
+	// requested by the user
+	requiredBy string
+}
+
+func (r *requirement) String() string {
+	constraint := ""
+	if r.constraint != nil && r.constraint.String() != "" {
+		constraint = " (" + r.constraint.String() + ")"
+	}
+	if r.requiredBy == "" {
+		return tr("%[1]s%[2]s requested", r.name, constraint)
+	}
+	return tr("%[1]s%[2]s required by %[3]s", r.name, constraint, r.requiredBy)
+}
+
+func (r *requirement) match(v *semver.Version) bool {
+	return r.constraint == nil || r.constraint.Match(v)
+}
+
+// candidate is a version of a library that may be selected
+type candidate struct {
+	version   *semver.Version
+	release   *Release
+	installed *libraries.Library
+}
+
+// dependencies returns the dependencies of the candidate from the index or,
+// for the installed libraries not available in the index, from the depends
+// property of library.properties
+func (c *candidate) dependencies() []semver.Dependency {
+	if c.release != nil {
+		return c.release.Dependencies
+	}
+	if c.installed == nil {
+		return nil
+	}
+	depends, err := c.installed.Dependencies()
+	if err != nil {
+		logrus.WithError(err).WithField("library", c.installed.Name).Warn("Invalid dependencies of installed library")
+		return nil
+	}
+	res := []semver.Dependency{}
+	for _, dep := range depends {
+		indexDep := &indexDependency{Name: dep.Name, Version: dep.VersionConstraint}
+		res = append(res, indexDep.extractDependency())
+	}
+	return res
+}
+
+// DependencyResolver finds a set of library releases that satisfies the
+// dependencies of the requested libraries
+type DependencyResolver struct {
+	index     *Index
+	installed map[string]libraries.List
+	pinned    map[string]*semver.Version
+
+	requirements map[string][]*requirement
+	selected     map[string]*candidate
+	order        []string
+	steps        int
+	conflict     *DependencyConflictError
+	conflictAt   int
+}
+
+// NewDependencyResolver creates a resolver using the releases of the index.
+// Installed libraries, including the ones bundled with platforms, are
+// preferred to satisfy the dependencies and allow to resolve dependencies
+// not available in the index. Pinned versions, usually loaded from a
+// lockfile, are the only versions allowed for the respective libraries.
+func (idx *Index) NewDependencyResolver(installed libraries.List, pinned map[string]*semver.Version) *DependencyResolver {
+	res := &DependencyResolver{
+		index:     idx,
+		installed: map[string]libraries.List{},
+		pinned:    pinned,
+	}
+	if res.pinned == nil {
+		res.pinned = map[string]*semver.Version{}
+	}
+	for _, lib := range installed {
+		res.installed[lib.Name] = append(res.installed[lib.Name], lib)
+	}
+	return res
+}
+
+// Resolve returns the libraries to install to satisfy the dependencies of
+// the given libraries, the requested libraries are included. If a Reference
+// has no version the newest release is preferred. If no solution is found a
+// DependencyConflictError explains the requirements that can't be satisfied.
+func (r *DependencyResolver) Resolve(refs ...*Reference) ([]*ResolvedLibrary, error) {
+	r.requirements = map[string][]*requirement{}
+	r.selected = map[string]*candidate{}
+	r.order = []string{}
+	r.steps = 0
+	r.conflict = nil
+	r.conflictAt = -1
+
+	roots := map[string]bool{}
+	for _, ref := range refs {
+		req := &requirement{name: ref.Name}
+		if ref.Version != nil {
+			req.constraint = &semver.Equals{Version: ref.Version}
+		}
+		r.addRequirement(req)
+		roots[ref.Name] = true
+	}
+
+	if !r.resolve(roots, 0) {
+		if r.conflict == nil {
+			return nil, fmt.Errorf(tr("dependency resolution is too complex"))
+		}
+		return nil, r.conflict
+	}
+
+	res := []*ResolvedLibrary{}
+	for _, name := range r.order {
+		c := r.selected[name]
+		res = append(res, &ResolvedLibrary{
+			Name:      name,
+			Version:   c.version,
+			Release:   c.release,
+			Installed: c.installed,
+		})
+	}
+	return res, nil
+}
+
+func (r *DependencyResolver) addRequirement(req *requirement) {
+	if _, ok := r.requirements[req.name]; !ok {
+		r.order = append(r.order, req.name)
+	}
+	r.requirements[req.name] = append(r.requirements[req.name], req)
+}
+
+func (r *DependencyResolver) removeLastRequirement(name string) {
+	reqs := r.requirements[name]
+	if len(reqs) == 1 {
+		delete(r.requirements, name)
+		r.order = r.order[:len(r.order)-1]
+		return
+	}
+	r.requirements[name] = reqs[:len(reqs)-1]
+}
+
+// resolve selects a candidate for the first library without one and recurses,
+// backtracking on failures.
+func (r *DependencyResolver) resolve(roots map[string]bool, depth int) bool {
+	name := ""
+	for _, n := range r.order {
+		if _, ok := r.selected[n]; !ok {
+			name = n
+			break
+		}
+	}
+	if name == "" {
+		// All the required libraries have been selected
+		return true
+	}
+
+	candidates := r.candidatesFor(name, roots[name])
+	found := false
+	for _, c := range candidates {
+		if !r.satisfies(name, c) {
+			continue
+		}
+		found = true
+		r.steps++
+		if r.steps > maxResolutionSteps {
+			r.conflict = nil
+			return false
+		}
+
+		// Select the candidate and add its dependencies, checking that
+		// they don't clash with already selected libraries
+		r.selected[name] = c
+		added := []string{}
+		compatible := true
+		for _, dep := range c.dependencies() {
+			req := &requirement{name: dep.GetName(), constraint: dep.GetConstraint(), requiredBy: name + "@" + c.version.String()}
+			r.addRequirement(req)
+			added = append(added, req.name)
+			if sel, ok := r.selected[req.name]; ok && !req.match(sel.version) {
+				r.recordConflict(req.name, depth)
+				compatible = false
+				break
+			}
+		}
+		if compatible && r.resolve(roots, depth+1) {
+			return true
+		}
+		if r.steps > maxResolutionSteps {
+			return false
+		}
+		for i := len(added) - 1; i >= 0; i-- {
+			r.removeLastRequirement(added[i])
+		}
+		delete(r.selected, name)
+	}
+	if !found {
+		r.recordConflict(name, depth)
+	}
+	return false
+}
+
+// satisfies returns true if the candidate matches all the requirements on
+// the library and the pinned version
+func (r *DependencyResolver) satisfies(name string, c *candidate) bool {
+	if pin, ok := r.pinned[name]; ok && !pin.Equal(c.version) {
+		return false
+	}
+	for _, req := range r.requirements[name] {
+		if !req.match(c.version) {
+			return false
+		}
+	}
+	return true
+}
+
+// recordConflict keeps the explanation of the deepest failure, that is
+// usually the closest to a solution and the most meaningful for the user
+func (r *DependencyResolver) recordConflict(name string, depth int) {
+	if depth <= r.conflictAt {
+		return
+	}
+	conflict := &DependencyConflictError{Library: name}
+	for _, req := range r.requirements[name] {
+		conflict.Requirements = append(conflict.Requirements, req.String())
+	}
+	if pin, ok := r.pinned[name]; ok {
+		conflict.Requirements = append(conflict.Requirements, tr("%[1]s pinned to %[2]s by the lockfile", name, pin))
+	}
+	for _, c := range r.candidatesFor(name, false) {
+		conflict.Available = append(conflict.Available, c.version)
+	}
+	sort.Sort(semver.List(conflict.Available))
+	r.conflict = conflict
+	r.conflictAt = depth
+}
+
+// candidatesFor returns all the versions of the library in order of
+// preference: for the requested libraries the newest releases come first,
+// for the dependencies the installed versions are preferred to avoid
+// unneeded upgrades.
+func (r *DependencyResolver) candidatesFor(name string, preferNewest bool) []*candidate {
+	byVersion := map[string]*candidate{}
+	indexed := []*candidate{}
+	if lib, ok := r.index.Libraries[name]; ok {
+		for _, release := range lib.Releases {
+			c := &candidate{version: release.Version, release: release}
+			byVersion[release.Version.String()] = c
+			indexed = append(indexed, c)
+		}
+	}
+	sort.Slice(indexed, func(i, j int) bool { return indexed[i].version.GreaterThan(indexed[j].version) })
+
+	installed := []*candidate{}
+	for _, lib := range r.installed[name] {
+		if lib.Version == nil {
+			continue
+		}
+		if c, ok := byVersion[lib.Version.String()]; ok {
+			if c.installed == nil {
+				c.installed = lib
+				installed = append(installed, c)
+			}
+			continue
+		}
+		c := &candidate{version: lib.Version, installed: lib}
+		byVersion[lib.Version.String()] = c
+		installed = append(installed, c)
+	}
+
+	res := []*candidate{}
+	if preferNewest {
+		res = append(res, indexed...)
+		for _, c := range installed {
+			if c.release == nil {
+				res = append(res, c)
+			}
+		}
+		return res
+	}
+	res = append(res, installed...)
+	for _, c := range indexed {
+		if c.installed == nil {
+			res = append(res, c)
+		}
+	}
+	return res
+}
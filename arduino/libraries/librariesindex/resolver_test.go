@@ -0,0 +1,160 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package librariesindex
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/arduino/arduino-cli/arduino/libraries"
+	"github.com/arduino/go-paths-helper"
+	properties "github.com/arduino/go-properties-orderedmap"
+	"github.com/stretchr/testify/require"
+	semver "go.bug.st/relaxed-semver"
+)
+
+// addRelease adds a release to the index, deps are in the form "Name" or
+// "Name (constraint)"
+func addRelease(idx *Index, name, version string, deps ...string) {
+	lib, ok := idx.Libraries[name]
+	if !ok {
+		lib = &Library{Name: name, Releases: map[string]*Release{}, Index: idx}
+		idx.Libraries[name] = lib
+	}
+	release := &Release{Version: semver.MustParse(version), Library: lib}
+	for _, dep := range deps {
+		d := &Dependency{Name: dep, VersionConstraint: &semver.True{}}
+		if i := strings.Index(dep, " ("); i != -1 {
+			constraint, err := semver.ParseConstraint(dep[i+2 : len(dep)-1])
+			if err != nil {
+				panic(err)
+			}
+			d = &Dependency{Name: dep[:i], VersionConstraint: constraint}
+		}
+		release.Dependencies = append(release.Dependencies, d)
+	}
+	lib.Releases[version] = release
+	if lib.Latest == nil || release.Version.GreaterThan(lib.Latest.Version) {
+		lib.Latest = release
+	}
+}
+
+func resolvedToStrings(resolved []*ResolvedLibrary) []string {
+	res := []string{}
+	for _, lib := range resolved {
+		s := lib.Name + "@" + lib.Version.String()
+		if lib.Installed != nil {
+			s += " (installed)"
+		}
+		res = append(res, s)
+	}
+	return res
+}
+
+func TestDependencyResolver(t *testing.T) {
+	idx := &Index{Libraries: map[string]*Library{}}
+	addRelease(idx, "A", "1.0.0", "C (<2.0.0)")
+	addRelease(idx, "A", "2.0.0", "C (>=2.0.0)")
+	addRelease(idx, "B", "1.0.0", "C (=1.5.0)")
+	addRelease(idx, "C", "1.0.0")
+	addRelease(idx, "C", "1.5.0")
+	addRelease(idx, "C", "2.0.0")
+	addRelease(idx, "D", "1.0.0", "Wire", "Missing")
+	addRelease(idx, "E", "1.0.0", "Wire")
+
+	resolver := idx.NewDependencyResolver(nil, nil)
+	resolved, err := resolver.Resolve(&Reference{Name: "A"})
+	require.NoError(t, err)
+	require.Equal(t, []string{"A@2.0.0", "C@2.0.0"}, resolvedToStrings(resolved))
+
+	// Multiple roots: the resolver backtracks to an older A compatible with B
+	resolved, err = resolver.Resolve(&Reference{Name: "A"}, &Reference{Name: "B"})
+	require.NoError(t, err)
+	require.Equal(t, []string{"A@1.0.0", "B@1.0.0", "C@1.5.0"}, resolvedToStrings(resolved))
+
+	// Conflicts are explained
+	_, err = resolver.Resolve(&Reference{Name: "A", Version: semver.MustParse("2.0.0")}, &Reference{Name: "B"})
+	require.Error(t, err)
+	conflict, ok := err.(*DependencyConflictError)
+	require.True(t, ok)
+	require.Equal(t, "C", conflict.Library)
+	require.Equal(t, []string{"C (>=2.0.0) required by A@2.0.0", "C (=1.5.0) required by B@1.0.0"}, conflict.Requirements)
+	require.Contains(t, err.Error(), "available versions: 1.0.0, 1.5.0, 2.0.0")
+
+	_, err = resolver.Resolve(&Reference{Name: "D"})
+	require.Error(t, err)
+	require.Equal(t, "Wire", err.(*DependencyConflictError).Library)
+
+	// Installed and platform bundled libraries are taken into account
+	installed := libraries.List{
+		{Name: "C", Version: semver.MustParse("1.0.0"), Location: libraries.User},
+		{Name: "Wire", Version: semver.MustParse("1.0.0"), Location: libraries.PlatformBuiltIn},
+	}
+	resolver = idx.NewDependencyResolver(installed, nil)
+	resolved, err = resolver.Resolve(&Reference{Name: "A"})
+	require.NoError(t, err)
+	require.Equal(t, []string{"A@2.0.0", "C@2.0.0"}, resolvedToStrings(resolved))
+	resolved, err = resolver.Resolve(&Reference{Name: "A", Version: semver.MustParse("1.0.0")})
+	require.NoError(t, err)
+	require.Equal(t, []string{"A@1.0.0", "C@1.0.0 (installed)"}, resolvedToStrings(resolved))
+	resolved, err = resolver.Resolve(&Reference{Name: "E"})
+	require.NoError(t, err)
+	require.Equal(t, []string{"E@1.0.0", "Wire@1.0.0 (installed)"}, resolvedToStrings(resolved))
+	_, err = resolver.Resolve(&Reference{Name: "D"})
+	require.Error(t, err)
+	require.Equal(t, "Missing", err.(*DependencyConflictError).Library)
+	require.Contains(t, err.Error(), "Missing required by D@1.0.0")
+
+	// Dependencies of installed libraries not available in the index are
+	// read from library.properties
+	wireProps := properties.NewMap()
+	wireProps.Set("depends", "C (<1.5.0)")
+	installed = libraries.List{
+		{Name: "Wire", Version: semver.MustParse("1.0.0"), Location: libraries.PlatformBuiltIn, Properties: wireProps},
+	}
+	resolver = idx.NewDependencyResolver(installed, nil)
+	resolved, err = resolver.Resolve(&Reference{Name: "E"})
+	require.NoError(t, err)
+	require.Equal(t, []string{"E@1.0.0", "Wire@1.0.0 (installed)", "C@1.0.0"}, resolvedToStrings(resolved))
+
+	// Pinned versions
+	resolver = idx.NewDependencyResolver(nil, map[string]*semver.Version{"C": semver.MustParse("1.0.0")})
+	resolved, err = resolver.Resolve(&Reference{Name: "A"})
+	require.NoError(t, err)
+	require.Equal(t, []string{"A@1.0.0", "C@1.0.0"}, resolvedToStrings(resolved))
+	_, err = resolver.Resolve(&Reference{Name: "B"})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "C pinned to 1.0.0 by the lockfile")
+
+	// Requested libraries without a version are pinned too
+	resolver = idx.NewDependencyResolver(nil, map[string]*semver.Version{"A": semver.MustParse("1.0.0")})
+	resolved, err = resolver.Resolve(&Reference{Name: "A"})
+	require.NoError(t, err)
+	require.Equal(t, []string{"A@1.0.0", "C@1.5.0"}, resolvedToStrings(resolved))
+}
+
+func TestLoadLockfile(t *testing.T) {
+	lockfilePath := paths.New(t.TempDir()).Join("libraries.lock")
+	require.NoError(t, lockfilePath.WriteFile([]byte(`{"libraries": {"Servo": "1.1.8"}}`)))
+	lockfile, err := LoadLockfile(lockfilePath)
+	require.NoError(t, err)
+	require.Len(t, lockfile.Libraries, 1)
+	require.Equal(t, "1.1.8", lockfile.Libraries["Servo"].String())
+
+	require.NoError(t, lockfilePath.WriteFile([]byte(`{"libraries": [}`)))
+	_, err = LoadLockfile(lockfilePath)
+	require.Error(t, err)
+}
@@ -0,0 +1,170 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package librariesmanager
+
+import (
+	"sort"
+
+	"github.com/arduino/arduino-cli/arduino/libraries"
+	"github.com/arduino/arduino-cli/arduino/libraries/librariesindex"
+	"github.com/sirupsen/logrus"
+	semver "go.bug.st/relaxed-semver"
+)
+
+// Dependents returns the installed libraries that depend on the given
+// libraries and that would be left without a library satisfying the
+// dependency if the given libraries are removed. The dependents that are
+// part of the given libraries are not reported.
+func (lm *LibrariesManager) Dependents(libs ...*libraries.Library) map[*libraries.Library]libraries.List {
+	removed := map[*libraries.Library]bool{}
+	for _, lib := range libs {
+		removed[lib] = true
+	}
+	res := map[*libraries.Library]libraries.List{}
+	for _, lib := range libs {
+		if dependents := lm.dependents(lib, removed); len(dependents) > 0 {
+			res[lib] = dependents
+		}
+	}
+	return res
+}
+
+// Orphans returns the libraries installed only to satisfy the dependencies of
+// other libraries that are not required anymore once the given libraries are
+// removed. Libraries that become orphans because they were only required by
+// other orphans are returned too.
+func (lm *LibrariesManager) Orphans(removedLibs ...*libraries.Library) libraries.List {
+	removed := map[*libraries.Library]bool{}
+	for _, lib := range removedLibs {
+		removed[lib] = true
+	}
+	res := libraries.List{}
+	for {
+		found := false
+		for _, lib := range lm.installedLibraries() {
+			if removed[lib] || !lib.InstalledAsDependency || lib.Location != libraries.User {
+				continue
+			}
+			removed[lib] = true
+			if len(lm.dependents(lib, removed)) > 0 {
+				delete(removed, lib)
+				continue
+			}
+			res = append(res, lib)
+			found = true
+		}
+		if !found {
+			return res
+		}
+	}
+}
+
+// dependents returns the libraries, not in removed, that have a dependency
+// satisfied by lib and not by any other installed library not in removed.
+func (lm *LibrariesManager) dependents(lib *libraries.Library, removed map[*libraries.Library]bool) libraries.List {
+	res := libraries.List{}
+	for _, other := range lm.installedLibraries() {
+		if removed[other] {
+			continue
+		}
+		for _, dep := range lm.dependenciesOf(other) {
+			if !dependencySatisfiedBy(dep, lib) {
+				continue
+			}
+			if !lm.dependencySatisfied(dep, removed) {
+				res = append(res, other)
+				break
+			}
+		}
+	}
+	return res
+}
+
+// dependencySatisfied returns true if an installed library, not in removed,
+// satisfies the dependency
+func (lm *LibrariesManager) dependencySatisfied(dep semver.Dependency, removed map[*libraries.Library]bool) bool {
+	for _, lib := range lm.installedLibraries() {
+		if !removed[lib] && dependencySatisfiedBy(dep, lib) {
+			return true
+		}
+	}
+	return false
+}
+
+// dependenciesOf returns the dependencies declared in the library.properties
+// of the library or, if missing, the ones of the corresponding release in
+// the library index.
+func (lm *LibrariesManager) dependenciesOf(lib *libraries.Library) []semver.Dependency {
+	res := []semver.Dependency{}
+	depends, err := lib.Dependencies()
+	if err != nil {
+		logrus.WithField("library", lib.Name).WithError(err).Warn("Invalid dependencies")
+	}
+	for _, dep := range depends {
+		var constraint semver.Constraint = &semver.True{}
+		if dep.VersionConstraint != "" {
+			if c, err := semver.ParseConstraint(dep.VersionConstraint); err == nil {
+				constraint = c
+			}
+		}
+		res = append(res, &librariesindex.Dependency{Name: dep.Name, VersionConstraint: constraint})
+	}
+	if len(res) > 0 || lm.Index == nil || lib.Version == nil {
+		return res
+	}
+	if release := lm.Index.FindRelease(&librariesindex.Reference{Name: libraryName(lib), Version: lib.Version}); release != nil {
+		return release.Dependencies
+	}
+	return res
+}
+
+func dependencySatisfiedBy(dep semver.Dependency, lib *libraries.Library) bool {
+	if dep.GetName() != libraryName(lib) {
+		return false
+	}
+	constraint := dep.GetConstraint()
+	if constraint == nil {
+		return true
+	}
+	if lib.Version == nil {
+		_, any := constraint.(*semver.True)
+		return any
+	}
+	return constraint.Match(lib.Version)
+}
+
+// libraryName returns the name used to refer to the library in the
+// dependencies, that is the name in library.properties if available
+func libraryName(lib *libraries.Library) string {
+	if lib.RealName != "" {
+		return lib.RealName
+	}
+	return lib.Name
+}
+
+// installedLibraries returns all the installed libraries sorted by name
+func (lm *LibrariesManager) installedLibraries() libraries.List {
+	names := []string{}
+	for name := range lm.Libraries {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	res := libraries.List{}
+	for _, name := range names {
+		res = append(res, lm.Libraries[name].Alternatives...)
+	}
+	return res
+}
@@ -0,0 +1,67 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package librariesmanager
+
+import (
+	"testing"
+
+	"github.com/arduino/arduino-cli/arduino/libraries"
+	properties "github.com/arduino/go-properties-orderedmap"
+	"github.com/stretchr/testify/require"
+	semver "go.bug.st/relaxed-semver"
+)
+
+func addInstalledLibrary(lm *LibrariesManager, name, version, depends string, location libraries.LibraryLocation, asDependency bool) *libraries.Library {
+	props := properties.NewMap()
+	props.Set("depends", depends)
+	lib := &libraries.Library{
+		Name:                  name,
+		RealName:              name,
+		Version:               semver.MustParse(version),
+		Location:              location,
+		Properties:            props,
+		InstalledAsDependency: asDependency,
+	}
+	alts, ok := lm.Libraries[name]
+	if !ok {
+		alts = &LibraryAlternatives{}
+		lm.Libraries[name] = alts
+	}
+	alts.Add(lib)
+	return lib
+}
+
+func TestDependentsAndOrphans(t *testing.T) {
+	lm := NewLibraryManager(nil, nil)
+	app := addInstalledLibrary(lm, "App", "1.0.0", "Display, Sensor (>=2.0.0)", libraries.User, false)
+	display := addInstalledLibrary(lm, "Display", "1.0.0", "GFX", libraries.User, true)
+	gfx := addInstalledLibrary(lm, "GFX", "1.0.0", "", libraries.User, true)
+	sensor := addInstalledLibrary(lm, "Sensor", "2.1.0", "", libraries.User, false)
+	oldSensor := addInstalledLibrary(lm, "Sensor", "1.0.0", "", libraries.PlatformBuiltIn, false)
+	unused := addInstalledLibrary(lm, "Unused", "1.0.0", "", libraries.User, true)
+
+	require.Equal(t, map[*libraries.Library]libraries.List{display: {app}}, lm.Dependents(display))
+	require.Equal(t, map[*libraries.Library]libraries.List{gfx: {display}}, lm.Dependents(gfx))
+	require.Equal(t, map[*libraries.Library]libraries.List{sensor: {app}}, lm.Dependents(sensor))
+	// The platform bundled Sensor doesn't satisfy the App dependency
+	require.Empty(t, lm.Dependents(oldSensor))
+	// Removing a library together with its dependents is allowed
+	require.Empty(t, lm.Dependents(gfx, display, app))
+
+	require.Equal(t, libraries.List{unused}, lm.Orphans())
+	require.Equal(t, libraries.List{gfx}, lm.Orphans(display, unused))
+	require.ElementsMatch(t, libraries.List{display, gfx, unused}, lm.Orphans(app))
+}
@@ -0,0 +1,218 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package librariesmanager
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/arduino/arduino-cli/arduino/libraries"
+	semver "go.bug.st/relaxed-semver"
+	"gopkg.in/src-d/go-git.v4"
+	"gopkg.in/src-d/go-git.v4/config"
+	"gopkg.in/src-d/go-git.v4/plumbing"
+	"gopkg.in/src-d/go-git.v4/plumbing/transport"
+	"gopkg.in/src-d/go-git.v4/plumbing/transport/http"
+	"gopkg.in/src-d/go-git.v4/plumbing/transport/ssh"
+	"gopkg.in/src-d/go-git.v4/storage/memory"
+)
+
+// GitCredentials are the credentials used to access private git repositories
+type GitCredentials struct {
+	// Username and Password are used for HTTPS repositories, Password may
+	// also be a personal access token.
+	Username string
+	Password string
+	// SSHKeyPath is the private key used for SSH repositories, if empty the
+	// SSH agent is used.
+	SSHKeyPath       string
+	SSHKeyPassphrase string
+}
+
+// authFor returns the authentication method to use for the given repository URL
+func (c *GitCredentials) authFor(gitURL string) (transport.AuthMethod, error) {
+	if c == nil {
+		return nil, nil
+	}
+	endpoint, err := transport.NewEndpoint(gitURL)
+	if err != nil {
+		return nil, err
+	}
+	switch endpoint.Protocol {
+	case "ssh":
+		user := endpoint.User
+		if user == "" {
+			user = "git"
+		}
+		if c.SSHKeyPath == "" {
+			return ssh.NewSSHAgentAuth(user)
+		}
+		auth, err := ssh.NewPublicKeysFromFile(user, c.SSHKeyPath, c.SSHKeyPassphrase)
+		if err != nil {
+			return nil, fmt.Errorf(tr("loading SSH key %[1]s: %[2]s"), c.SSHKeyPath, err)
+		}
+		return auth, nil
+	case "http", "https":
+		if c.Username == "" && c.Password == "" {
+			return nil, nil
+		}
+		username := c.Username
+		if username == "" {
+			// Access tokens are accepted with any non-empty username
+			username = "arduino-cli"
+		}
+		return &http.BasicAuth{Username: username, Password: c.Password}, nil
+	}
+	return nil, nil
+}
+
+// splitGitURLRef splits a git URL in the form URL#REF in the repository URL and
+// the tag, branch or commit to install.
+func splitGitURLRef(gitURL string) (string, string) {
+	i := strings.LastIndex(gitURL, "#")
+	if i == -1 {
+		return gitURL, ""
+	}
+	return gitURL[:i], gitURL[i+1:]
+}
+
+// resolveGitRef returns the commit referenced by the given tag, branch or
+// commit hash in the cloned repository.
+func resolveGitRef(repo *git.Repository, ref string) (*plumbing.Hash, error) {
+	if hash, err := repo.ResolveRevision(plumbing.Revision(ref)); err == nil {
+		return hash, nil
+	}
+	// Branches of a cloned repository are available only as remote branches
+	if hash, err := repo.ResolveRevision(plumbing.Revision("origin/" + ref)); err == nil {
+		return hash, nil
+	}
+	// Abbreviated commit hashes are not resolved by go-git
+	if len(ref) >= 4 && isHexString(ref) {
+		commits, err := repo.CommitObjects()
+		if err != nil {
+			return nil, err
+		}
+		defer commits.Close()
+		var found *plumbing.Hash
+		for {
+			commit, err := commits.Next()
+			if err != nil {
+				break
+			}
+			if strings.HasPrefix(commit.Hash.String(), strings.ToLower(ref)) {
+				if found != nil {
+					return nil, fmt.Errorf(tr("ambiguous commit hash %s"), ref)
+				}
+				hash := commit.Hash
+				found = &hash
+			}
+		}
+		if found != nil {
+			return found, nil
+		}
+	}
+	return nil, fmt.Errorf(tr("tag, branch or commit %s not found"), ref)
+}
+
+func isHexString(s string) bool {
+	for _, c := range strings.ToLower(s) {
+		if !(c >= '0' && c <= '9') && !(c >= 'a' && c <= 'f') {
+			return false
+		}
+	}
+	return true
+}
+
+// FindGitLibUpdate checks the repository of a library installed from git and
+// returns the URL (in the URL#REF form) to install to update it, or an empty
+// string if the library is up to date:
+// - libraries installed from a tag are updated to the greatest newer tag
+// - libraries installed from a branch are updated to the tip of the branch
+// - libraries installed from a commit are never updated
+func FindGitLibUpdate(origin *libraries.GitOrigin, credentials *GitCredentials) (string, error) {
+	if origin.Ref != "" && isHexString(origin.Ref) && strings.HasPrefix(origin.Commit, strings.ToLower(origin.Ref)) {
+		// Pinned to a commit
+		return "", nil
+	}
+
+	auth, err := credentials.authFor(origin.URL)
+	if err != nil {
+		return "", err
+	}
+	remote := git.NewRemote(memory.NewStorage(), &config.RemoteConfig{Name: "origin", URLs: []string{origin.URL}})
+	refs, err := remote.List(&git.ListOptions{Auth: auth})
+	if err != nil {
+		return "", fmt.Errorf(tr("listing references of %[1]s: %[2]s"), origin.URL, err)
+	}
+	tags := map[string]bool{}
+	heads := map[string]string{}
+	var defaultBranch plumbing.ReferenceName
+	for _, ref := range refs {
+		name := ref.Name()
+		if name.IsTag() {
+			tags[name.Short()] = true
+		} else if name.IsBranch() {
+			heads[name.Short()] = ref.Hash().String()
+		} else if name == plumbing.HEAD {
+			if ref.Type() == plumbing.SymbolicReference {
+				defaultBranch = ref.Target()
+			} else {
+				heads[""] = ref.Hash().String()
+			}
+		}
+	}
+	if _, ok := heads[""]; !ok && defaultBranch != "" {
+		heads[""] = heads[defaultBranch.Short()]
+	}
+
+	if tags[origin.Ref] {
+		// Look for a newer tag
+		current, err := parseTagVersion(origin.Ref)
+		if err != nil {
+			// Not a version number, there is no way to sort the tags
+			return "", nil
+		}
+		newestTag := ""
+		newest := current
+		for tag := range tags {
+			if version, err := parseTagVersion(tag); err == nil && version.GreaterThan(newest) {
+				newest = version
+				newestTag = tag
+			}
+		}
+		if newestTag == "" {
+			return "", nil
+		}
+		return origin.URL + "#" + newestTag, nil
+	}
+
+	head, ok := heads[origin.Ref]
+	if !ok {
+		return "", fmt.Errorf(tr("branch %[1]s not found in %[2]s"), origin.Ref, origin.URL)
+	}
+	if head == origin.Commit {
+		return "", nil
+	}
+	if origin.Ref == "" {
+		return origin.URL, nil
+	}
+	return origin.URL + "#" + origin.Ref, nil
+}
+
+// parseTagVersion parses a tag in the form "1.2.3" or "v1.2.3"
+func parseTagVersion(tag string) (*semver.Version, error) {
+	return semver.Parse(strings.TrimPrefix(strings.TrimPrefix(tag, "v"), "V"))
+}
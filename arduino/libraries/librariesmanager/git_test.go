@@ -0,0 +1,130 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package librariesmanager
+
+import (
+	"testing"
+	"time"
+
+	"github.com/arduino/arduino-cli/arduino/libraries"
+	"github.com/arduino/go-paths-helper"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/src-d/go-git.v4"
+	"gopkg.in/src-d/go-git.v4/plumbing"
+	"gopkg.in/src-d/go-git.v4/plumbing/object"
+)
+
+// createLibraryRepo creates a git repository containing a library with a
+// commit for each version, tagged as "vVERSION"
+func createLibraryRepo(t *testing.T, dir *paths.Path, versions ...string) []plumbing.Hash {
+	repo, err := git.PlainInit(dir.String(), false)
+	require.NoError(t, err)
+	tree, err := repo.Worktree()
+	require.NoError(t, err)
+	require.NoError(t, dir.Join("src").MkdirAll())
+
+	hashes := []plumbing.Hash{}
+	for _, version := range versions {
+		require.NoError(t, dir.Join("library.properties").WriteFile([]byte("name=MyLib\nversion="+version+"\n")))
+		require.NoError(t, dir.Join("src", "MyLib.h").WriteFile([]byte("// "+version+"\n")))
+		_, err = tree.Add(".")
+		require.NoError(t, err)
+		hash, err := tree.Commit("Release "+version, &git.CommitOptions{
+			Author: &object.Signature{Name: "test", Email: "test@example.com", When: time.Now()},
+		})
+		require.NoError(t, err)
+		_, err = repo.CreateTag("v"+version, hash, nil)
+		require.NoError(t, err)
+		hashes = append(hashes, hash)
+	}
+	return hashes
+}
+
+func TestSplitGitURLRef(t *testing.T) {
+	url, ref := splitGitURLRef("https://github.com/arduino/arduino-lib.git#v1.2.3")
+	require.Equal(t, "https://github.com/arduino/arduino-lib.git", url)
+	require.Equal(t, "v1.2.3", ref)
+
+	url, ref = splitGitURLRef("git@github.com:arduino/arduino-lib.git")
+	require.Equal(t, "git@github.com:arduino/arduino-lib.git", url)
+	require.Equal(t, "", ref)
+}
+
+func TestInstallGitLib(t *testing.T) {
+	tmp := paths.New(t.TempDir())
+	repoDir := tmp.Join("repo", "MyLib")
+	hashes := createLibraryRepo(t, repoDir, "1.0.0", "1.1.0", "1.2.0")
+
+	libsDir := tmp.Join("libraries")
+	lm := NewLibraryManager(nil, nil)
+	lm.AddLibrariesDir(libsDir, libraries.User)
+	installDir := libsDir.Join("MyLib")
+
+	// Install a tag
+	require.NoError(t, lm.InstallGitLib(repoDir.String()+"#v1.0.0", false, nil))
+	header, err := installDir.Join("src", "MyLib.h").ReadFile()
+	require.NoError(t, err)
+	require.Equal(t, "// 1.0.0\n", string(header))
+	require.False(t, installDir.Join(".git").Exist())
+
+	lib, err := libraries.Load(installDir, libraries.User)
+	require.NoError(t, err)
+	require.NotNil(t, lib.GitOrigin)
+	require.Equal(t, repoDir.String(), lib.GitOrigin.URL)
+	require.Equal(t, "v1.0.0", lib.GitOrigin.Ref)
+	require.Equal(t, hashes[0].String(), lib.GitOrigin.Commit)
+
+	update, err := FindGitLibUpdate(lib.GitOrigin, nil)
+	require.NoError(t, err)
+	require.Equal(t, repoDir.String()+"#v1.2.0", update)
+
+	// Install an abbreviated commit
+	require.NoError(t, lm.InstallGitLib(repoDir.String()+"#"+hashes[1].String()[:8], true, nil))
+	origin, err := libraries.LoadGitOrigin(installDir)
+	require.NoError(t, err)
+	require.Equal(t, hashes[1].String(), origin.Commit)
+	header, err = installDir.Join("src", "MyLib.h").ReadFile()
+	require.NoError(t, err)
+	require.Equal(t, "// 1.1.0\n", string(header))
+
+	// Commits are never upgraded
+	update, err = FindGitLibUpdate(origin, nil)
+	require.NoError(t, err)
+	require.Equal(t, "", update)
+
+	// Install the default branch
+	require.NoError(t, lm.InstallGitLib(repoDir.String(), true, nil))
+	origin, err = libraries.LoadGitOrigin(installDir)
+	require.NoError(t, err)
+	require.Equal(t, "", origin.Ref)
+	require.Equal(t, hashes[2].String(), origin.Commit)
+	update, err = FindGitLibUpdate(origin, nil)
+	require.NoError(t, err)
+	require.Equal(t, "", update)
+
+	// Refs not found leave the installed library untouched
+	require.Error(t, lm.InstallGitLib(repoDir.String()+"#v9.9.9", true, nil))
+	require.True(t, installDir.Join("library.properties").Exist())
+
+	// Installed libraries are not overwritten, the repository is not even cloned
+	err = lm.InstallGitLib(tmp.Join("missing", "MyLib").String(), false, nil)
+	require.EqualError(t, err, "library MyLib already installed")
+
+	// The temporary clones are never left in the libraries directory
+	content, err := libsDir.ReadDir()
+	require.NoError(t, err)
+	require.Equal(t, paths.PathList{installDir}, content)
+}
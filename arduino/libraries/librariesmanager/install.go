@@ -30,6 +30,7 @@ import (
 	"github.com/codeclysm/extract/v3"
 	"github.com/sirupsen/logrus"
 	"gopkg.in/src-d/go-git.v4"
+	"gopkg.in/src-d/go-git.v4/plumbing"
 )
 
 type alreadyInstalledError struct{}
@@ -184,12 +185,16 @@ func (lm *LibrariesManager) InstallZipLib(ctx context.Context, archivePath strin
 }
 
 //InstallGitLib  installs a library hosted on a git repository on the specified path.
-func (lm *LibrariesManager) InstallGitLib(gitURL string, overwrite bool) error {
+// The URL may be followed by "#" and the tag, branch or commit to install,
+// otherwise the default branch is installed. The origin of the library is
+// recorded in the installation directory to allow future upgrades.
+func (lm *LibrariesManager) InstallGitLib(gitURL string, overwrite bool, credentials *GitCredentials) error {
 	libsDir := lm.getUserLibrariesDir()
 	if libsDir == nil {
 		return fmt.Errorf(tr("User directory not set"))
 	}
 
+	gitURL, ref := splitGitURLRef(gitURL)
 	libraryName, err := parseGitURL(gitURL)
 	if err != nil {
 		logrus.
@@ -198,31 +203,45 @@ func (lm *LibrariesManager) InstallGitLib(gitURL string, overwrite bool) error {
 		return err
 	}
 
+	// Check if the library is already installed before cloning the repository
 	installPath := libsDir.Join(libraryName)
+	_, alreadyInstalled := lm.Libraries[libraryName]
+	alreadyInstalled = alreadyInstalled || installPath.Exist()
+	if alreadyInstalled && !overwrite {
+		return fmt.Errorf(tr("library %s already installed"), libraryName)
+	}
 
-	// Deletes libraries folder if already installed
-	if _, ok := lm.Libraries[libraryName]; ok {
-		if !overwrite {
-			return fmt.Errorf(tr("library %s already installed"), libraryName)
-		}
-		logrus.
-			WithField("library name", libraryName).
-			WithField("install path", installPath).
-			Trace("Deleting library")
-		installPath.RemoveAll()
+	auth, err := credentials.authFor(gitURL)
+	if err != nil {
+		return err
 	}
 
+	// Clone in a temporary directory to leave the installed library untouched
+	// if the clone fails. It's outside the libraries directory so that an
+	// interrupted clone can't be loaded as a library.
+	tmpDir, err := paths.MkTempDir("", "arduino-cli-git-")
+	if err != nil {
+		return err
+	}
+	defer tmpDir.RemoveAll()
+	clonePath := tmpDir.Join(libraryName)
+
 	logrus.
 		WithField("library name", libraryName).
-		WithField("install path", installPath).
 		WithField("git url", gitURL).
-		Trace("Installing library")
+		WithField("ref", ref).
+		Trace("Cloning library")
 
-	_, err = git.PlainClone(installPath.String(), false, &git.CloneOptions{
+	cloneOptions := &git.CloneOptions{
 		URL:      gitURL,
-		Depth:    1,
+		Auth:     auth,
 		Progress: os.Stdout,
-	})
+	}
+	if ref == "" {
+		// The default branch is enough, avoid downloading the whole history
+		cloneOptions.Depth = 1
+	}
+	repo, err := git.PlainClone(clonePath.String(), false, cloneOptions)
 	if err != nil {
 		logrus.
 			WithError(err).
@@ -230,14 +249,59 @@ func (lm *LibrariesManager) InstallGitLib(gitURL string, overwrite bool) error {
 		return err
 	}
 
-	if err := validateLibrary(installPath); err != nil {
-		// Clean up installation directory since this is not a valid library
-		installPath.RemoveAll()
+	var commit *plumbing.Hash
+	if ref != "" {
+		if commit, err = resolveGitRef(repo, ref); err != nil {
+			return err
+		}
+		tree, err := repo.Worktree()
+		if err != nil {
+			return err
+		}
+		if err := tree.Checkout(&git.CheckoutOptions{Hash: *commit, Force: true}); err != nil {
+			return fmt.Errorf(tr("checking out %[1]s: %[2]s"), ref, err)
+		}
+	} else {
+		head, err := repo.Head()
+		if err != nil {
+			return err
+		}
+		hash := head.Hash()
+		commit = &hash
+	}
+
+	if err := validateLibrary(clonePath); err != nil {
 		return err
 	}
 
 	// We don't want the installed library to be a git repository thus we delete this folder
-	installPath.Join(".git").RemoveAll()
+	clonePath.Join(".git").RemoveAll()
+	origin := &libraries.GitOrigin{URL: gitURL, Ref: ref, Commit: commit.String()}
+	if err := origin.Save(clonePath); err != nil {
+		return fmt.Errorf(tr("saving library origin: %s"), err)
+	}
+
+	// Deletes libraries folder if already installed
+	if alreadyInstalled {
+		logrus.
+			WithField("library name", libraryName).
+			WithField("install path", installPath).
+			Trace("Deleting library")
+		installPath.RemoveAll()
+	}
+
+	logrus.
+		WithField("library name", libraryName).
+		WithField("install path", installPath).
+		WithField("commit", commit.String()).
+		Trace("Installing library")
+
+	if err := libsDir.MkdirAll(); err != nil {
+		return err
+	}
+	if err := clonePath.CopyDirTo(installPath); err != nil {
+		return fmt.Errorf(tr("moving cloned repository to destination dir: %s"), err)
+	}
 	return nil
 }
 
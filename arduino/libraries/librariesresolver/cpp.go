@@ -24,13 +24,15 @@ import (
 	"github.com/arduino/arduino-cli/arduino/libraries/librariesmanager"
 	"github.com/arduino/arduino-cli/arduino/utils"
 	"github.com/arduino/arduino-cli/i18n"
+	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
 	"github.com/schollz/closestmatch"
 	"github.com/sirupsen/logrus"
 )
 
 // Cpp finds libraries made for the C++ language
 type Cpp struct {
-	headers map[string]libraries.List
+	headers   map[string]libraries.List
+	overrides map[string]*LibraryOverride
 }
 
 var tr = i18n.Tr
@@ -38,10 +40,17 @@ var tr = i18n.Tr
 // NewCppResolver creates a new Cpp resolver
 func NewCppResolver() *Cpp {
 	return &Cpp{
-		headers: map[string]libraries.List{},
+		headers:   map[string]libraries.List{},
+		overrides: map[string]*LibraryOverride{},
 	}
 }
 
+// SetOverride forces the library used to resolve the specified header,
+// the override takes precedence over the priority heuristics
+func (resolver *Cpp) SetOverride(header string, override *LibraryOverride) {
+	resolver.overrides[header] = override
+}
+
 // ScanFromLibrariesManager reads all librariers loaded in the LibrariesManager to find
 // and cache all C++ headers for later retrieval
 func (resolver *Cpp) ScanFromLibrariesManager(lm *librariesmanager.LibrariesManager) error {
@@ -75,11 +84,45 @@ func (resolver *Cpp) AlternativesFor(header string) libraries.List {
 // ResolveFor finds the most suitable library for the specified combination of
 // header and architecture. If no libraries provides the requested header, nil is returned
 func (resolver *Cpp) ResolveFor(header, architecture string) *libraries.Library {
+	return resolver.Explain(header, architecture).Selected
+}
+
+// Candidate is a library providing an include, with the priority computed
+// to choose between all the candidates
+type Candidate struct {
+	Library  *libraries.Library
+	Priority *Priority
+	// ClosestMatch is true if the library has been chosen by the
+	// "closestmatch" algorithm between the candidates with the same priority
+	ClosestMatch bool
+}
+
+// Resolution explains how the library used for an include has been chosen
+type Resolution struct {
+	Header     string
+	Candidates []*Candidate
+	// Selected is the library chosen to resolve the include, nil if no
+	// library provides it
+	Selected *libraries.Library
+	// Reason describes the rule that determined the selected library
+	Reason string
+	// UnmatchedOverride is the override set for the header if none of the
+	// candidates matches it
+	UnmatchedOverride *LibraryOverride
+}
+
+// Explain finds the most suitable library for the specified combination of
+// header and architecture, like ResolveFor, and returns all the candidates
+// considered with their priority and the reason of the choice.
+func (resolver *Cpp) Explain(header, architecture string) *Resolution {
 	logrus.Infof("Resolving include %s for arch %s", header, architecture)
+	res := &Resolution{Header: header}
 	var found libraries.List
 	var foundPriority int
 	for _, lib := range resolver.headers[header] {
-		libPriority := computePriority(lib, header, architecture)
+		priority := computePriorityComponents(lib, header, architecture)
+		res.Candidates = append(res.Candidates, &Candidate{Library: lib, Priority: priority})
+		libPriority := priority.Total()
 		msg := "  discarded"
 		if found == nil || foundPriority < libPriority {
 			found = libraries.List{}
@@ -96,22 +139,78 @@ func (resolver *Cpp) ResolveFor(header, architecture string) *libraries.Library
 			Infof(msg)
 	}
 	if found == nil {
-		return nil
+		return res
+	}
+
+	if override, ok := resolver.overrides[header]; ok {
+		for _, candidate := range res.Candidates {
+			if override.Matches(candidate.Library) {
+				logrus.WithField("lib", candidate.Library.Name).Info("  library selected by override")
+				res.Selected = candidate.Library
+				res.Reason = tr("overridden by the sketch to %s", override)
+				return res
+			}
+		}
+		logrus.WithField("override", override).Warn("  no library matches the override")
+		res.UnmatchedOverride = override
 	}
+
 	if len(found) == 1 {
-		return found[0]
+		res.Selected = found[0]
+		res.Reason = tr("highest priority")
+		return res
 	}
 
 	// If more than one library qualifies use the "closestmatch" algorithm to
 	// find the best matching one (instead of choosing it randomly)
 	if best := findLibraryWithNameBestDistance(header, found); best != nil {
 		logrus.WithField("lib", best.Name).Info("  library with the best matching name")
-		return best
+		for _, candidate := range res.Candidates {
+			candidate.ClosestMatch = candidate.Library == best
+		}
+		res.Selected = best
+		res.Reason = tr("same priority, closest matching name")
+		return res
 	}
 
 	found.SortByName()
 	logrus.WithField("lib", found[0].Name).Info("  first library in alphabetic order")
-	return found[0]
+	res.Selected = found[0]
+	res.Reason = tr("same priority, first in alphabetic order")
+	return res
+}
+
+// ToRPCLibraryResolution converts this Resolution into a rpc.LibraryResolution
+func (res *Resolution) ToRPCLibraryResolution() *rpc.LibraryResolution {
+	candidates := []*rpc.LibraryResolutionCandidate{}
+	for _, candidate := range res.Candidates {
+		lib := candidate.Library
+		version := ""
+		if lib.Version != nil {
+			version = lib.Version.String()
+		}
+		installDir := ""
+		if lib.InstallDir != nil {
+			installDir = lib.InstallDir.String()
+		}
+		candidates = append(candidates, &rpc.LibraryResolutionCandidate{
+			Name:                 lib.Name,
+			Version:              version,
+			InstallDir:           installDir,
+			Location:             lib.Location.ToRPCLibraryLocation(),
+			ArchitecturePriority: int32(candidate.Priority.Architecture),
+			NamePriority:         int32(candidate.Priority.Name),
+			LocationPriority:     int32(candidate.Priority.Location),
+			Priority:             int32(candidate.Priority.Total()),
+			ClosestMatch:         candidate.ClosestMatch,
+			Selected:             lib == res.Selected,
+		})
+	}
+	return &rpc.LibraryResolution{
+		Include:    res.Header,
+		Candidates: candidates,
+		Reason:     res.Reason,
+	}
 }
 
 func simplify(name string) string {
@@ -120,52 +219,81 @@ func simplify(name string) string {
 	return name
 }
 
+// Priority is the priority of a library as a candidate to resolve an include,
+// split by the rules that contribute to it. Candidates with a greater Total
+// are preferred.
+type Priority struct {
+	// Architecture is the bonus for libraries compatible with (or optimized
+	// for) the target architecture
+	Architecture int
+	// Name is the bonus for libraries whose name matches the include
+	Name int
+	// Location is the bonus given by the location of the library
+	Location int
+}
+
+// Total returns the overall priority
+func (p *Priority) Total() int {
+	return p.Architecture + p.Name + p.Location
+}
+
 func computePriority(lib *libraries.Library, header, arch string) int {
+	return computePriorityComponents(lib, header, arch).Total()
+}
+
+func computePriorityComponents(lib *libraries.Library, header, arch string) *Priority {
 	header = strings.TrimSuffix(header, filepath.Ext(header))
 	header = simplify(header)
 	name := simplify(lib.Name)
 	realName := simplify(lib.RealName)
 
-	priority := 0
+	priority := &Priority{}
 
 	// Bonus for core-optimized libraries
 	if lib.IsOptimizedForArchitecture(arch) {
 		// give a slightly better bonus for libraries that have specific optimization
 		// (it is more important than Location but less important than Name)
-		priority += 1010
+		priority.Architecture = 2010
 	} else if lib.IsArchitectureIndependent() {
-		// standard bonus for architecture independent (vanilla) libraries
-		priority += 1000
+		// standard bonus for architecture independent (vanilla) libraries,
+		// it exceeds the Name and Location bonuses combined so that a compatible
+		// library is always preferred over an incompatible one
+		priority.Architecture = 2000
 	} else {
 		// the library is not architecture compatible
-		priority += 0
+		priority.Architecture = 0
 	}
 
 	if realName == header && name == header {
-		priority += 600
+		priority.Name = 600
 	} else if realName == header || name == header {
-		priority += 500
+		priority.Name = 500
 	} else if realName == header+"-master" || name == header+"-master" {
-		priority += 400
+		priority.Name = 400
 	} else if strings.HasPrefix(realName, header) || strings.HasPrefix(name, header) {
-		priority += 300
+		priority.Name = 300
 	} else if strings.HasSuffix(realName, header) || strings.HasSuffix(name, header) {
-		priority += 200
+		priority.Name = 200
 	} else if strings.Contains(realName, header) || strings.Contains(name, header) {
-		priority += 100
+		priority.Name = 100
 	}
 
 	switch lib.Location {
 	case libraries.IDEBuiltIn:
-		priority += 0
+		priority.Location = 0
 	case libraries.ReferencedPlatformBuiltIn:
-		priority++
+		priority.Location = 1
 	case libraries.PlatformBuiltIn:
-		priority += 2
+		priority.Location = 2
 	case libraries.User:
-		priority += 3
+		priority.Location = 3
 	case libraries.Unmanaged:
-		priority += 4
+		priority.Location = 4
+	case libraries.Sketch:
+		// Libraries vendored in the sketch are an explicit choice of the user:
+		// the bonus exceeds the Name bonus and the optimized Architecture bonus
+		// combined, so they win against any library with the same compatibility
+		priority.Location = 700
 	default:
 		panic(fmt.Sprintf("Invalid library location: %d", lib.Location))
 	}
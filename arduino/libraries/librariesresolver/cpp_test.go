@@ -19,7 +19,9 @@ import (
 	"testing"
 
 	"github.com/arduino/arduino-cli/arduino/libraries"
+	paths "github.com/arduino/go-paths-helper"
 	"github.com/stretchr/testify/require"
+	semver "go.bug.st/relaxed-semver"
 )
 
 var l1 = &libraries.Library{Name: "Calculus Lib", Location: libraries.User}
@@ -89,6 +91,32 @@ func TestArchitecturePriority(t *testing.T) {
 	require.Equal(t, builtinSDesp, res, "selected library")
 }
 
+func TestSketchLibrariesPriority(t *testing.T) {
+	userServo := &libraries.Library{
+		Name:          "Servo",
+		Location:      libraries.User,
+		Architectures: []string{"avr"}}
+	sketchServo := &libraries.Library{
+		Name:          "Servo",
+		Location:      libraries.Sketch,
+		Architectures: []string{"*"}}
+	sketchAnotherServo := &libraries.Library{
+		Name:          "AnotherServo",
+		Location:      libraries.Sketch,
+		Architectures: []string{"sam"}}
+
+	res := runResolver("Servo.h", "avr", bundleServo, userServo, sketchServo)
+	require.Equal(t, sketchServo, res, "selected library")
+
+	// Vendored libraries win even if not matching name
+	res = runResolver("Servo.h", "sam", bundleServo, userServo, sketchAnotherServo)
+	require.Equal(t, sketchAnotherServo, res, "selected library")
+
+	// ...but not against a library compatible with the architecture
+	res = runResolver("Servo.h", "avr", bundleServo, userServo, sketchAnotherServo)
+	require.Equal(t, userServo, res, "selected library")
+}
+
 func TestClosestMatchWithTotallyDifferentNames(t *testing.T) {
 	libraryList := libraries.List{}
 	libraryList.Add(l5)
@@ -158,3 +186,97 @@ func TestCppHeaderResolverWithLibrariesInStrangeDirectoryNames(t *testing.T) {
 	resolver.headers["OneWire.h"] = librarylist2
 	require.Equal(t, "OneWire", resolver.ResolveFor("OneWire.h", "avr").Name)
 }
+
+func TestExplain(t *testing.T) {
+	userServo := &libraries.Library{
+		Name:          "Servo",
+		Location:      libraries.User,
+		Architectures: []string{"avr"}}
+	resolver := NewCppResolver()
+	resolver.headers["Servo.h"] = libraries.List{bundleServo, userServo}
+
+	res := resolver.Explain("Servo.h", "avr")
+	require.Equal(t, "Servo.h", res.Header)
+	require.Equal(t, userServo, res.Selected)
+	require.Equal(t, "highest priority", res.Reason)
+	require.Len(t, res.Candidates, 2)
+	require.Equal(t, bundleServo, res.Candidates[0].Library)
+	require.Equal(t, &Priority{Architecture: 2010, Name: 500, Location: 0}, res.Candidates[0].Priority)
+	require.Equal(t, &Priority{Architecture: 2010, Name: 500, Location: 3}, res.Candidates[1].Priority)
+	require.Equal(t, 2513, res.Candidates[1].Priority.Total())
+
+	rpcRes := res.ToRPCLibraryResolution()
+	require.Equal(t, "Servo.h", rpcRes.Include)
+	require.False(t, rpcRes.Candidates[0].Selected)
+	require.True(t, rpcRes.Candidates[1].Selected)
+	require.EqualValues(t, 2513, rpcRes.Candidates[1].Priority)
+
+	res = resolver.Explain("Missing.h", "avr")
+	require.Nil(t, res.Selected)
+	require.Empty(t, res.Candidates)
+}
+
+func TestExplainClosestMatch(t *testing.T) {
+	resolver := NewCppResolver()
+	resolver.headers["calculus_lib.h"] = libraries.List{l7, l6}
+
+	res := resolver.Explain("calculus_lib.h", "avr")
+	require.Equal(t, l6, res.Selected)
+	require.Equal(t, "same priority, closest matching name", res.Reason)
+	require.False(t, res.Candidates[0].ClosestMatch)
+	require.True(t, res.Candidates[1].ClosestMatch)
+
+	rpcRes := res.ToRPCLibraryResolution()
+	require.False(t, rpcRes.Candidates[0].ClosestMatch)
+	require.True(t, rpcRes.Candidates[1].ClosestMatch)
+}
+
+func TestLibraryOverride(t *testing.T) {
+	override, err := ParseLibraryOverride("Servo@1.1.8", nil)
+	require.NoError(t, err)
+	require.Equal(t, "Servo", override.Name)
+	require.Equal(t, "1.1.8", override.Version.String())
+	require.Equal(t, "Servo@1.1.8", override.String())
+
+	override, err = ParseLibraryOverride("libs/MyServo", paths.New("/sketch"))
+	require.NoError(t, err)
+	require.Equal(t, paths.New("/sketch/libs/MyServo").String(), override.Path.String())
+
+	_, err = ParseLibraryOverride("", nil)
+	require.Error(t, err)
+	_, err = ParseLibraryOverride("@1.0.0", nil)
+	require.Error(t, err)
+
+	oldServo := &libraries.Library{
+		Name:          "Servo",
+		Location:      libraries.IDEBuiltIn,
+		Version:       semver.MustParse("1.1.6"),
+		InstallDir:    paths.New("/ide/libraries/Servo"),
+		Architectures: []string{"avr"}}
+	newServo := &libraries.Library{
+		Name:          "Servo",
+		Location:      libraries.User,
+		Version:       semver.MustParse("1.1.8"),
+		InstallDir:    paths.New("/user/libraries/Servo"),
+		Architectures: []string{"avr"}}
+	resolver := NewCppResolver()
+	resolver.headers["Servo.h"] = libraries.List{oldServo, newServo}
+	require.Equal(t, newServo, resolver.ResolveFor("Servo.h", "avr"))
+
+	override, _ = ParseLibraryOverride("Servo@1.1.6", nil)
+	resolver.SetOverride("Servo.h", override)
+	res := resolver.Explain("Servo.h", "avr")
+	require.Equal(t, oldServo, res.Selected)
+	require.Nil(t, res.UnmatchedOverride)
+
+	override, _ = ParseLibraryOverride("/user/libraries/Servo", nil)
+	resolver.SetOverride("Servo.h", override)
+	require.Equal(t, newServo, resolver.ResolveFor("Servo.h", "avr"))
+
+	// Overrides not matching any candidate fall back to the heuristics
+	override, _ = ParseLibraryOverride("Servo@2.0.0", nil)
+	resolver.SetOverride("Servo.h", override)
+	res = resolver.Explain("Servo.h", "avr")
+	require.Equal(t, newServo, res.Selected)
+	require.Equal(t, override, res.UnmatchedOverride)
+}
@@ -0,0 +1,85 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package librariesresolver
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/arduino/arduino-cli/arduino/libraries"
+	paths "github.com/arduino/go-paths-helper"
+	semver "go.bug.st/relaxed-semver"
+)
+
+// LibraryOverride forces the library used to resolve an include, bypassing
+// the priority heuristics. The library is selected by Path if set, otherwise
+// by Name and, optionally, Version.
+type LibraryOverride struct {
+	Name    string
+	Version *semver.Version
+	Path    *paths.Path
+}
+
+// ParseLibraryOverride parses a library override in the form "LibName",
+// "LibName@Version" or a path to the library root folder. Relative paths
+// are resolved from baseDir.
+func ParseLibraryOverride(override string, baseDir *paths.Path) (*LibraryOverride, error) {
+	if override == "" {
+		return nil, fmt.Errorf(tr("invalid empty library override"))
+	}
+	if strings.ContainsAny(override, `/\`) {
+		path := paths.New(override)
+		if !path.IsAbs() && baseDir != nil {
+			path = baseDir.Join(override)
+		}
+		return &LibraryOverride{Path: path}, nil
+	}
+
+	tokens := strings.SplitN(override, "@", 2)
+	if tokens[0] == "" {
+		return nil, fmt.Errorf(tr("invalid empty library name in override: %s"), override)
+	}
+	res := &LibraryOverride{Name: tokens[0]}
+	if len(tokens) > 1 {
+		version, err := semver.Parse(tokens[1])
+		if err != nil {
+			return nil, fmt.Errorf(tr("invalid library version in override %[1]s: %[2]s"), override, err)
+		}
+		res.Version = version
+	}
+	return res, nil
+}
+
+// Matches returns true if the library is the one selected by the override
+func (o *LibraryOverride) Matches(lib *libraries.Library) bool {
+	if o.Path != nil {
+		return lib.InstallDir != nil && lib.InstallDir.EquivalentTo(o.Path)
+	}
+	if lib.Name != o.Name && lib.RealName != o.Name {
+		return false
+	}
+	return o.Version == nil || (lib.Version != nil && lib.Version.Equal(o.Version))
+}
+
+func (o *LibraryOverride) String() string {
+	if o.Path != nil {
+		return o.Path.String()
+	}
+	if o.Version != nil {
+		return o.Name + "@" + o.Version.String()
+	}
+	return o.Name
+}
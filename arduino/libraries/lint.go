@@ -0,0 +1,201 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package libraries
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/arduino/arduino-cli/arduino/globals"
+	"github.com/arduino/arduino-cli/arduino/lint"
+	paths "github.com/arduino/go-paths-helper"
+	properties "github.com/arduino/go-properties-orderedmap"
+	semver "go.bug.st/relaxed-semver"
+)
+
+var validArchitecture = regexp.MustCompile(`^[a-zA-Z0-9_\-]+$`)
+
+// Lint checks the library in the given folder against the library
+// specification and returns the problems found.
+func Lint(libDir *paths.Path) (lint.Findings, error) {
+	findings := lint.Findings{}
+	if !libDir.IsDir() {
+		return nil, fmt.Errorf(tr("%s is not a directory"), libDir)
+	}
+
+	propertiesFile := libDir.Join("library.properties")
+	if !propertiesFile.Exist() {
+		findings.Add(lint.Warning, "missing-library-properties", libDir,
+			tr("library.properties not found, the library uses the legacy format"))
+		lintLayout(libDir, nil, &findings)
+		return findings, nil
+	}
+	libProperties, err := properties.Load(propertiesFile.String())
+	if err != nil {
+		findings.Add(lint.Error, "invalid-library-properties", propertiesFile, tr("loading library.properties: %s"), err)
+		return findings, nil
+	}
+
+	lintProperties(propertiesFile, libProperties, &findings)
+	lintLayout(libDir, libProperties, &findings)
+	return findings, nil
+}
+
+func lintProperties(file *paths.Path, props *properties.Map, findings *lint.Findings) {
+	for _, propName := range MandatoryProperties {
+		if strings.TrimSpace(props.Get(propName)) != "" {
+			continue
+		}
+		if propName == "maintainer" && props.Get("email") != "" {
+			findings.Add(lint.Warning, "deprecated-property", file, tr("the %[1]s property is deprecated, use %[2]s instead"), "email", "maintainer")
+			continue
+		}
+		findings.Add(lint.Error, "missing-property", file, tr("missing required property %s"), propName)
+	}
+	for _, propName := range OptionalProperties {
+		if strings.TrimSpace(props.Get(propName)) == "" {
+			findings.Add(lint.Note, "missing-property", file, tr("missing recommended property %s"), propName)
+		}
+	}
+
+	if name := strings.TrimSpace(props.Get("name")); name != "" && !validLibraryName.MatchString(name) {
+		findings.Add(lint.Error, "invalid-name", file,
+			tr("invalid library name %s: it must start with a letter or number and contain only letters, numbers, spaces, underscores, dots and dashes"), name)
+	}
+
+	if version := strings.TrimSpace(props.Get("version")); version != "" {
+		if _, err := semver.Parse(version); err != nil {
+			findings.Add(lint.Error, "invalid-version", file, tr("invalid version %[1]s: %[2]s"), version, err)
+		}
+	}
+
+	if category, ok := props.GetOk("category"); !ok || strings.TrimSpace(category) == "" {
+		findings.Add(lint.Warning, "invalid-category", file, tr("missing category, the library will be listed as Uncategorized"))
+	} else if category = strings.TrimSpace(category); !ValidCategories[category] {
+		findings.Add(lint.Warning, "invalid-category", file, tr("invalid category %s, the library will be listed as Uncategorized"), category)
+	}
+
+	if architectures, ok := props.GetOk("architectures"); ok {
+		archs := strings.Split(architectures, ",")
+		for _, arch := range archs {
+			arch = strings.TrimSpace(arch)
+			if arch == "*" {
+				if len(archs) > 1 {
+					findings.Add(lint.Warning, "invalid-architectures", file, tr("the * architecture makes the other architectures redundant"))
+				}
+				continue
+			}
+			if !validArchitecture.MatchString(arch) {
+				findings.Add(lint.Error, "invalid-architectures", file, tr("invalid architecture %q"), arch)
+			} else if arch != strings.ToLower(arch) {
+				findings.Add(lint.Warning, "invalid-architectures", file, tr("architecture %s should be lowercase"), arch)
+			}
+		}
+	} else {
+		findings.Add(lint.Warning, "invalid-architectures", file, tr("missing architectures property, the library is considered compatible with all architectures"))
+	}
+
+	if depends := strings.TrimSpace(props.Get("depends")); depends != "" {
+		for _, dep := range strings.Split(depends, ",") {
+			if _, err := ParseDepends(dep); err != nil {
+				findings.Add(lint.Error, "invalid-depends", file, "%s", err)
+			}
+		}
+	}
+
+	if precompiled, ok := props.GetOk("precompiled"); ok {
+		switch precompiled {
+		case "true", "full", "false":
+		default:
+			findings.Add(lint.Error, "invalid-precompiled", file, tr("invalid precompiled value %s, it must be true, full or false"), precompiled)
+		}
+	}
+}
+
+func lintLayout(libDir *paths.Path, props *properties.Map, findings *lint.Findings) {
+	srcDir := libDir.Join("src")
+	recursive := srcDir.IsDir()
+	sourceDir := libDir
+	if recursive {
+		sourceDir = srcDir
+		if files := sourceFilesIn(libDir); len(files) > 0 {
+			findings.Add(lint.Warning, "mixed-layout", libDir,
+				tr("source files in the root folder are ignored by the recursive layout: %s"), strings.Join(files, ", "))
+		}
+		if libDir.Join("utility").IsDir() {
+			findings.Add(lint.Warning, "mixed-layout", libDir.Join("utility"),
+				tr("the utility folder is ignored by the recursive layout"))
+		}
+	}
+
+	if props == nil {
+		return
+	}
+
+	if includes := strings.TrimSpace(props.Get("includes")); includes != "" {
+		for _, include := range strings.Split(includes, ",") {
+			include = strings.TrimSpace(include)
+			if !sourceDir.Join(include).Exist() {
+				findings.Add(lint.Error, "missing-include", libDir.Join("library.properties"),
+					tr("the header %[1]s listed in includes is not in %[2]s"), include, sourceDir)
+			}
+		}
+	}
+
+	precompiled := props.Get("precompiled")
+	if precompiled != "true" && precompiled != "full" {
+		return
+	}
+	if !recursive {
+		findings.Add(lint.Error, "precompiled-layout", libDir, tr("precompiled libraries must use the recursive layout"))
+		return
+	}
+	archives := 0
+	if dirs, err := srcDir.ReadDir(); err == nil {
+		dirs.FilterDirs()
+		for _, dir := range dirs {
+			files, err := dir.ReadDirRecursive()
+			if err != nil {
+				continue
+			}
+			files.FilterSuffix(".a", ".so")
+			archives += len(files)
+		}
+	}
+	if archives == 0 {
+		findings.Add(lint.Error, "precompiled-layout", srcDir,
+			tr("no precompiled archive found, they must be placed in %s"), "src/{build.mcu}/")
+	}
+}
+
+// sourceFilesIn returns the name of the source files directly contained in dir
+func sourceFilesIn(dir *paths.Path) []string {
+	res := []string{}
+	files, err := dir.ReadDir()
+	if err != nil {
+		return res
+	}
+	files.FilterOutDirs()
+	for _, file := range files {
+		if _, ok := globals.SourceFilesValidExtensions[file.Ext()]; ok {
+			res = append(res, file.Base())
+		} else if _, ok := globals.HeaderFilesValidExtensions[file.Ext()]; ok {
+			res = append(res, file.Base())
+		}
+	}
+	return res
+}
@@ -0,0 +1,85 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package libraries
+
+import (
+	"testing"
+
+	"github.com/arduino/arduino-cli/arduino/lint"
+	paths "github.com/arduino/go-paths-helper"
+	"github.com/stretchr/testify/require"
+)
+
+func createLibrary(t *testing.T, files map[string]string) *paths.Path {
+	libDir := paths.New(t.TempDir()).Join("MyLib")
+	for name, content := range files {
+		file := libDir.Join(name)
+		require.NoError(t, file.Parent().MkdirAll())
+		require.NoError(t, file.WriteFile([]byte(content)))
+	}
+	return libDir
+}
+
+func findingIDs(findings lint.Findings, level lint.Level) []string {
+	res := []string{}
+	for _, f := range findings {
+		if f.Level == level {
+			res = append(res, f.RuleID)
+		}
+	}
+	return res
+}
+
+func TestLint(t *testing.T) {
+	libDir := createLibrary(t, map[string]string{
+		"library.properties": "name=MyLib\nversion=1.0.0\nauthor=Me\nmaintainer=Me\n" +
+			"sentence=A library\nparagraph=A library\nurl=https://example.com\n" +
+			"category=Communication\narchitectures=avr,samd\n" +
+			"depends=Servo, Adafruit GFX Library (>=1.0.0)\nincludes=MyLib.h\n",
+		"src/MyLib.h": "",
+	})
+	findings, err := Lint(libDir)
+	require.NoError(t, err)
+	require.Empty(t, findings)
+
+	libDir = createLibrary(t, map[string]string{
+		"library.properties": "name=MyLib\nversion=one\nauthor=Me\n" +
+			"category=Stuff\narchitectures=*,avr\n" +
+			"depends=Servo (>>1.0), (Wrong)\nincludes=Missing.h\nprecompiled=true\n",
+		"src/MyLib.h": "",
+		"MyLib.cpp":   "",
+		"utility/a.c": "",
+	})
+	findings, err = Lint(libDir)
+	require.NoError(t, err)
+	require.ElementsMatch(t, []string{
+		"missing-property", "invalid-version", "invalid-depends", "invalid-depends",
+		"missing-include", "precompiled-layout",
+	}, findingIDs(findings, lint.Error))
+	require.ElementsMatch(t, []string{
+		"invalid-category", "invalid-architectures", "mixed-layout", "mixed-layout",
+	}, findingIDs(findings, lint.Warning))
+	require.True(t, findings.HasErrors())
+
+	libDir = createLibrary(t, map[string]string{"MyLib.h": ""})
+	findings, err = Lint(libDir)
+	require.NoError(t, err)
+	require.Equal(t, []string{"missing-library-properties"}, findingIDs(findings, lint.Warning))
+	require.False(t, findings.HasErrors())
+
+	_, err = Lint(libDir.Join("MyLib.h"))
+	require.Error(t, err)
+}
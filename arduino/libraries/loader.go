@@ -28,10 +28,21 @@ import (
 
 // Load loads a library from the given LibraryLocation
 func Load(libDir *paths.Path, location LibraryLocation) (*Library, error) {
+	var library *Library
+	var err error
 	if libDir.Join("library.properties").Exist() {
-		return makeNewLibrary(libDir, location)
+		library, err = makeNewLibrary(libDir, location)
+	} else {
+		library, err = makeLegacyLibrary(libDir, location)
+	}
+	if err != nil {
+		return nil, err
 	}
-	return makeLegacyLibrary(libDir, location)
+	if library.GitOrigin, err = LoadGitOrigin(libDir); err != nil {
+		return nil, err
+	}
+	library.InstalledAsDependency = libDir.Join(DependencyMarkerFileName).Exist()
+	return library, nil
 }
 
 func addUtilityDirectory(library *Library) {
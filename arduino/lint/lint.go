@@ -0,0 +1,118 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package lint
+
+import (
+	"fmt"
+
+	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
+	paths "github.com/arduino/go-paths-helper"
+)
+
+// Level is the severity of a Finding
+type Level string
+
+const (
+	// Error is a violation of the specification
+	Error Level = "error"
+	// Warning is a problem that doesn't prevent the use of the checked item
+	Warning Level = "warning"
+	// Note is a suggestion
+	Note Level = "note"
+)
+
+// Finding is a problem found by a linter
+type Finding struct {
+	Level   Level
+	RuleID  string
+	Message string
+	File    *paths.Path
+	Line    int
+}
+
+func (f *Finding) String() string {
+	location := ""
+	if f.File != nil {
+		location = f.File.String()
+		if f.Line > 0 {
+			location += fmt.Sprintf(":%d", f.Line)
+		}
+		location += ": "
+	}
+	return fmt.Sprintf("%s%s: %s [%s]", location, f.Level, f.Message, f.RuleID)
+}
+
+// ToRPC converts the Finding into a rpc.LintFinding
+func (f *Finding) ToRPC() *rpc.LintFinding {
+	file := ""
+	if f.File != nil {
+		file = f.File.String()
+	}
+	return &rpc.LintFinding{
+		Level:   string(f.Level),
+		RuleId:  f.RuleID,
+		Message: f.Message,
+		File:    file,
+		Line:    int32(f.Line),
+	}
+}
+
+// FromRPC converts a rpc.LintFinding into a Finding
+func FromRPC(f *rpc.LintFinding) *Finding {
+	var file *paths.Path
+	if f.GetFile() != "" {
+		file = paths.New(f.GetFile())
+	}
+	return &Finding{
+		Level:   Level(f.GetLevel()),
+		RuleID:  f.GetRuleId(),
+		Message: f.GetMessage(),
+		File:    file,
+		Line:    int(f.GetLine()),
+	}
+}
+
+// Findings is a list of Finding
+type Findings []*Finding
+
+// Add appends a new Finding to the list
+func (l *Findings) Add(level Level, ruleID string, file *paths.Path, format string, args ...interface{}) {
+	*l = append(*l, &Finding{
+		Level:   level,
+		RuleID:  ruleID,
+		Message: fmt.Sprintf(format, args...),
+		File:    file,
+	})
+}
+
+// HasErrors returns true if at least one Finding has the Error level
+func (l Findings) HasErrors() bool {
+	for _, f := range l {
+		if f.Level == Error {
+			return true
+		}
+	}
+	return false
+}
+
+// ToRPC converts the findings into a list of rpc.LintFinding
+func (l Findings) ToRPC() []*rpc.LintFinding {
+	res := []*rpc.LintFinding{}
+	for _, f := range l {
+		res = append(res, f.ToRPC())
+	}
+	return res
+}
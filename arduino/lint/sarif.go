@@ -0,0 +1,142 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package lint
+
+import (
+	"encoding/json"
+	"net/url"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	paths "github.com/arduino/go-paths-helper"
+)
+
+// The subset of the SARIF 2.1.0 format used to report the findings, see
+// https://docs.oasis-open.org/sarif/sarif/v2.1.0/sarif-v2.1.0.html
+
+type sarifLog struct {
+	Schema  string      `json:"$schema"`
+	Version string      `json:"version"`
+	Runs    []*sarifRun `json:"runs"`
+}
+
+type sarifRun struct {
+	Tool    *sarifTool     `json:"tool"`
+	Results []*sarifResult `json:"results"`
+}
+
+type sarifTool struct {
+	Driver *sarifDriver `json:"driver"`
+}
+
+type sarifDriver struct {
+	Name           string       `json:"name"`
+	Version        string       `json:"version,omitempty"`
+	InformationURI string       `json:"informationUri,omitempty"`
+	Rules          []*sarifRule `json:"rules"`
+}
+
+type sarifRule struct {
+	ID string `json:"id"`
+}
+
+type sarifResult struct {
+	RuleID    string           `json:"ruleId"`
+	Level     string           `json:"level"`
+	Message   *sarifMessage    `json:"message"`
+	Locations []*sarifLocation `json:"locations,omitempty"`
+}
+
+type sarifMessage struct {
+	Text string `json:"text"`
+}
+
+type sarifLocation struct {
+	PhysicalLocation *sarifPhysicalLocation `json:"physicalLocation"`
+}
+
+type sarifPhysicalLocation struct {
+	ArtifactLocation *sarifArtifactLocation `json:"artifactLocation"`
+	Region           *sarifRegion           `json:"region,omitempty"`
+}
+
+type sarifArtifactLocation struct {
+	URI string `json:"uri"`
+}
+
+type sarifRegion struct {
+	StartLine int `json:"startLine"`
+}
+
+// SARIF returns the findings as a SARIF log produced by the given tool
+func (l Findings) SARIF(toolName, toolVersion string) ([]byte, error) {
+	rules := map[string]bool{}
+	run := &sarifRun{
+		Tool: &sarifTool{Driver: &sarifDriver{
+			Name:           toolName,
+			Version:        toolVersion,
+			InformationURI: "https://arduino.github.io/arduino-cli/",
+			Rules:          []*sarifRule{},
+		}},
+		Results: []*sarifResult{},
+	}
+	for _, f := range l {
+		rules[f.RuleID] = true
+		result := &sarifResult{
+			RuleID:  f.RuleID,
+			Level:   string(f.Level),
+			Message: &sarifMessage{Text: f.Message},
+		}
+		if f.File != nil {
+			location := &sarifPhysicalLocation{
+				ArtifactLocation: &sarifArtifactLocation{URI: fileURI(f.File)},
+			}
+			if f.Line > 0 {
+				location.Region = &sarifRegion{StartLine: f.Line}
+			}
+			result.Locations = []*sarifLocation{{PhysicalLocation: location}}
+		}
+		run.Results = append(run.Results, result)
+	}
+	ids := []string{}
+	for id := range rules {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	for _, id := range ids {
+		run.Tool.Driver.Rules = append(run.Tool.Driver.Rules, &sarifRule{ID: id})
+	}
+
+	return json.MarshalIndent(&sarifLog{
+		Schema:  "https://json.schemastore.org/sarif-2.1.0.json",
+		Version: "2.1.0",
+		Runs:    []*sarifRun{run},
+	}, "", "  ")
+}
+
+func fileURI(file *paths.Path) string {
+	path := filepath.ToSlash(file.String())
+	if !file.IsAbs() {
+		// Relative paths are relative to the working directory
+		return (&url.URL{Path: path}).String()
+	}
+	if !strings.HasPrefix(path, "/") {
+		// Windows drive letter
+		path = "/" + path
+	}
+	return (&url.URL{Scheme: "file", Path: path}).String()
+}
@@ -17,6 +17,7 @@ package resources
 
 import (
 	"fmt"
+	"net/http"
 	"os"
 
 	"github.com/arduino/go-paths-helper"
@@ -42,16 +43,24 @@ func (r *DownloadResource) IsCached(downloadDir *paths.Path) (bool, error) {
 	return archivePath.Exist(), nil
 }
 
-// Download a DownloadResource.
+// Download a DownloadResource. A partially downloaded archive left in the
+// downloadDir by an interrupted download is resumed with an HTTP range
+// request, if the server doesn't support ranges the download is restarted.
 func (r *DownloadResource) Download(downloadDir *paths.Path, config *downloader.Config) (*downloader.Downloader, error) {
 	path, err := r.ArchivePath(downloadDir)
 	if err != nil {
 		return nil, fmt.Errorf(tr("getting archive path: %s"), err)
 	}
 
-	if _, err := path.Stat(); os.IsNotExist(err) {
+	resume := false
+	if info, err := path.Stat(); os.IsNotExist(err) {
 		// normal download
-	} else if err == nil {
+	} else if err != nil {
+		return nil, fmt.Errorf(tr("getting archive file info: %s"), err)
+	} else if r.Size > 0 && info.Size() < r.Size {
+		// resume download
+		resume = true
+	} else {
 		// check local file integrity
 		ok, err := r.TestLocalArchiveIntegrity(downloadDir)
 		if err != nil || !ok {
@@ -62,11 +71,21 @@ func (r *DownloadResource) Download(downloadDir *paths.Path, config *downloader.
 			// File is cached, nothing to do here
 			return nil, nil
 		}
-	} else if err == nil {
-		// resume download
-	} else {
-		return nil, fmt.Errorf(tr("getting archive file info: %s"), err)
 	}
 
-	return downloader.DownloadWithConfig(path.String(), r.URL, *config)
+	if !resume {
+		return downloader.DownloadWithConfig(path.String(), r.URL, *config, downloader.NoResume)
+	}
+	d, err := downloader.DownloadWithConfig(path.String(), r.URL, *config)
+	if err != nil {
+		return nil, err
+	}
+	if d.Resp.StatusCode != http.StatusPartialContent {
+		// The server ignored or refused the range request: restart from zero
+		if err := d.Close(); err != nil {
+			return nil, err
+		}
+		return downloader.DownloadWithConfig(path.String(), r.URL, *config, downloader.NoResume)
+	}
+	return d, nil
 }
@@ -16,12 +16,16 @@
 package resources
 
 import (
+	"bytes"
+	"crypto/sha256"
 	"fmt"
 	"io/ioutil"
 	"net/http"
 	"net/http/httptest"
 	"strings"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/arduino/arduino-cli/httpclient"
 	"github.com/arduino/go-paths-helper"
@@ -82,3 +86,83 @@ func TestDownloadApplyUserAgentHeaderUsingConfig(t *testing.T) {
 	require.Equal(t, goldUserAgentString, userAgentHeaderString)
 
 }
+
+func TestDownloadResume(t *testing.T) {
+	content := []byte(strings.Repeat("0123456789", 1000))
+	hash := sha256.Sum256(content)
+
+	// The handler runs on the server goroutines
+	var lock sync.Mutex
+	requests := []string{}
+	supportRanges := true
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		lock.Lock()
+		requests = append(requests, r.Header.Get("Range"))
+		ranges := supportRanges
+		lock.Unlock()
+		if ranges {
+			http.ServeContent(w, r, "archive.zip", time.Time{}, bytes.NewReader(content))
+			return
+		}
+		w.Write(content)
+	}))
+	defer srv.Close()
+	// takeRequests returns the Range headers of the requests received since
+	// the last call
+	takeRequests := func() []string {
+		lock.Lock()
+		defer lock.Unlock()
+		res := requests
+		requests = []string{}
+		return res
+	}
+
+	tmp, err := paths.MkTempDir("", "")
+	require.NoError(t, err)
+	defer tmp.RemoveAll()
+
+	r := &DownloadResource{
+		ArchiveFileName: "archive.zip",
+		CachePath:       "cache",
+		URL:             srv.URL,
+		Checksum:        fmt.Sprintf("SHA-256:%x", hash),
+		Size:            int64(len(content)),
+	}
+	archivePath := tmp.Join("cache", "archive.zip")
+	download := func() {
+		d, err := r.Download(tmp, &downloader.Config{HttpClient: http.Client{}})
+		require.NoError(t, err)
+		require.NotNil(t, d)
+		require.NoError(t, d.Run())
+		data, err := archivePath.ReadFile()
+		require.NoError(t, err)
+		require.Equal(t, content, data)
+	}
+
+	// A partial download is resumed with a range request
+	require.NoError(t, tmp.Join("cache").MkdirAll())
+	require.NoError(t, archivePath.WriteFile(content[:3000]))
+	download()
+	require.Equal(t, []string{"bytes=3000-"}, takeRequests())
+
+	// A complete archive is not downloaded again
+	d, err := r.Download(tmp, &downloader.Config{HttpClient: http.Client{}})
+	require.NoError(t, err)
+	require.Nil(t, d)
+	require.Empty(t, takeRequests())
+
+	// A corrupted archive is downloaded from scratch
+	corrupted := append([]byte{}, content...)
+	corrupted[0] = 'X'
+	require.NoError(t, archivePath.WriteFile(corrupted))
+	download()
+	require.Equal(t, []string{""}, takeRequests())
+
+	// The download restarts if the server ignores the range request
+	lock.Lock()
+	supportRanges = false
+	lock.Unlock()
+	require.NoError(t, archivePath.WriteFile(content[:3000]))
+	download()
+	require.Equal(t, []string{"bytes=3000-", ""}, takeRequests())
+}
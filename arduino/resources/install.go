@@ -32,31 +32,71 @@ import (
 // Note that tempPath and destDir must be on the same filesystem partition
 // otherwise the last step will fail.
 func (release *DownloadResource) Install(downloadDir, tempPath, destDir *paths.Path) error {
+	root, err := release.Extract(downloadDir, tempPath)
+	if err != nil {
+		return err
+	}
+	defer root.Parent().RemoveAll()
+
+	// Ensure container dir exists
+	destDirParent := destDir.Parent()
+	if err := destDirParent.MkdirAll(); err != nil {
+		return err
+	}
+	defer func() {
+		if empty, err := IsDirEmpty(destDirParent); err == nil && empty {
+			destDirParent.RemoveAll()
+		}
+	}()
+
+	// If the destination dir already exists remove it
+	if destDir.IsDir() {
+		destDir.RemoveAll()
+	}
+
+	// Move/rename the extracted root directory in the destination directory
+	if err := root.Rename(destDir); err != nil {
+		return fmt.Errorf(tr("moving extracted archive to destination dir: %s"), err)
+	}
+
+	// Record the installed files to allow later verification
+	if err := CreateInstalledFilesManifest(destDir); err != nil {
+		return fmt.Errorf(tr("creating installed files manifest: %s"), err)
+	}
+
+	return nil
+}
+
+// Extract checks the integrity of the archive and unpacks it in a temporary
+// subdir of tempPath. The archive must contain only one root dir, its path is
+// returned: the caller is responsible to move it and to remove its parent.
+func (release *DownloadResource) Extract(downloadDir, tempPath *paths.Path) (*paths.Path, error) {
 	// Check the integrity of the package
 	if ok, err := release.TestLocalArchiveIntegrity(downloadDir); err != nil {
-		return fmt.Errorf(tr("testing local archive integrity: %s"), err)
+		return nil, fmt.Errorf(tr("testing local archive integrity: %s"), err)
 	} else if !ok {
-		return fmt.Errorf(tr("checking local archive integrity"))
+		return nil, fmt.Errorf(tr("checking local archive integrity"))
 	}
 
 	// Create a temporary dir to extract package
 	if err := tempPath.MkdirAll(); err != nil {
-		return fmt.Errorf(tr("creating temp dir for extraction: %s"), err)
+		return nil, fmt.Errorf(tr("creating temp dir for extraction: %s"), err)
 	}
 	tempDir, err := tempPath.MkTempDir("package-")
 	if err != nil {
-		return fmt.Errorf(tr("creating temp dir for extraction: %s"), err)
+		return nil, fmt.Errorf(tr("creating temp dir for extraction: %s"), err)
 	}
-	defer tempDir.RemoveAll()
 
 	// Obtain the archive path and open it
 	archivePath, err := release.ArchivePath(downloadDir)
 	if err != nil {
-		return fmt.Errorf(tr("getting archive path: %s"), err)
+		tempDir.RemoveAll()
+		return nil, fmt.Errorf(tr("getting archive path: %s"), err)
 	}
 	file, err := os.Open(archivePath.String())
 	if err != nil {
-		return fmt.Errorf(tr("opening archive file: %s"), err)
+		tempDir.RemoveAll()
+		return nil, fmt.Errorf(tr("opening archive file: %s"), err)
 	}
 	defer file.Close()
 
@@ -64,43 +104,17 @@ func (release *DownloadResource) Install(downloadDir, tempPath, destDir *paths.P
 	ctx, cancel := cleanup.InterruptableContext(context.Background())
 	defer cancel()
 	if err := extract.Archive(ctx, file, tempDir.String(), nil); err != nil {
-		return fmt.Errorf(tr("extracting archive: %s"), err)
+		tempDir.RemoveAll()
+		return nil, fmt.Errorf(tr("extracting archive: %s"), err)
 	}
 
 	// Check package content and find package root dir
 	root, err := findPackageRoot(tempDir)
 	if err != nil {
-		return fmt.Errorf(tr("searching package root dir: %s"), err)
-	}
-
-	// Ensure container dir exists
-	destDirParent := destDir.Parent()
-	if err := destDirParent.MkdirAll(); err != nil {
-		return err
+		tempDir.RemoveAll()
+		return nil, fmt.Errorf(tr("searching package root dir: %s"), err)
 	}
-	defer func() {
-		if empty, err := IsDirEmpty(destDirParent); err == nil && empty {
-			destDirParent.RemoveAll()
-		}
-	}()
-
-	// If the destination dir already exists remove it
-	if destDir.IsDir() {
-		destDir.RemoveAll()
-	}
-
-	// Move/rename the extracted root directory in the destination directory
-	if err := root.Rename(destDir); err != nil {
-		return fmt.Errorf(tr("moving extracted archive to destination dir: %s"), err)
-	}
-
-	// TODO
-	// // Create a package file
-	// if err := createPackageFile(destDir); err != nil {
-	// 	return err
-	// }
-
-	return nil
+	return root, nil
 }
 
 // IsDirEmpty returns true if the directory specified by path is empty.
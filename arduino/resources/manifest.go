@@ -0,0 +1,166 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package resources
+
+import (
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+
+	paths "github.com/arduino/go-paths-helper"
+)
+
+// InstalledFilesManifestName is the name of the file, placed in the root
+// folder of an installed resource, that lists the installed files together
+// with their hashes.
+const InstalledFilesManifestName = "installed_files.json"
+
+type installedFilesManifest struct {
+	Files map[string]string `json:"files"`
+}
+
+// InstalledFilesReport is the result of the verification of an installed
+// resource against the manifest recorded at install time.
+type InstalledFilesReport struct {
+	// ManifestMissing is true if the resource has been installed without
+	// recording a manifest, in this case the files can't be verified
+	ManifestMissing bool
+	// Modified are the files whose content differs from the installed one
+	Modified []string
+	// Missing are the installed files that have been removed
+	Missing []string
+	// Extra are the files not installed from the archive, they may have been
+	// added by the user or by a post install script
+	Extra []string
+}
+
+// IsIntact returns true if none of the installed files has been modified or
+// removed. Extra files don't affect the integrity of the installation, and
+// a resource without manifest is reported as not verifiable but not damaged.
+func (r *InstalledFilesReport) IsIntact() bool {
+	return len(r.Modified) == 0 && len(r.Missing) == 0
+}
+
+// CreateInstalledFilesManifest records the hashes of all the files contained
+// in root in the installed files manifest.
+func CreateInstalledFilesManifest(root *paths.Path) error {
+	files, err := hashInstalledFiles(root, nil)
+	if err != nil {
+		return err
+	}
+	data, err := json.MarshalIndent(&installedFilesManifest{Files: files}, "", "  ")
+	if err != nil {
+		return err
+	}
+	return root.Join(InstalledFilesManifestName).WriteFile(data)
+}
+
+// VerifyInstalledFiles compares the files contained in root with the ones
+// listed in the installed files manifest. The ignored files, given as paths
+// relative to root using forward slashes, are not checked: they are usually
+// files created by the installer after the resource has been extracted.
+func VerifyInstalledFiles(root *paths.Path, ignored ...string) (*InstalledFilesReport, error) {
+	report := &InstalledFilesReport{}
+	data, err := root.Join(InstalledFilesManifestName).ReadFile()
+	if os.IsNotExist(err) {
+		report.ManifestMissing = true
+		return report, nil
+	} else if err != nil {
+		return nil, fmt.Errorf(tr("reading installed files manifest: %s"), err)
+	}
+	var manifest installedFilesManifest
+	if err := json.Unmarshal(data, &manifest); err != nil {
+		return nil, fmt.Errorf(tr("parsing installed files manifest: %s"), err)
+	}
+
+	ignoredFiles := map[string]bool{}
+	for _, file := range ignored {
+		ignoredFiles[file] = true
+	}
+	files, err := hashInstalledFiles(root, ignoredFiles)
+	if err != nil {
+		return nil, err
+	}
+	for file, hash := range manifest.Files {
+		if ignoredFiles[file] {
+			continue
+		}
+		if current, ok := files[file]; !ok {
+			report.Missing = append(report.Missing, file)
+		} else if current != hash {
+			report.Modified = append(report.Modified, file)
+		}
+	}
+	for file := range files {
+		if _, ok := manifest.Files[file]; !ok {
+			report.Extra = append(report.Extra, file)
+		}
+	}
+	sort.Strings(report.Modified)
+	sort.Strings(report.Missing)
+	sort.Strings(report.Extra)
+	return report, nil
+}
+
+// hashInstalledFiles returns the hashes of the files contained in root, keyed
+// by their path relative to root. Symbolic links are not followed, the link
+// target is recorded instead.
+func hashInstalledFiles(root *paths.Path, ignored map[string]bool) (map[string]string, error) {
+	files := map[string]string{}
+	err := filepath.Walk(root.String(), func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() {
+			return nil
+		}
+		rel, err := filepath.Rel(root.String(), path)
+		if err != nil {
+			return err
+		}
+		rel = filepath.ToSlash(rel)
+		if rel == InstalledFilesManifestName || ignored[rel] {
+			return nil
+		}
+		if info.Mode()&os.ModeSymlink != 0 {
+			target, err := os.Readlink(path)
+			if err != nil {
+				return err
+			}
+			files[rel] = "symlink:" + filepath.ToSlash(target)
+			return nil
+		}
+		f, err := os.Open(path)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		hash := sha256.New()
+		if _, err := io.Copy(hash, f); err != nil {
+			return fmt.Errorf(tr("failed to compute hash of file \"%s\""), rel)
+		}
+		files[rel] = fmt.Sprintf("SHA-256:%x", hash.Sum(nil))
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return files, nil
+}
@@ -0,0 +1,58 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package resources
+
+import (
+	"testing"
+
+	paths "github.com/arduino/go-paths-helper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInstalledFilesManifest(t *testing.T) {
+	root, err := paths.MkTempDir("", "test-manifest")
+	require.NoError(t, err)
+	defer root.RemoveAll()
+
+	require.NoError(t, root.Join("bin").MkdirAll())
+	require.NoError(t, root.Join("bin", "tool").WriteFile([]byte("tool")))
+	require.NoError(t, root.Join("platform.txt").WriteFile([]byte("name=Test")))
+	require.NoError(t, root.Join("boards.txt").WriteFile([]byte("uno.name=Uno")))
+
+	report, err := VerifyInstalledFiles(root)
+	require.NoError(t, err)
+	require.True(t, report.ManifestMissing)
+	// Resources installed without manifest can't be verified but are not damaged
+	require.True(t, report.IsIntact())
+
+	require.NoError(t, CreateInstalledFilesManifest(root))
+	require.True(t, root.Join(InstalledFilesManifestName).Exist())
+	report, err = VerifyInstalledFiles(root)
+	require.NoError(t, err)
+	require.True(t, report.IsIntact())
+	require.Empty(t, report.Extra)
+
+	require.NoError(t, root.Join("bin", "tool").WriteFile([]byte("changed")))
+	require.NoError(t, root.Join("boards.txt").Remove())
+	require.NoError(t, root.Join("installed.json").WriteFile([]byte("{}")))
+	require.NoError(t, root.Join("bin", "extra").WriteFile([]byte("extra")))
+	report, err = VerifyInstalledFiles(root, "installed.json")
+	require.NoError(t, err)
+	require.False(t, report.IsIntact())
+	require.Equal(t, []string{"bin/tool"}, report.Modified)
+	require.Equal(t, []string{"boards.txt"}, report.Missing)
+	require.Equal(t, []string{"bin/extra"}, report.Extra)
+}
@@ -0,0 +1,155 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package security
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"strings"
+
+	"github.com/arduino/go-paths-helper"
+	"golang.org/x/crypto/openpgp"
+)
+
+// Keyring is a folder containing the OpenPGP public keys that the user trusts,
+// in addition to the bundled Arduino key, to sign package indexes. Each key
+// is stored in a separate file named after the key fingerprint.
+type Keyring struct {
+	Dir *paths.Path
+}
+
+// NewKeyring returns the Keyring stored in dir
+func NewKeyring(dir *paths.Path) *Keyring {
+	return &Keyring{Dir: dir}
+}
+
+// Keys returns the keys in the keyring. A nil Keyring, or a keyring whose
+// folder doesn't exist, has no keys.
+func (k *Keyring) Keys() (openpgp.EntityList, error) {
+	res := openpgp.EntityList{}
+	if k == nil || !k.Dir.IsDir() {
+		return res, nil
+	}
+	files, err := k.Dir.ReadDir()
+	if err != nil {
+		return nil, fmt.Errorf(tr("reading keyring: %s"), err)
+	}
+	files.FilterSuffix(".gpg")
+	files.Sort()
+	for _, file := range files {
+		f, err := file.Open()
+		if err != nil {
+			return nil, fmt.Errorf(tr("reading keyring: %s"), err)
+		}
+		entities, err := openpgp.ReadKeyRing(f)
+		f.Close()
+		if err != nil {
+			return nil, fmt.Errorf(tr("reading key %[1]s: %[2]s"), file.Base(), err)
+		}
+		res = append(res, entities...)
+	}
+	return res, nil
+}
+
+// Add adds to the keyring the public keys read from r, either ASCII armored
+// or binary, and returns them.
+func (k *Keyring) Add(r io.Reader) (openpgp.EntityList, error) {
+	data, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, fmt.Errorf(tr("reading key: %s"), err)
+	}
+	entities, err := openpgp.ReadArmoredKeyRing(bytes.NewReader(data))
+	if err != nil {
+		if entities, err = openpgp.ReadKeyRing(bytes.NewReader(data)); err != nil {
+			return nil, fmt.Errorf(tr("reading key: %s"), err)
+		}
+	}
+	if err := k.Dir.MkdirAll(); err != nil {
+		return nil, fmt.Errorf(tr("creating keyring: %s"), err)
+	}
+	for _, entity := range entities {
+		// Serialize only writes the public part of the key
+		var buff bytes.Buffer
+		if err := entity.Serialize(&buff); err != nil {
+			return nil, fmt.Errorf(tr("reading key: %s"), err)
+		}
+		if err := k.Dir.Join(Fingerprint(entity) + ".gpg").WriteFile(buff.Bytes()); err != nil {
+			return nil, fmt.Errorf(tr("adding key to keyring: %s"), err)
+		}
+	}
+	return entities, nil
+}
+
+// Remove removes from the keyring the key identified by id, that is the
+// fingerprint of the key or its last 8 or more hex digits, like the long or
+// the short key ID.
+func (k *Keyring) Remove(id string) (*openpgp.Entity, error) {
+	id = strings.ToUpper(strings.TrimPrefix(strings.ReplaceAll(id, " ", ""), "0x"))
+	if len(id) < 8 {
+		return nil, errors.New(tr("the key ID must have at least 8 hex digits"))
+	}
+	keys, err := k.Keys()
+	if err != nil {
+		return nil, err
+	}
+	var found *openpgp.Entity
+	for _, entity := range keys {
+		if !strings.HasSuffix(Fingerprint(entity), id) {
+			continue
+		}
+		if found != nil {
+			return nil, fmt.Errorf(tr("more than one key matches %s"), id)
+		}
+		found = entity
+	}
+	if found == nil {
+		return nil, fmt.Errorf(tr("key %s not found in keyring"), id)
+	}
+	if err := k.Dir.Join(Fingerprint(found) + ".gpg").Remove(); err != nil {
+		return nil, fmt.Errorf(tr("removing key from keyring: %s"), err)
+	}
+	return found, nil
+}
+
+// VerifyDetachedSignature checks that the detached GPG signature (in the
+// signaturePath file) matches the given targetPath file and is an authentic
+// signature from the bundled Arduino key or from one of the keys of the
+// keyring. The PGP entity that produced the signature is returned too.
+func (k *Keyring) VerifyDetachedSignature(targetPath *paths.Path, signaturePath *paths.Path) (bool, *openpgp.Entity, error) {
+	arduinoKeyringFile, err := keys.Open("keys/arduino_public.gpg.key")
+	if err != nil {
+		panic("could not find bundled signature keys")
+	}
+	defer arduinoKeyringFile.Close()
+	keyRing, err := openpgp.ReadKeyRing(arduinoKeyringFile)
+	if err != nil {
+		return false, nil, fmt.Errorf(tr("retrieving Arduino public keys: %s"), err)
+	}
+	userKeys, err := k.Keys()
+	if err != nil {
+		return false, nil, err
+	}
+	return verifySignatureWithKeyRing(targetPath, signaturePath, append(keyRing, userKeys...))
+}
+
+// Fingerprint returns the fingerprint of the primary key of the entity as an
+// uppercase hex string
+func Fingerprint(entity *openpgp.Entity) string {
+	return fmt.Sprintf("%X", entity.PrimaryKey.Fingerprint)
+}
@@ -73,3 +73,51 @@ func TestVerifySignature(t *testing.T) {
 	require.Nil(t, signer)
 	require.Error(t, err)
 }
+
+func TestKeyring(t *testing.T) {
+	dir, err := paths.MkTempDir("", "test-keyring")
+	require.NoError(t, err)
+	defer dir.RemoveAll()
+	keyring := NewKeyring(dir.Join("keys"))
+
+	keys, err := keyring.Keys()
+	require.NoError(t, err)
+	require.Empty(t, keys)
+
+	// The Arduino key is always trusted
+	res, signer, err := keyring.VerifyDetachedSignature(PackageIndexPath, PackageSignaturePath)
+	require.NoError(t, err)
+	require.True(t, res)
+	require.Equal(t, uint64(0x7baf404c2dfab4ae), signer.PrimaryKey.KeyId)
+
+	res, _, err = keyring.VerifyDetachedSignature(ModuleFWIndexPath, ModuleFWSignaturePath)
+	require.Error(t, err)
+	require.False(t, res)
+
+	keyFile, err := ModuleFWIndexKey.Open()
+	require.NoError(t, err)
+	added, err := keyring.Add(keyFile)
+	keyFile.Close()
+	require.NoError(t, err)
+	require.Len(t, added, 1)
+	keys, err = keyring.Keys()
+	require.NoError(t, err)
+	require.Len(t, keys, 1)
+	require.Equal(t, Fingerprint(added[0]), Fingerprint(keys[0]))
+
+	res, signer, err = keyring.VerifyDetachedSignature(ModuleFWIndexPath, ModuleFWSignaturePath)
+	require.NoError(t, err)
+	require.True(t, res)
+	require.Equal(t, uint64(0x82f2d7c7c5a22a73), signer.PrimaryKey.KeyId)
+
+	_, err = keyring.Remove("1234")
+	require.Error(t, err)
+	_, err = keyring.Remove("DEADBEEF")
+	require.Error(t, err)
+	removed, err := keyring.Remove("82f2d7c7c5a22a73")
+	require.NoError(t, err)
+	require.Equal(t, uint64(0x82f2d7c7c5a22a73), removed.PrimaryKey.KeyId)
+	keys, err = keyring.Keys()
+	require.NoError(t, err)
+	require.Empty(t, keys)
+}
@@ -71,6 +71,10 @@ func VerifySignature(targetPath *paths.Path, signaturePath *paths.Path, arduinoK
 	if err != nil {
 		return false, nil, fmt.Errorf(tr("retrieving Arduino public keys: %s"), err)
 	}
+	return verifySignatureWithKeyRing(targetPath, signaturePath, keyRing)
+}
+
+func verifySignatureWithKeyRing(targetPath *paths.Path, signaturePath *paths.Path, keyRing openpgp.KeyRing) (bool, *openpgp.Entity, error) {
 	target, err := targetPath.Open()
 	if err != nil {
 		return false, nil, fmt.Errorf(tr("opening target file: %s"), err)
@@ -44,6 +44,12 @@ type Sketch struct {
 // Metadata is the kind of data associated to a project such as the connected board
 type Metadata struct {
 	CPU BoardMetadata `json:"cpu,omitempty"`
+	// LibrariesDir is the directory, relative to the sketch, containing the
+	// libraries vendored in the sketch. Defaults to "libraries".
+	LibrariesDir string `json:"libraries_dir,omitempty"`
+	// LibraryOverrides maps included headers to the library that must be used
+	// to resolve them, as "LibName", "LibName@Version" or a path to the library.
+	LibraryOverrides map[string]string `json:"library_overrides,omitempty"`
 }
 
 // BoardMetadata represents the board metadata for the sketch
@@ -105,6 +111,10 @@ func New(path *paths.Path) (*Sketch, error) {
 		return nil, fmt.Errorf(tr("can't find main Sketch file in %s"), path)
 	}
 
+	if err := sketch.importMetadata(); err != nil {
+		return nil, fmt.Errorf(tr("importing sketch metadata: %s"), err)
+	}
+
 	sketchFolderFiles, err := sketch.supportedFiles()
 	if err != nil {
 		return nil, err
@@ -151,12 +161,21 @@ func New(path *paths.Path) (*Sketch, error) {
 	sort.Sort(&sketch.OtherSketchFiles)
 	sort.Sort(&sketch.RootFolderFiles)
 
-	if err := sketch.importMetadata(); err != nil {
-		return nil, fmt.Errorf(tr("importing sketch metadata: %s"), err)
-	}
 	return sketch, nil
 }
 
+// LibrariesDir returns the directory containing the libraries vendored in the
+// sketch. The directory may not exist.
+func (s *Sketch) LibrariesDir() *paths.Path {
+	if s.Metadata != nil && s.Metadata.LibrariesDir != "" {
+		if dir := paths.New(s.Metadata.LibrariesDir); dir.IsAbs() {
+			return dir
+		}
+		return s.FullPath.Join(s.Metadata.LibrariesDir)
+	}
+	return s.FullPath.Join("libraries")
+}
+
 // supportedFiles reads all files recursively contained in Sketch and
 // filter out unneded or unsupported ones and returns them
 func (s *Sketch) supportedFiles() (*paths.PathList, error) {
@@ -166,6 +185,16 @@ func (s *Sketch) supportedFiles() (*paths.PathList, error) {
 	}
 	files.FilterOutDirs()
 	files.FilterOutHiddenFiles()
+	// The vendored libraries are not part of the sketch sources
+	librariesDir := s.LibrariesDir()
+	sources := paths.NewPathList()
+	for _, file := range files {
+		if isInside, err := file.IsInsideDir(librariesDir); err == nil && isInside {
+			continue
+		}
+		sources.Add(file)
+	}
+	files = sources
 	validExtensions := []string{}
 	for ext := range globals.MainFileValidExtensions {
 		validExtensions = append(validExtensions, ext)
@@ -402,3 +402,17 @@ func TestSketchWithMultipleSymlinkLoops(t *testing.T) {
 	require.Error(t, err)
 	require.Nil(t, sketch)
 }
+
+func TestSketchWithVendoredLibraries(t *testing.T) {
+	sketchPath := paths.New("testdata", "SketchWithVendoredLibraries")
+	sketch, err := New(sketchPath)
+	require.NoError(t, err)
+	require.True(t, sketchPath.Join("libraries").EquivalentTo(sketch.LibrariesDir()))
+	// Vendored libraries sources are not part of the sketch
+	require.Equal(t, 2, sketch.AdditionalFiles.Len())
+	require.True(t, sketch.AdditionalFiles.ContainsEquivalentTo(sketchPath.Join("helper.cpp")))
+	require.True(t, sketch.AdditionalFiles.ContainsEquivalentTo(sketchPath.Join("helper.h")))
+
+	sketch.Metadata.LibrariesDir = "vendor"
+	require.True(t, sketchPath.Join("vendor").EquivalentTo(sketch.LibrariesDir()))
+}
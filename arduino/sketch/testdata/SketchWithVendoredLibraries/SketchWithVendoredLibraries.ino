@@ -0,0 +1,4 @@
+#include <MyLib.h>
+
+void setup() {}
+void loop() {}
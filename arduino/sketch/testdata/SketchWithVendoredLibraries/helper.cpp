@@ -0,0 +1 @@
+#include "helper.h"
@@ -40,6 +40,7 @@ func NewCommand() *cobra.Command {
 	boardCommand.AddCommand(initDetailsCommand())
 	boardCommand.AddCommand(initListCommand())
 	boardCommand.AddCommand(initListAllCommand())
+	boardCommand.AddCommand(initReadCommand())
 	boardCommand.AddCommand(initSearchCommand())
 
 	return boardCommand
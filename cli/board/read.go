@@ -0,0 +1,90 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package board
+
+import (
+	"context"
+	"os"
+
+	"github.com/arduino/arduino-cli/cli/arguments"
+	"github.com/arduino/arduino-cli/cli/errorcodes"
+	"github.com/arduino/arduino-cli/cli/feedback"
+	"github.com/arduino/arduino-cli/cli/instance"
+	"github.com/arduino/arduino-cli/commands/upload"
+	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+var (
+	readPort       arguments.Port
+	readProgrammer arguments.Programmer
+	readRegion     string
+	readOutput     string
+	readVerbose    bool
+	readDryRun     bool
+)
+
+func initReadCommand() *cobra.Command {
+	readCommand := &cobra.Command{
+		Use:   "read",
+		Short: tr("Read back the memory of a board."),
+		Long:  tr("Read back the content of a memory region of a board and save it in a file."),
+		Example: "" +
+			"  " + os.Args[0] + " board read -b arduino:avr:uno -p /dev/ttyACM0 --region eeprom --output eeprom.bin\n" +
+			"  " + os.Args[0] + " board read -b arduino:avr:uno -P atmel_ice --region flash --output flash.bin",
+		Args: cobra.NoArgs,
+		Run:  runReadCommand,
+	}
+
+	fqbn.AddToCommand(readCommand)
+	readPort.AddToCommand(readCommand)
+	readProgrammer.AddToCommand(readCommand)
+	readCommand.Flags().StringVar(&readRegion, "region", "flash", tr("Memory region to read, e.g.: %s", "flash, eeprom"))
+	readCommand.Flags().StringVarP(&readOutput, "output", "o", "", tr("File where the content of the memory is saved."))
+	readCommand.Flags().BoolVarP(&readVerbose, "verbose", "v", false, tr("Turns on verbose mode."))
+	readCommand.Flags().BoolVar(&readDryRun, "dry-run", false, tr("Do not perform the actual read, just log out actions"))
+	readCommand.Flags().MarkHidden("dry-run")
+	readCommand.MarkFlagRequired("output")
+
+	return readCommand
+}
+
+func runReadCommand(command *cobra.Command, args []string) {
+	instance := instance.CreateAndInit()
+
+	logrus.Info("Executing `arduino-cli board read`")
+
+	discoveryPort, err := readPort.GetPort(instance, nil)
+	if err != nil {
+		feedback.Errorf(tr("Error reading board memory: %v"), err)
+		os.Exit(errorcodes.ErrGeneric)
+	}
+
+	if _, err := upload.Read(context.Background(), &rpc.BoardReadRequest{
+		Instance:   instance,
+		Fqbn:       fqbn.String(),
+		Port:       discoveryPort.ToRPC(),
+		Verbose:    readVerbose,
+		Programmer: readProgrammer.String(),
+		DryRun:     readDryRun,
+		Region:     readRegion,
+		OutputPath: readOutput,
+	}, os.Stdout, os.Stderr); err != nil {
+		feedback.Errorf(tr("Error reading board memory: %v"), err)
+		os.Exit(errorcodes.ErrGeneric)
+	}
+}
@@ -31,10 +31,13 @@ func NewCommand() *cobra.Command {
 		Short: tr("Arduino cache commands."),
 		Long:  tr("Arduino cache commands."),
 		Example: "# " + tr("Clean caches.") + "\n" +
-			" " + os.Args[0] + " cache clean\n\n",
+			" " + os.Args[0] + " cache clean\n\n" +
+			"# " + tr("Remove unused archives from the cache.") + "\n" +
+			" " + os.Args[0] + " cache gc\n\n",
 	}
 
 	cacheCommand.AddCommand(initCleanCommand())
+	cacheCommand.AddCommand(initGCCommand())
 
 	return cacheCommand
 }
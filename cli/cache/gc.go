@@ -0,0 +1,137 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package cache
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/arduino/arduino-cli/cli/errorcodes"
+	"github.com/arduino/arduino-cli/cli/feedback"
+	"github.com/arduino/arduino-cli/cli/instance"
+	"github.com/arduino/arduino-cli/cli/output"
+	"github.com/arduino/arduino-cli/commands/cache"
+	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
+	"github.com/arduino/arduino-cli/table"
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+var (
+	gcMaxAgeDays int32
+	gcMaxSize    string
+	gcDryRun     bool
+)
+
+func initGCCommand() *cobra.Command {
+	gcCommand := &cobra.Command{
+		Use:   "gc",
+		Short: tr("Remove unused archives from the Boards/Library Manager download cache."),
+		Long: tr(`Remove from the "directories.downloads" folder the archives not used by the installed platforms, tools and
+libraries, and replace identical archives with hardlinks. By default all the unused archives are removed, with
+--max-age and --max-size only the ones older than the given number of days or the oldest ones exceeding the given
+cache size are removed.`),
+		Example: "" +
+			"  " + os.Args[0] + " cache gc\n" +
+			"  " + os.Args[0] + " cache gc --max-age 30 --max-size 2GB --dry-run",
+		Args: cobra.NoArgs,
+		Run:  runGCCommand,
+	}
+	gcCommand.Flags().Int32Var(&gcMaxAgeDays, "max-age", 0, tr("Remove only the unused archives older than the given number of days."))
+	gcCommand.Flags().StringVar(&gcMaxSize, "max-size", "", tr("Remove the oldest unused archives until the cache is smaller than the given size (e.g. 500MB, 2GB)."))
+	gcCommand.Flags().BoolVar(&gcDryRun, "dry-run", false, tr("Show the archives that would be removed without removing them."))
+	return gcCommand
+}
+
+func runGCCommand(cmd *cobra.Command, args []string) {
+	inst := instance.CreateAndInit()
+	logrus.Info("Executing `arduino-cli cache gc`")
+
+	maxSize, err := parseSize(gcMaxSize)
+	if err != nil {
+		feedback.Errorf(tr("Invalid size: %v"), err)
+		os.Exit(errorcodes.ErrBadArgument)
+	}
+	res, err := cache.CacheGC(context.Background(), &rpc.CacheGCRequest{
+		Instance:   inst,
+		MaxAgeDays: gcMaxAgeDays,
+		MaxSize:    maxSize,
+		DryRun:     gcDryRun,
+	})
+	if err != nil {
+		feedback.Errorf(tr("Error cleaning caches: %v"), err)
+		os.Exit(errorcodes.ErrGeneric)
+	}
+	feedback.PrintResult(gcResult{res: res, dryRun: gcDryRun})
+}
+
+// parseSize parses a size in bytes with an optional KB, MB or GB suffix
+func parseSize(size string) (int64, error) {
+	size = strings.ToUpper(strings.TrimSpace(size))
+	if size == "" {
+		return 0, nil
+	}
+	multiplier := int64(1)
+	for i, suffix := range []string{"KB", "MB", "GB", "TB"} {
+		if strings.HasSuffix(size, suffix) {
+			multiplier = int64(1) << (10 * (i + 1))
+			size = strings.TrimSpace(strings.TrimSuffix(size, suffix))
+			break
+		}
+	}
+	size = strings.TrimSuffix(size, "B")
+	n, err := strconv.ParseInt(size, 10, 64)
+	if err != nil || n < 0 {
+		return 0, fmt.Errorf(tr("%s is not a valid size"), size)
+	}
+	return n * multiplier, nil
+}
+
+type gcResult struct {
+	res    *rpc.CacheGCResponse
+	dryRun bool
+}
+
+func (r gcResult) Data() interface{} {
+	return r.res
+}
+
+func (r gcResult) String() string {
+	t := table.New()
+	if len(r.res.GetRemoved()) > 0 || len(r.res.GetDeduplicated()) > 0 {
+		t.SetHeader(tr("Action"), tr("Archive"), tr("Size"))
+		for _, archive := range r.res.GetRemoved() {
+			t.AddRow(tr("removed"), archive.GetPath(), output.FormatSize(archive.GetSize()))
+		}
+		for _, archive := range r.res.GetDeduplicated() {
+			t.AddRow(tr("deduplicated"), archive.GetPath(), output.FormatSize(archive.GetSize()))
+		}
+	}
+	reclaimed := r.res.GetSizeBefore() - r.res.GetSizeAfter()
+	summary := tr("Reclaimed %[1]s, the cache now uses %[2]s (%[3]d archives in use).",
+		output.FormatSize(reclaimed), output.FormatSize(r.res.GetSizeAfter()), r.res.GetUsedArchives())
+	if r.dryRun {
+		summary = tr("Dry run: %[1]s would be reclaimed, the cache would use %[2]s (%[3]d archives in use).",
+			output.FormatSize(reclaimed), output.FormatSize(r.res.GetSizeAfter()), r.res.GetUsedArchives())
+	}
+	if len(r.res.GetRemoved()) == 0 && len(r.res.GetDeduplicated()) == 0 {
+		return tr("Nothing to clean.") + "\n" + summary
+	}
+	return t.Render() + "\n" + summary
+}
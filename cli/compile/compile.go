@@ -27,6 +27,7 @@ import (
 	"github.com/arduino/arduino-cli/arduino"
 	"github.com/arduino/arduino-cli/arduino/cores/packagemanager"
 	"github.com/arduino/arduino-cli/arduino/discovery"
+	libs "github.com/arduino/arduino-cli/arduino/libraries"
 	"github.com/arduino/arduino-cli/arduino/sketch"
 	"github.com/arduino/arduino-cli/cli/arguments"
 	"github.com/arduino/arduino-cli/cli/feedback"
@@ -35,6 +36,7 @@ import (
 	"github.com/arduino/arduino-cli/commands"
 	"github.com/arduino/arduino-cli/configuration"
 	"github.com/arduino/arduino-cli/i18n"
+	"github.com/arduino/arduino-cli/table"
 	"github.com/sirupsen/logrus"
 
 	"github.com/arduino/arduino-cli/cli/errorcodes"
@@ -66,6 +68,7 @@ var (
 	clean                   bool                 // Cleanup the build folder and do not use any cached build
 	compilationDatabaseOnly bool                 // Only create compilation database without actually compiling
 	sourceOverrides         string               // Path to a .json file that contains a set of replacements of the sketch source code.
+	explainLibs             bool                 // Print how the library used for each include has been chosen.
 	// library and libraries sound similar but they're actually different.
 	// library expects a path to the root folder of one single library.
 	// libraries expects a path to a directory containing multiple libraries, similarly to the <directories.user>/libraries path.
@@ -122,6 +125,7 @@ func NewCommand() *cobra.Command {
 	compileCommand.Flags().BoolP("export-binaries", "e", false, tr("If set built binaries will be exported to the sketch folder."))
 	compileCommand.Flags().StringVar(&sourceOverrides, "source-override", "", tr("Optional. Path to a .json file that contains a set of replacements of the sketch source code."))
 	compileCommand.Flag("source-override").Hidden = true
+	compileCommand.Flags().BoolVar(&explainLibs, "explain-libs", false, tr("Show all the libraries found for each include and why the used one has been chosen."))
 
 	configuration.Settings.BindPFlag("sketch.always_export_binaries", compileCommand.Flags().Lookup("export-binaries"))
 
@@ -267,6 +271,7 @@ func runCompileCommand(cmd *cobra.Command, args []string) {
 		CompileErr:    compileStdErr.String(),
 		BuilderResult: compileRes,
 		Success:       compileError == nil,
+		explainLibs:   explainLibs,
 	})
 	if compileError != nil {
 		feedback.Errorf(tr("Error during build: %v"), compileError)
@@ -301,6 +306,7 @@ type compileResult struct {
 	CompileErr    string               `json:"compiler_err"`
 	BuilderResult *rpc.CompileResponse `json:"builder_result"`
 	Success       bool                 `json:"success"`
+	explainLibs   bool
 }
 
 func (r *compileResult) Data() interface{} {
@@ -309,5 +315,38 @@ func (r *compileResult) Data() interface{} {
 
 func (r *compileResult) String() string {
 	// The output is already printed via os.Stdout/os.Stdin
-	return ""
+	if !r.explainLibs || r.BuilderResult == nil {
+		return ""
+	}
+
+	res := ""
+	for _, resolution := range r.BuilderResult.GetLibraryResolutions() {
+		res += fmt.Sprintln()
+		res += fmt.Sprintln(tr("Libraries found for %[1]s (%[2]s):", resolution.GetInclude(), resolution.GetReason()))
+		t := table.New()
+		t.SetHeader("", tr("Name"), tr("Version"), tr("Location"), tr("Architecture"), tr("Name match"), tr("Location priority"), tr("Priority"), tr("Closest match"), tr("Path"))
+		for _, candidate := range resolution.GetCandidates() {
+			selected := ""
+			if candidate.GetSelected() {
+				selected = "*"
+			}
+			closestMatch := ""
+			if candidate.GetClosestMatch() {
+				closestMatch = "*"
+			}
+			location := libs.FromRPCLibraryLocation(candidate.GetLocation())
+			t.AddRow(selected,
+				candidate.GetName(),
+				candidate.GetVersion(),
+				location.String(),
+				candidate.GetArchitecturePriority(),
+				candidate.GetNamePriority(),
+				candidate.GetLocationPriority(),
+				candidate.GetPriority(),
+				closestMatch,
+				candidate.GetInstallDir())
+		}
+		res += t.Render()
+	}
+	return res
 }
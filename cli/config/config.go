@@ -38,6 +38,7 @@ func NewCommand() *cobra.Command {
 	configCommand.AddCommand(initDeleteCommand())
 	configCommand.AddCommand(initDumpCommand())
 	configCommand.AddCommand(initInitCommand())
+	configCommand.AddCommand(initKeysCommand())
 	configCommand.AddCommand(initRemoveCommand())
 	configCommand.AddCommand(initSetCommand())
 
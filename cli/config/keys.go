@@ -0,0 +1,157 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package config
+
+import (
+	"os"
+	"sort"
+
+	"github.com/arduino/arduino-cli/arduino/security"
+	"github.com/arduino/arduino-cli/cli/errorcodes"
+	"github.com/arduino/arduino-cli/cli/feedback"
+	"github.com/arduino/arduino-cli/configuration"
+	"github.com/arduino/arduino-cli/table"
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	"golang.org/x/crypto/openpgp"
+)
+
+func initKeysCommand() *cobra.Command {
+	keysCommand := &cobra.Command{
+		Use:   "keys",
+		Short: tr("Manages the keys trusted to sign package indexes."),
+		Long: tr(`Manages the OpenPGP public keys trusted, in addition to the Arduino one, to sign the
+package indexes of the additional Boards Manager URLs.`),
+		Example: "" +
+			"  " + os.Args[0] + " config keys add company_public.gpg.key\n" +
+			"  " + os.Args[0] + " config keys list\n" +
+			"  " + os.Args[0] + " config keys remove 82F2D7C7C5A22A73",
+	}
+	keysCommand.AddCommand(&cobra.Command{
+		Use:   "add <" + tr("KEY_FILE") + "> ...",
+		Short: tr("Adds one or more keys to the trusted keys."),
+		Long:  tr("Adds the public keys contained in the given files, either ASCII armored or binary, to the trusted keys."),
+		Args:  cobra.MinimumNArgs(1),
+		Run:   runKeysAddCommand,
+	})
+	keysCommand.AddCommand(&cobra.Command{
+		Use:   "list",
+		Short: tr("Lists the trusted keys."),
+		Long:  tr("Lists the keys trusted, in addition to the Arduino one, to sign package indexes."),
+		Args:  cobra.NoArgs,
+		Run:   runKeysListCommand,
+	})
+	keysCommand.AddCommand(&cobra.Command{
+		Use:   "remove <" + tr("KEY_ID") + "> ...",
+		Short: tr("Removes one or more keys from the trusted keys."),
+		Long:  tr("Removes the keys with the given fingerprints or key IDs from the trusted keys."),
+		Args:  cobra.MinimumNArgs(1),
+		Run:   runKeysRemoveCommand,
+	})
+	return keysCommand
+}
+
+func getKeyring() *security.Keyring {
+	return security.NewKeyring(configuration.KeyringDir(configuration.Settings))
+}
+
+func runKeysAddCommand(cmd *cobra.Command, args []string) {
+	logrus.Info("Executing `arduino-cli config keys add`")
+	keyring := getKeyring()
+	added := openpgp.EntityList{}
+	for _, arg := range args {
+		keyFile, err := os.Open(arg)
+		if err != nil {
+			feedback.Errorf(tr("Error opening key file: %v"), err)
+			os.Exit(errorcodes.ErrGeneric)
+		}
+		entities, err := keyring.Add(keyFile)
+		keyFile.Close()
+		if err != nil {
+			feedback.Errorf(tr("Error adding key %[1]s: %[2]v"), arg, err)
+			os.Exit(errorcodes.ErrGeneric)
+		}
+		added = append(added, entities...)
+	}
+	feedback.PrintResult(keysResult{keys: added})
+}
+
+func runKeysListCommand(cmd *cobra.Command, args []string) {
+	logrus.Info("Executing `arduino-cli config keys list`")
+	keys, err := getKeyring().Keys()
+	if err != nil {
+		feedback.Errorf(tr("Error listing keys: %v"), err)
+		os.Exit(errorcodes.ErrGeneric)
+	}
+	feedback.PrintResult(keysResult{keys: keys})
+}
+
+func runKeysRemoveCommand(cmd *cobra.Command, args []string) {
+	logrus.Info("Executing `arduino-cli config keys remove`")
+	keyring := getKeyring()
+	for _, arg := range args {
+		if _, err := keyring.Remove(arg); err != nil {
+			feedback.Errorf(tr("Error removing key: %v"), err)
+			os.Exit(errorcodes.ErrGeneric)
+		}
+	}
+}
+
+// output from this command requires special formatting, let's create a dedicated
+// feedback.Result implementation
+type keysResult struct {
+	keys openpgp.EntityList
+}
+
+type keyInfo struct {
+	Fingerprint string   `json:"fingerprint"`
+	KeyID       string   `json:"key_id"`
+	Identities  []string `json:"identities"`
+}
+
+func (res keysResult) Data() interface{} {
+	keys := []*keyInfo{}
+	for _, entity := range res.keys {
+		identities := []string{}
+		for name := range entity.Identities {
+			identities = append(identities, name)
+		}
+		sort.Strings(identities)
+		keys = append(keys, &keyInfo{
+			Fingerprint: security.Fingerprint(entity),
+			KeyID:       entity.PrimaryKey.KeyIdString(),
+			Identities:  identities,
+		})
+	}
+	return keys
+}
+
+func (res keysResult) String() string {
+	keys := res.Data().([]*keyInfo)
+	if len(keys) == 0 {
+		return tr("No trusted keys.")
+	}
+	t := table.New()
+	t.SetHeader(tr("Key ID"), tr("Fingerprint"), tr("Identity"))
+	for _, key := range keys {
+		identity := ""
+		if len(key.Identities) > 0 {
+			identity = key.Identities[0]
+		}
+		t.AddRow(key.KeyID, key.Fingerprint, identity)
+	}
+	return t.Render()
+}
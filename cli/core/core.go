@@ -38,8 +38,13 @@ func NewCommand() *cobra.Command {
 	coreCommand.AddCommand(initListCommand())
 	coreCommand.AddCommand(initUpdateIndexCommand())
 	coreCommand.AddCommand(initUpgradeCommand())
+	coreCommand.AddCommand(initRollbackCommand())
 	coreCommand.AddCommand(initUninstallCommand())
 	coreCommand.AddCommand(initSearchCommand())
+	coreCommand.AddCommand(initVerifyCommand())
+	coreCommand.AddCommand(initPruneCommand())
+	coreCommand.AddCommand(initIndexCommand())
+	coreCommand.AddCommand(initLintCommand())
 
 	return coreCommand
 }
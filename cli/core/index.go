@@ -0,0 +1,116 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package core
+
+import (
+	"context"
+	"os"
+	"strings"
+
+	"github.com/arduino/arduino-cli/arduino/lint"
+	"github.com/arduino/arduino-cli/cli/errorcodes"
+	"github.com/arduino/arduino-cli/cli/feedback"
+	"github.com/arduino/arduino-cli/cli/globals"
+	"github.com/arduino/arduino-cli/cli/output"
+	"github.com/arduino/arduino-cli/commands/core"
+	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+var (
+	indexLintVerifyArchives bool
+	indexLintSarif          bool
+)
+
+func initIndexCommand() *cobra.Command {
+	indexCommand := &cobra.Command{
+		Use:     "index",
+		Short:   tr("Package index operations."),
+		Long:    tr("Package index operations."),
+		Example: "  " + os.Args[0] + " core index lint package_example_index.json",
+	}
+	indexCommand.AddCommand(initIndexLintCommand())
+	return indexCommand
+}
+
+func initIndexLintCommand() *cobra.Command {
+	lintCommand := &cobra.Command{
+		Use:   "lint <" + tr("indexFile") + ">",
+		Short: tr("Checks a package index against the package index specification."),
+		Long:  tr("Checks the structure of a package index, the references between its platforms and tools and, optionally, the size and checksum of the archives it references."),
+		Example: "" +
+			"  " + os.Args[0] + " core index lint package_example_index.json\n" +
+			"  " + os.Args[0] + " core index lint --verify-archives --sarif package_example_index.json",
+		Args: cobra.ExactArgs(1),
+		Run:  runIndexLintCommand,
+	}
+	lintCommand.Flags().BoolVar(&indexLintVerifyArchives, "verify-archives", false, tr("Download the archives referenced by the index and verify their size and checksum."))
+	lintCommand.Flags().BoolVar(&indexLintSarif, "sarif", false, tr("Print the findings in the SARIF format."))
+	return lintCommand
+}
+
+func runIndexLintCommand(cmd *cobra.Command, args []string) {
+	logrus.Info("Executing `arduino-cli core index lint`")
+
+	resp, err := core.PackageIndexLint(context.Background(), &rpc.PackageIndexLintRequest{
+		IndexPath:      args[0],
+		VerifyArchives: indexLintVerifyArchives,
+	}, output.ProgressBar())
+	if err != nil {
+		feedback.Errorf(tr("Error linting package index: %v"), err)
+		os.Exit(errorcodes.ErrGeneric)
+	}
+
+	findings := lint.Findings{}
+	for _, f := range resp.GetFindings() {
+		findings = append(findings, lint.FromRPC(f))
+	}
+	if indexLintSarif {
+		sarif, err := findings.SARIF("arduino-cli", globals.VersionInfo.VersionString)
+		if err != nil {
+			feedback.Errorf(tr("Error generating SARIF output: %v"), err)
+			os.Exit(errorcodes.ErrGeneric)
+		}
+		feedback.Print(string(sarif))
+	} else {
+		feedback.PrintResult(lintResult{findings: resp.GetFindings()})
+	}
+	if findings.HasErrors() {
+		os.Exit(errorcodes.ErrGeneric)
+	}
+}
+
+// output from this command requires special formatting, let's create a dedicated
+// feedback.Result implementation
+type lintResult struct {
+	findings []*rpc.LintFinding
+}
+
+func (res lintResult) Data() interface{} {
+	return res.findings
+}
+
+func (res lintResult) String() string {
+	if len(res.findings) == 0 {
+		return tr("No problems found.")
+	}
+	lines := []string{}
+	for _, f := range res.findings {
+		lines = append(lines, lint.FromRPC(f).String())
+	}
+	return strings.Join(lines, "\n")
+}
@@ -0,0 +1,80 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package core
+
+import (
+	"context"
+	"os"
+
+	"github.com/arduino/arduino-cli/arduino/lint"
+	"github.com/arduino/arduino-cli/cli/errorcodes"
+	"github.com/arduino/arduino-cli/cli/feedback"
+	"github.com/arduino/arduino-cli/cli/globals"
+	"github.com/arduino/arduino-cli/cli/instance"
+	"github.com/arduino/arduino-cli/commands/core"
+	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+var lintSarif bool
+
+func initLintCommand() *cobra.Command {
+	lintCommand := &cobra.Command{
+		Use:   "lint <" + tr("platformPath") + ">",
+		Short: tr("Checks a platform against the platform specification."),
+		Long:  tr("Checks the boards.txt and platform.txt of a platform: unresolved properties in recipes, missing core and variant folders, menu labels, upload port identification, deprecated keys and pluggable discovery and monitor declarations."),
+		Example: "" +
+			"  " + os.Args[0] + " core lint /home/user/Arduino/hardware/mypackager/avr\n" +
+			"  " + os.Args[0] + " core lint --sarif /home/user/Arduino/hardware/mypackager/avr",
+		Args: cobra.ExactArgs(1),
+		Run:  runLintCommand,
+	}
+	lintCommand.Flags().BoolVar(&lintSarif, "sarif", false, tr("Print the findings in the SARIF format."))
+	return lintCommand
+}
+
+func runLintCommand(cmd *cobra.Command, args []string) {
+	inst := instance.CreateAndInit()
+	logrus.Info("Executing `arduino-cli core lint`")
+
+	resp, err := core.PlatformLint(context.Background(), &rpc.PlatformLintRequest{
+		Instance:     inst,
+		PlatformPath: args[0],
+	})
+	if err != nil {
+		feedback.Errorf(tr("Error linting platform: %v"), err)
+		os.Exit(errorcodes.ErrGeneric)
+	}
+
+	findings := lint.Findings{}
+	for _, f := range resp.GetFindings() {
+		findings = append(findings, lint.FromRPC(f))
+	}
+	if lintSarif {
+		sarif, err := findings.SARIF("arduino-cli", globals.VersionInfo.VersionString)
+		if err != nil {
+			feedback.Errorf(tr("Error generating SARIF output: %v"), err)
+			os.Exit(errorcodes.ErrGeneric)
+		}
+		feedback.Print(string(sarif))
+	} else {
+		feedback.PrintResult(lintResult{findings: resp.GetFindings()})
+	}
+	if findings.HasErrors() {
+		os.Exit(errorcodes.ErrGeneric)
+	}
+}
@@ -0,0 +1,89 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package core
+
+import (
+	"context"
+	"os"
+
+	"github.com/arduino/arduino-cli/cli/errorcodes"
+	"github.com/arduino/arduino-cli/cli/feedback"
+	"github.com/arduino/arduino-cli/cli/instance"
+	"github.com/arduino/arduino-cli/cli/output"
+	"github.com/arduino/arduino-cli/commands/core"
+	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
+	"github.com/arduino/arduino-cli/table"
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+var pruneDryRun bool
+
+func initPruneCommand() *cobra.Command {
+	pruneCommand := &cobra.Command{
+		Use:   "prune",
+		Short: tr("Removes the tools not required by any installed platform."),
+		Long:  tr("Removes the installed tool releases that are not required by any of the installed platforms, like the toolchains left behind by platform upgrades."),
+		Example: "" +
+			"  " + os.Args[0] + " core prune --dry-run\n" +
+			"  " + os.Args[0] + " core prune",
+		Args: cobra.NoArgs,
+		Run:  runPruneCommand,
+	}
+	pruneCommand.Flags().BoolVar(&pruneDryRun, "dry-run", false, tr("List the unused tools without removing them."))
+	return pruneCommand
+}
+
+func runPruneCommand(cmd *cobra.Command, args []string) {
+	inst := instance.CreateAndInit()
+	logrus.Info("Executing `arduino-cli core prune`")
+
+	taskCB := output.TaskProgress()
+	if pruneDryRun {
+		taskCB = output.NewNullTaskProgressCB()
+	}
+	res, err := core.ToolPrune(context.Background(), &rpc.ToolPruneRequest{Instance: inst, DryRun: pruneDryRun}, taskCB)
+	if err != nil {
+		feedback.Errorf(tr("Error removing unused tools: %v"), err)
+		os.Exit(errorcodes.ErrGeneric)
+	}
+	feedback.PrintResult(pruneResult{res: res, dryRun: pruneDryRun})
+}
+
+type pruneResult struct {
+	res    *rpc.ToolPruneResponse
+	dryRun bool
+}
+
+func (r pruneResult) Data() interface{} {
+	return r.res
+}
+
+func (r pruneResult) String() string {
+	if len(r.res.GetTools()) == 0 {
+		return tr("No unused tools found.")
+	}
+	t := table.New()
+	t.SetHeader(tr("Tool"), tr("Size"), tr("Path"))
+	for _, tool := range r.res.GetTools() {
+		t.AddRow(tool.GetId(), output.FormatSize(tool.GetSize()), tool.GetInstallDir())
+	}
+	total := output.FormatSize(r.res.GetTotalSize())
+	if r.dryRun {
+		return t.Render() + "\n" + tr("%[1]d unused tools, %[2]s would be freed.", len(r.res.GetTools()), total)
+	}
+	return t.Render() + "\n" + tr("%[1]d unused tools removed, %[2]s freed.", len(r.res.GetTools()), total)
+}
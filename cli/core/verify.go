@@ -0,0 +1,100 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package core
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/arduino/arduino-cli/cli/arguments"
+	"github.com/arduino/arduino-cli/cli/errorcodes"
+	"github.com/arduino/arduino-cli/cli/feedback"
+	"github.com/arduino/arduino-cli/cli/instance"
+	"github.com/arduino/arduino-cli/cli/output"
+	"github.com/arduino/arduino-cli/commands/core"
+	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+var verifyRepair bool
+
+func initVerifyCommand() *cobra.Command {
+	verifyCommand := &cobra.Command{
+		Use:   fmt.Sprintf("verify [%s:%s] ...", tr("PACKAGER"), tr("ARCH")),
+		Short: tr("Verifies the integrity of the files of the installed cores."),
+		Long: tr(`Verifies the files of the installed cores against the ones recorded at install time,
+reporting modified, missing or extra files. If no core is specified all the installed cores are verified.`),
+		Example: "" +
+			"  " + os.Args[0] + " core verify\n" +
+			"  " + os.Args[0] + " core verify arduino:samd --repair",
+		Args: cobra.ArbitraryArgs,
+		Run:  runVerifyCommand,
+		ValidArgsFunction: func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+			return arguments.GetUninstallableCores(), cobra.ShellCompDirectiveDefault
+		},
+	}
+	verifyCommand.Flags().BoolVar(&verifyRepair, "repair", false, tr("Reinstall the damaged cores from the archives in the downloads directory."))
+	postInstallFlags.AddToCommand(verifyCommand)
+	return verifyCommand
+}
+
+func runVerifyCommand(cmd *cobra.Command, args []string) {
+	inst := instance.CreateAndInit()
+	logrus.Info("Executing `arduino-cli core verify`")
+
+	platformsRefs, err := arguments.ParseReferences(args)
+	if err != nil {
+		feedback.Errorf(tr("Invalid argument passed: %v"), err)
+		os.Exit(errorcodes.ErrBadArgument)
+	}
+	requests := []*rpc.PlatformVerifyRequest{}
+	for _, platformRef := range platformsRefs {
+		if platformRef.Version != "" {
+			feedback.Errorf(tr("Invalid parameter %s: version not allowed"), platformRef)
+			os.Exit(errorcodes.ErrBadArgument)
+		}
+		requests = append(requests, &rpc.PlatformVerifyRequest{
+			Instance:        inst,
+			PlatformPackage: platformRef.PackageName,
+			Architecture:    platformRef.Architecture,
+			Repair:          verifyRepair,
+			SkipPostInstall: postInstallFlags.DetectSkipPostInstallValue(),
+		})
+	}
+	if len(requests) == 0 {
+		requests = append(requests, &rpc.PlatformVerifyRequest{
+			Instance:        inst,
+			Repair:          verifyRepair,
+			SkipPostInstall: postInstallFlags.DetectSkipPostInstallValue(),
+		})
+	}
+
+	res := output.InstalledFilesVerificationResult{}
+	for _, req := range requests {
+		resp, err := core.PlatformVerify(context.Background(), req, output.ProgressBar(), output.TaskProgress())
+		if err != nil {
+			feedback.Errorf(tr("Error verifying platform: %v"), err)
+			os.Exit(errorcodes.ErrGeneric)
+		}
+		res.Results = append(res.Results, resp.GetResults()...)
+	}
+	feedback.PrintResult(res)
+	if !res.IsIntact() {
+		os.Exit(errorcodes.ErrGeneric)
+	}
+}
@@ -17,9 +17,12 @@ package debug
 
 import (
 	"context"
+	"io/ioutil"
+	"net"
 	"os"
 	"os/signal"
 	"sort"
+	"strings"
 
 	"github.com/arduino/arduino-cli/cli/arguments"
 	"github.com/arduino/arduino-cli/cli/errorcodes"
@@ -31,6 +34,7 @@ import (
 	"github.com/arduino/arduino-cli/table"
 	"github.com/arduino/go-properties-orderedmap"
 	"github.com/fatih/color"
+	"github.com/mattn/go-colorable"
 	"github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
 )
@@ -41,6 +45,8 @@ var (
 	interpreter string
 	importDir   string
 	printInfo   bool
+	dapMode     bool
+	dapListen   string
 	programmer  arguments.Programmer
 	tr          = i18n.Tr
 )
@@ -62,6 +68,10 @@ func NewCommand() *cobra.Command {
 	debugCommand.Flags().StringVar(&interpreter, "interpreter", "console", tr("Debug interpreter e.g.: %s", "console, mi, mi1, mi2, mi3"))
 	debugCommand.Flags().StringVarP(&importDir, "input-dir", "", "", tr("Directory containing binaries for debug."))
 	debugCommand.Flags().BoolVarP(&printInfo, "info", "I", false, tr("Show metadata about the debug session instead of starting the debugger."))
+	debugCommand.Flags().BoolVar(&dapMode, "dap", false, tr("Run a Debug Adapter Protocol server on stdin/stdout instead of an interactive gdb session."))
+	debugCommand.Flags().StringVar(&dapListen, "dap-listen", "", tr("Run a Debug Adapter Protocol server listening for TCP connections on the given address (e.g.: %s).", "localhost:4711"))
+
+	debugCommand.AddCommand(initDecodeCommand())
 
 	return debugCommand
 }
@@ -89,7 +99,9 @@ func runDebugCommand(command *cobra.Command, args []string) {
 		Programmer:  programmer.String(),
 	}
 
-	if printInfo {
+	if dapMode || dapListen != "" {
+		runDAPServer(debugConfigRequested)
+	} else if printInfo {
 
 		if res, err := debug.GetDebugConfig(context.Background(), debugConfigRequested); err != nil {
 			feedback.Errorf(tr("Error getting Debug info: %v"), err)
@@ -112,6 +124,43 @@ func runDebugCommand(command *cobra.Command, args []string) {
 	}
 }
 
+func runDAPServer(req *dbg.DebugConfigRequest) {
+	if dapListen == "" {
+		// stdout is used by the protocol: the logs printed with --verbose are
+		// moved to stderr to not corrupt the DAP stream
+		if logrus.StandardLogger().Out != ioutil.Discard {
+			logrus.SetOutput(colorable.NewColorableStderr())
+		}
+		if err := debug.DebugDAP(context.Background(), req, os.Stdin, os.Stdout); err != nil {
+			feedback.Errorf(tr("Error during Debug: %v"), err)
+			os.Exit(errorcodes.ErrGeneric)
+		}
+		return
+	}
+
+	listener, err := net.Listen("tcp", dapListen)
+	if err != nil {
+		feedback.Errorf(tr("Failed to listen on TCP port: %[1]s. Unexpected error: %[2]v"), dapListen, err)
+		os.Exit(errorcodes.ErrNetwork)
+	}
+	defer listener.Close()
+	feedback.Printf(tr("Debug Adapter Protocol server listening on %s"), listener.Addr())
+	for {
+		conn, err := listener.Accept()
+		if err != nil {
+			feedback.Errorf(tr("Error during Debug: %v"), err)
+			os.Exit(errorcodes.ErrNetwork)
+		}
+		logrus.Infof("DAP client connected from %s", conn.RemoteAddr())
+		// Serve one session at a time: a board can be debugged by a single client
+		if err := debug.DebugDAP(context.Background(), req, conn, conn); err != nil {
+			feedback.Errorf(tr("Error during Debug: %v"), err)
+		}
+		conn.Close()
+		logrus.Infof("DAP client %s disconnected", conn.RemoteAddr())
+	}
+}
+
 type debugInfoResult struct {
 	info *dbg.GetDebugConfigResponse
 }
@@ -148,5 +197,12 @@ func (r *debugInfoResult) String() string {
 			t.AddRow(table.NewCell(" - "+k, dimGreen), table.NewCell(conf.Get(k), dimGreen))
 		}
 	}
+	if len(r.info.GetServerCommandLine()) > 0 {
+		t.AddRow(tr("GDB Server command line"), table.NewCell(strings.Join(r.info.GetServerCommandLine(), " "), dimGreen))
+		t.AddRow(tr("GDB Server address"), table.NewCell(r.info.GetServerAddress(), dimGreen))
+	}
+	if len(r.info.GetClientCommandLine()) > 0 {
+		t.AddRow(tr("Debugger command line"), table.NewCell(strings.Join(r.info.GetClientCommandLine(), " "), dimGreen))
+	}
 	return t.Render()
 }
@@ -0,0 +1,76 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package debug
+
+import (
+	"os"
+
+	"github.com/arduino/arduino-cli/arduino/crashdump"
+	"github.com/arduino/arduino-cli/cli/arguments"
+	"github.com/arduino/arduino-cli/cli/errorcodes"
+	"github.com/arduino/arduino-cli/cli/feedback"
+	"github.com/arduino/go-paths-helper"
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+var elfFile string
+
+func initDecodeCommand() *cobra.Command {
+	decodeCommand := &cobra.Command{
+		Use:   "decode [" + tr("sketchPath") + "]",
+		Short: tr("Decode crash dumps."),
+		Long:  tr("Reads a serial log from stdin and prints the source location of the addresses found in backtraces and register dumps."),
+		Example: "" +
+			"  " + os.Args[0] + " debug decode --elf /tmp/build/Blink.ino.elf < log.txt\n" +
+			"  " + os.Args[0] + " debug decode /home/user/Arduino/MySketch < log.txt",
+		Args: cobra.MaximumNArgs(1),
+		Run:  runDecodeCommand,
+	}
+	decodeCommand.Flags().StringVar(&elfFile, "elf", "", tr("ELF file of the program that crashed, defaults to the one in the sketch build path."))
+	return decodeCommand
+}
+
+func runDecodeCommand(cmd *cobra.Command, args []string) {
+	logrus.Info("Executing `arduino-cli debug decode`")
+
+	var elfPath *paths.Path
+	if elfFile != "" {
+		elfPath = paths.New(elfFile)
+	} else {
+		path := ""
+		if len(args) > 0 {
+			path = args[0]
+		}
+		sk := arguments.NewSketch(arguments.InitSketchPath(path))
+		if p, err := crashdump.SketchELF(sk); err != nil {
+			feedback.Error(err)
+			os.Exit(errorcodes.ErrBadArgument)
+		} else {
+			elfPath = p
+		}
+	}
+
+	symbolizer, err := crashdump.NewSymbolizer(elfPath)
+	if err != nil {
+		feedback.Errorf(tr("Error loading symbols: %v"), err)
+		os.Exit(errorcodes.ErrBadArgument)
+	}
+	if err := crashdump.Decode(os.Stdin, os.Stdout, symbolizer); err != nil {
+		feedback.Errorf(tr("Error decoding crash dump: %v"), err)
+		os.Exit(errorcodes.ErrGeneric)
+	}
+}
@@ -0,0 +1,168 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package env
+
+import (
+	"context"
+	"os"
+
+	"github.com/arduino/arduino-cli/cli/errorcodes"
+	"github.com/arduino/arduino-cli/cli/feedback"
+	"github.com/arduino/arduino-cli/cli/instance"
+	"github.com/arduino/arduino-cli/cli/output"
+	"github.com/arduino/arduino-cli/commands"
+	"github.com/arduino/arduino-cli/commands/env"
+	"github.com/arduino/arduino-cli/configuration"
+	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
+	"github.com/arduino/arduino-cli/table"
+	"github.com/arduino/go-paths-helper"
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+var (
+	applyDryRun       bool
+	applyRemoveExtras bool
+)
+
+func initApplyCommand() *cobra.Command {
+	applyCommand := &cobra.Command{
+		Use:   "apply <environment.yaml>",
+		Short: tr("Converges the current environment to the one described in a file."),
+		Long: tr(`Updates the configuration and installs, upgrades or downgrades the platforms, tools and libraries
+to match the environment described in a file created with "env export". The changes are printed before
+being applied. With --remove-extras the platforms, tools and libraries not in the file are removed too.
+Libraries installed from a zip file or copied manually can't be installed and are only reported.`),
+		Example: "" +
+			"  " + os.Args[0] + " env apply env.yaml\n" +
+			"  " + os.Args[0] + " env apply env.yaml --remove-extras --dry-run",
+		Args: cobra.ExactArgs(1),
+		Run:  runApplyCommand,
+	}
+	applyCommand.Flags().BoolVar(&applyDryRun, "dry-run", false, tr("Show the changes that would be applied without applying them."))
+	applyCommand.Flags().BoolVar(&applyRemoveExtras, "remove-extras", false, tr("Remove the platforms, tools and libraries not in the environment."))
+	return applyCommand
+}
+
+func runApplyCommand(cmd *cobra.Command, args []string) {
+	logrus.Info("Executing `arduino-cli env apply`")
+
+	environment, err := env.Load(paths.New(args[0]))
+	if err != nil {
+		feedback.Errorf(tr("Error loading environment: %v"), err)
+		os.Exit(errorcodes.ErrBadArgument)
+	}
+
+	configChanges := env.ConfigChanges(environment, configuration.Settings)
+	if applyDryRun {
+		// The plan is computed on the target configuration without changing
+		// the settings or the directories
+		plan, err := env.ComputeTargetPlan(context.Background(), environment, configuration.Settings, configChanges, applyRemoveExtras, output.ProgressBar())
+		if err != nil {
+			feedback.Errorf(tr("Error computing changes: %v"), err)
+			os.Exit(errorcodes.ErrGeneric)
+		}
+		feedback.PrintResult(planResult{Config: configChanges, Plan: plan, dryRun: true})
+		return
+	}
+
+	// The configuration is changed before creating the instance so that the
+	// platforms, tools and libraries are searched in the new directories
+	configFile := configuration.Settings.ConfigFileUsed()
+	if configFile == "" {
+		configFile = paths.New(configuration.Settings.GetString("directories.Data"), "arduino-cli.yaml").String()
+	}
+	env.ApplyConfigChanges(configChanges, configuration.Settings)
+	if len(configChanges) > 0 {
+		if err := paths.New(configFile).Parent().MkdirAll(); err != nil {
+			feedback.Errorf(tr("Cannot create config file directory: %v"), err)
+			os.Exit(errorcodes.ErrGeneric)
+		}
+		if err := configuration.Settings.WriteConfigAs(configFile); err != nil {
+			feedback.Errorf(tr("Writing config file: %v"), err)
+			os.Exit(errorcodes.ErrGeneric)
+		}
+	}
+
+	inst := instance.CreateAndInit()
+	if urlsChanged(configChanges) {
+		_, err := commands.UpdateIndex(context.Background(), &rpc.UpdateIndexRequest{Instance: inst}, output.ProgressBar())
+		if err != nil {
+			feedback.Errorf(tr("Error updating index: %v"), err)
+		}
+		for _, err := range instance.Init(inst) {
+			feedback.Errorf(tr("Error initializing instance: %v"), err)
+		}
+	}
+
+	plan, err := env.ComputePlan(context.Background(), inst, environment, applyRemoveExtras)
+	if err != nil {
+		feedback.Errorf(tr("Error computing changes: %v"), err)
+		os.Exit(errorcodes.ErrGeneric)
+	}
+	feedback.PrintResult(planResult{Config: configChanges, Plan: plan})
+	if plan.IsEmpty() {
+		return
+	}
+
+	if err := env.Apply(context.Background(), inst, plan, output.ProgressBar(), output.TaskProgress()); err != nil {
+		feedback.Errorf(tr("Error applying environment: %v"), err)
+		os.Exit(errorcodes.ErrGeneric)
+	}
+}
+
+// urlsChanged returns true if the board manager URLs have been changed
+func urlsChanged(changes []*env.ConfigChange) bool {
+	for _, change := range changes {
+		if change.Key == "board_manager.additional_urls" {
+			return true
+		}
+	}
+	return false
+}
+
+type planResult struct {
+	Config []*env.ConfigChange `json:"config"`
+	*env.Plan
+	dryRun bool
+}
+
+func (r planResult) Data() interface{} {
+	return r
+}
+
+func (r planResult) String() string {
+	if len(r.Config) == 0 && r.Plan.IsEmpty() {
+		return tr("The environment is already up to date.")
+	}
+	t := table.New()
+	t.SetHeader(tr("Action"), tr("Type"), tr("ID"), tr("Installed"), tr("Requested"), tr("Notes"))
+	for _, change := range r.Config {
+		t.AddRow(tr("set"), tr("config"), change.Key, change.From, change.To, "")
+	}
+	addChanges := func(kind string, changes []*env.Change) {
+		for _, change := range changes {
+			t.AddRow(string(change.Kind), kind, change.ID, change.From, change.To, change.Reason)
+		}
+	}
+	addChanges(tr("platform"), r.Plan.Platforms)
+	addChanges(tr("tool"), r.Plan.Tools)
+	addChanges(tr("library"), r.Plan.Libraries)
+	if r.dryRun {
+		return t.Render() + "\n" + tr("Dry run: no changes have been applied.")
+	}
+	return t.Render()
+}
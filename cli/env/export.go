@@ -0,0 +1,72 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package env
+
+import (
+	"context"
+	"os"
+
+	"github.com/arduino/arduino-cli/cli/errorcodes"
+	"github.com/arduino/arduino-cli/cli/feedback"
+	"github.com/arduino/arduino-cli/cli/instance"
+	"github.com/arduino/arduino-cli/commands/env"
+	"github.com/arduino/arduino-cli/configuration"
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+func initExportCommand() *cobra.Command {
+	exportCommand := &cobra.Command{
+		Use:   "export",
+		Short: tr("Prints the current environment."),
+		Long: tr(`Prints as YAML the board manager URLs and the directories of the configuration, the platforms and
+tools installed through the Boards Manager and the libraries installed in the sketchbook, with their
+versions and origins. The output can be used with "env apply" to reproduce the environment.`),
+		Example: "  " + os.Args[0] + " env export > env.yaml",
+		Args:    cobra.NoArgs,
+		Run:     runExportCommand,
+	}
+	return exportCommand
+}
+
+func runExportCommand(cmd *cobra.Command, args []string) {
+	inst := instance.CreateAndInit()
+	logrus.Info("Executing `arduino-cli env export`")
+
+	environment, err := env.Export(context.Background(), inst, configuration.Settings)
+	if err != nil {
+		feedback.Errorf(tr("Error exporting environment: %v"), err)
+		os.Exit(errorcodes.ErrGeneric)
+	}
+	feedback.PrintResult(exportResult{environment})
+}
+
+type exportResult struct {
+	env *env.Environment
+}
+
+func (r exportResult) Data() interface{} {
+	return r.env
+}
+
+func (r exportResult) String() string {
+	data, err := r.env.Marshal()
+	if err != nil {
+		feedback.Errorf(tr("unable to marshal environment to YAML: %v"), err)
+		return ""
+	}
+	return string(data)
+}
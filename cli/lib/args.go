@@ -37,6 +37,11 @@ func (r *LibraryReferenceArg) String() string {
 	return r.Name
 }
 
+// ToRPCLibraryReference converts the reference into a rpc.LibraryReference
+func (r *LibraryReferenceArg) ToRPCLibraryReference() *rpc.LibraryReference {
+	return &rpc.LibraryReference{Name: r.Name, Version: r.Version}
+}
+
 // ParseLibraryReferenceArg parse a command line argument that reference a
 // library in the form "LibName@Version" or just "LibName".
 func ParseLibraryReferenceArg(arg string) (*LibraryReferenceArg, error) {
@@ -19,6 +19,7 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"strings"
 
 	"github.com/arduino/arduino-cli/cli/arguments"
 	"github.com/arduino/arduino-cli/cli/errorcodes"
@@ -31,6 +32,8 @@ import (
 	"github.com/spf13/cobra"
 )
 
+var depsLockfile string
+
 func initDepsCommand() *cobra.Command {
 	depsCommand := &cobra.Command{
 		Use:   fmt.Sprintf("deps %s[@%s]...", tr("LIBRARY"), tr("VERSION_NUMBER")),
@@ -38,32 +41,39 @@ func initDepsCommand() *cobra.Command {
 		Long:  tr("Check dependencies status for the specified library."),
 		Example: "" +
 			"  " + os.Args[0] + " lib deps AudioZero       # " + tr("for the latest version.") + "\n" +
-			"  " + os.Args[0] + " lib deps AudioZero@1.0.0 # " + tr("for the specific version."),
-		Args: cobra.ExactArgs(1),
+			"  " + os.Args[0] + " lib deps AudioZero@1.0.0 # " + tr("for the specific version.") + "\n" +
+			"  " + os.Args[0] + " lib deps AudioZero ArduinoBLE # " + tr("for the dependencies of all the libraries together."),
+		Args: cobra.MinimumNArgs(1),
 		Run:  runDepsCommand,
 		ValidArgsFunction: func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
 			return arguments.GetInstalledLibraries(), cobra.ShellCompDirectiveDefault
 		},
 	}
+	depsCommand.Flags().StringVar(&depsLockfile, "lockfile", "", tr("Path to a lockfile pinning the versions of the libraries."))
 	return depsCommand
 }
 
 func runDepsCommand(cmd *cobra.Command, args []string) {
 	instance := instance.CreateAndInit()
 	logrus.Info("Executing `arduino-cli lib deps`")
-	libRef, err := ParseLibraryReferenceArgAndAdjustCase(instance, args[0])
+	libRefs, err := ParseLibraryReferenceArgsAndAdjustCase(instance, args)
 	if err != nil {
 		feedback.Errorf(tr("Arguments error: %v"), err)
 		os.Exit(errorcodes.ErrBadArgument)
 	}
 
-	deps, err := lib.LibraryResolveDependencies(context.Background(), &rpc.LibraryResolveDependenciesRequest{
+	req := &rpc.LibraryResolveDependenciesRequest{
 		Instance: instance,
-		Name:     libRef.Name,
-		Version:  libRef.Version,
-	})
+		Name:     libRefs[0].Name,
+		Version:  libRefs[0].Version,
+		Lockfile: depsLockfile,
+	}
+	for _, libRef := range libRefs[1:] {
+		req.AdditionalLibraries = append(req.AdditionalLibraries, libRef.ToRPCLibraryReference())
+	}
+	deps, err := lib.LibraryResolveDependencies(context.Background(), req)
 	if err != nil {
-		feedback.Errorf(tr("Error resolving dependencies for %[1]s: %[2]s", libRef, err))
+		feedback.Errorf(tr("Error resolving dependencies for %[1]s: %[2]s", strings.Join(args, ", "), err))
 	}
 
 	feedback.PrintResult(&checkDepResult{deps: deps})
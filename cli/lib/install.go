@@ -37,9 +37,10 @@ import (
 )
 
 var (
-	noDeps  bool
-	gitURL  bool
-	zipPath bool
+	noDeps   bool
+	gitURL   bool
+	zipPath  bool
+	lockfile string
 )
 
 func initInstallCommand() *cobra.Command {
@@ -51,6 +52,7 @@ func initInstallCommand() *cobra.Command {
 			"  " + os.Args[0] + " lib install AudioZero       # " + tr("for the latest version.") + "\n" +
 			"  " + os.Args[0] + " lib install AudioZero@1.0.0 # " + tr("for the specific version.") + "\n" +
 			"  " + os.Args[0] + " lib install --git-url https://github.com/arduino-libraries/WiFi101.git https://github.com/arduino-libraries/ArduinoBLE.git\n" +
+			"  " + os.Args[0] + " lib install --git-url https://github.com/arduino-libraries/WiFi101.git#0.16.1 # " + tr("for the specific tag, branch or commit.") + "\n" +
 			"  " + os.Args[0] + " lib install --zip-path /path/to/WiFi101.zip /path/to/ArduinoBLE.zip\n",
 		Args: cobra.MinimumNArgs(1),
 		Run:  runInstallCommand,
@@ -61,6 +63,7 @@ func initInstallCommand() *cobra.Command {
 	installCommand.Flags().BoolVar(&noDeps, "no-deps", false, tr("Do not install dependencies."))
 	installCommand.Flags().BoolVar(&gitURL, "git-url", false, tr("Enter git url for libraries hosted on repositories"))
 	installCommand.Flags().BoolVar(&zipPath, "zip-path", false, tr("Enter a path to zip file"))
+	installCommand.Flags().StringVar(&lockfile, "lockfile", "", tr("Path to a lockfile pinning the versions of the libraries to install."))
 	return installCommand
 }
 
@@ -126,17 +129,20 @@ func runInstallCommand(cmd *cobra.Command, args []string) {
 		os.Exit(errorcodes.ErrBadArgument)
 	}
 
-	for _, libRef := range libRefs {
-		libraryInstallRequest := &rpc.LibraryInstallRequest{
-			Instance: instance,
-			Name:     libRef.Name,
-			Version:  libRef.Version,
-			NoDeps:   noDeps,
-		}
-		err := lib.LibraryInstall(context.Background(), libraryInstallRequest, output.ProgressBar(), output.TaskProgress())
-		if err != nil {
-			feedback.Errorf(tr("Error installing %s: %v"), libRef.Name, err)
-			os.Exit(errorcodes.ErrGeneric)
-		}
+	// All the libraries are installed at once to resolve their dependencies together
+	libraryInstallRequest := &rpc.LibraryInstallRequest{
+		Instance: instance,
+		Name:     libRefs[0].Name,
+		Version:  libRefs[0].Version,
+		NoDeps:   noDeps,
+		Lockfile: lockfile,
+	}
+	for _, libRef := range libRefs[1:] {
+		libraryInstallRequest.AdditionalLibraries = append(libraryInstallRequest.AdditionalLibraries, libRef.ToRPCLibraryReference())
+	}
+	err = lib.LibraryInstall(context.Background(), libraryInstallRequest, output.ProgressBar(), output.TaskProgress())
+	if err != nil {
+		feedback.Errorf(tr("Error installing %s: %v"), strings.Join(args, ", "), err)
+		os.Exit(errorcodes.ErrGeneric)
 	}
 }
@@ -44,5 +44,9 @@ func NewCommand() *cobra.Command {
 	libCommand.AddCommand(initUpgradeCommand())
 	libCommand.AddCommand(initUpdateIndexCommand())
 	libCommand.AddCommand(initDepsCommand())
+	libCommand.AddCommand(initVendorCommand())
+	libCommand.AddCommand(initLintCommand())
+	libCommand.AddCommand(initPackCommand())
+	libCommand.AddCommand(initPrecompileCommand())
 	return libCommand
 }
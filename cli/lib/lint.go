@@ -0,0 +1,109 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package lib
+
+import (
+	"context"
+	"os"
+	"strings"
+
+	"github.com/arduino/arduino-cli/arduino/lint"
+	"github.com/arduino/arduino-cli/cli/errorcodes"
+	"github.com/arduino/arduino-cli/cli/feedback"
+	"github.com/arduino/arduino-cli/cli/globals"
+	"github.com/arduino/arduino-cli/cli/instance"
+	"github.com/arduino/arduino-cli/commands/lib"
+	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+var (
+	lintCompileExamples string
+	lintSarif           bool
+)
+
+func initLintCommand() *cobra.Command {
+	lintCommand := &cobra.Command{
+		Use:   "lint <" + tr("libraryPath") + ">",
+		Short: tr("Checks a library against the library specification."),
+		Long:  tr("Checks the metadata and the layout of a library against the library specification, optionally compiling its examples."),
+		Example: "" +
+			"  " + os.Args[0] + " lib lint /home/user/Arduino/libraries/MyLib\n" +
+			"  " + os.Args[0] + " lib lint --compile-examples arduino:avr:uno --sarif /home/user/Arduino/libraries/MyLib",
+		Args: cobra.ExactArgs(1),
+		Run:  runLintCommand,
+	}
+	lintCommand.Flags().StringVar(&lintCompileExamples, "compile-examples", "", tr("Compile the examples of the library for the given FQBN."))
+	lintCommand.Flags().BoolVar(&lintSarif, "sarif", false, tr("Print the findings in the SARIF format."))
+	return lintCommand
+}
+
+func runLintCommand(cmd *cobra.Command, args []string) {
+	logrus.Info("Executing `arduino-cli lib lint`")
+
+	req := &rpc.LibraryLintRequest{
+		LibraryPath:         args[0],
+		CompileExamplesFqbn: lintCompileExamples,
+	}
+	if lintCompileExamples != "" {
+		req.Instance = instance.CreateAndInit()
+	}
+	resp, err := lib.LibraryLint(context.Background(), req)
+	if err != nil {
+		feedback.Errorf(tr("Error linting library: %v"), err)
+		os.Exit(errorcodes.ErrGeneric)
+	}
+
+	findings := lint.Findings{}
+	for _, f := range resp.GetFindings() {
+		findings = append(findings, lint.FromRPC(f))
+	}
+	if lintSarif {
+		sarif, err := findings.SARIF("arduino-cli", globals.VersionInfo.VersionString)
+		if err != nil {
+			feedback.Errorf(tr("Error generating SARIF output: %v"), err)
+			os.Exit(errorcodes.ErrGeneric)
+		}
+		feedback.Print(string(sarif))
+	} else {
+		feedback.PrintResult(lintResult{findings: resp.GetFindings()})
+	}
+	if findings.HasErrors() {
+		os.Exit(errorcodes.ErrGeneric)
+	}
+}
+
+// output from this command requires special formatting, let's create a dedicated
+// feedback.Result implementation
+type lintResult struct {
+	findings []*rpc.LintFinding
+}
+
+func (res lintResult) Data() interface{} {
+	return res.findings
+}
+
+func (res lintResult) String() string {
+	if len(res.findings) == 0 {
+		return tr("No problems found.")
+	}
+	lines := []string{}
+	for _, f := range res.findings {
+		lines = append(lines, lint.FromRPC(f).String())
+	}
+	return strings.Join(lines, "\n")
+}
@@ -140,6 +140,13 @@ func (ir installedResult) String() string {
 		if lib.ContainerPlatform != "" {
 			location = lib.GetContainerPlatform()
 		}
+		if origin := lib.GetGitOrigin(); origin != nil {
+			ref := origin.GetRef()
+			if ref == "" && len(origin.GetCommit()) > 7 {
+				ref = origin.GetCommit()[:7]
+			}
+			location = origin.GetUrl() + "#" + ref
+		}
 
 		available := ""
 		sentence := ""
@@ -0,0 +1,93 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package lib
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/arduino/arduino-cli/cli/errorcodes"
+	"github.com/arduino/arduino-cli/cli/feedback"
+	"github.com/arduino/arduino-cli/commands/lib"
+	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+var (
+	packOutputDir string
+	packBaseURL   string
+)
+
+func initPackCommand() *cobra.Command {
+	packCommand := &cobra.Command{
+		Use:   "pack <" + tr("libraryPath") + ">",
+		Short: tr("Creates a release archive of a library."),
+		Long:  tr("Validates a library and creates a reproducible zip archive of it, printing the release entry to add to a library_index.json."),
+		Example: "" +
+			"  " + os.Args[0] + " lib pack /home/user/Arduino/libraries/MyLib\n" +
+			"  " + os.Args[0] + " lib pack --output-dir dist --base-url https://example.com/libraries /home/user/Arduino/libraries/MyLib",
+		Args: cobra.ExactArgs(1),
+		Run:  runPackCommand,
+	}
+	packCommand.Flags().StringVar(&packOutputDir, "output-dir", "", tr("The folder where the archive is created, defaults to the current folder or, if inside the library, to the folder containing the library."))
+	packCommand.Flags().StringVar(&packBaseURL, "base-url", "", tr("The URL of the folder where the archive will be published."))
+	return packCommand
+}
+
+func runPackCommand(cmd *cobra.Command, args []string) {
+	logrus.Info("Executing `arduino-cli lib pack`")
+
+	resp, err := lib.LibraryPack(context.Background(), &rpc.LibraryPackRequest{
+		LibraryPath: args[0],
+		OutputDir:   packOutputDir,
+		BaseUrl:     packBaseURL,
+	})
+	if err != nil {
+		feedback.Errorf(tr("Error packing library: %v"), err)
+		os.Exit(errorcodes.ErrGeneric)
+	}
+	feedback.PrintResult(packResult{resp: resp})
+}
+
+// output from this command requires special formatting, let's create a dedicated
+// feedback.Result implementation
+type packResult struct {
+	resp *rpc.LibraryPackResponse
+}
+
+func (res packResult) Data() interface{} {
+	return struct {
+		ArchivePath string          `json:"archive_path"`
+		Checksum    string          `json:"checksum"`
+		Size        int64           `json:"size"`
+		IndexEntry  json.RawMessage `json:"index_entry"`
+	}{
+		ArchivePath: res.resp.GetArchivePath(),
+		Checksum:    res.resp.GetChecksum(),
+		Size:        res.resp.GetSize(),
+		IndexEntry:  json.RawMessage(res.resp.GetIndexEntry()),
+	}
+}
+
+func (res packResult) String() string {
+	return tr("Archive: %s", res.resp.GetArchivePath()) + "\n" +
+		tr("Checksum: %s", res.resp.GetChecksum()) + "\n" +
+		tr("Size: %s", fmt.Sprint(res.resp.GetSize())) + "\n\n" +
+		res.resp.GetIndexEntry()
+}
@@ -0,0 +1,93 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package lib
+
+import (
+	"context"
+	"io"
+	"io/ioutil"
+	"os"
+
+	"github.com/arduino/arduino-cli/cli/errorcodes"
+	"github.com/arduino/arduino-cli/cli/feedback"
+	"github.com/arduino/arduino-cli/cli/instance"
+	"github.com/arduino/arduino-cli/cli/output"
+	"github.com/arduino/arduino-cli/commands/lib"
+	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
+	"github.com/arduino/arduino-cli/table"
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+var (
+	precompileFqbns   []string
+	precompileVerbose bool
+)
+
+func initPrecompileCommand() *cobra.Command {
+	precompileCommand := &cobra.Command{
+		Use:   "precompile <" + tr("libraryPath") + ">",
+		Short: tr("Precompiles a library for one or more boards."),
+		Long:  tr("Compiles the sources of a library for each board and stores the archives in the folders used by precompiled libraries, declaring the library as precompiled."),
+		Example: "" +
+			"  " + os.Args[0] + " lib precompile --fqbn arduino:samd:mkr1000 --fqbn arduino:mbed_nano:nano33ble /home/user/Arduino/libraries/MyLib",
+		Args: cobra.ExactArgs(1),
+		Run:  runPrecompileCommand,
+	}
+	precompileCommand.Flags().StringArrayVarP(&precompileFqbns, "fqbn", "b", []string{}, tr("Fully Qualified Board Name of a board to precompile the library for, can be used multiple times."))
+	precompileCommand.Flags().BoolVarP(&precompileVerbose, "verbose", "v", false, tr("Optional, turns on verbose mode."))
+	return precompileCommand
+}
+
+func runPrecompileCommand(cmd *cobra.Command, args []string) {
+	instance := instance.CreateAndInit()
+	logrus.Info("Executing `arduino-cli lib precompile`")
+
+	var stdOut, stdErr io.Writer = os.Stdout, os.Stderr
+	if output.OutputFormat == "json" {
+		stdOut, stdErr = ioutil.Discard, ioutil.Discard
+	}
+	resp, err := lib.LibraryPrecompile(context.Background(), &rpc.LibraryPrecompileRequest{
+		Instance:    instance,
+		LibraryPath: args[0],
+		Fqbn:        precompileFqbns,
+		Verbose:     precompileVerbose,
+	}, stdOut, stdErr, output.TaskProgress())
+	if err != nil {
+		feedback.Errorf(tr("Error precompiling library: %v"), err)
+		os.Exit(errorcodes.ErrGeneric)
+	}
+	feedback.PrintResult(precompileResult{archives: resp.GetArchives()})
+}
+
+// output from this command requires special formatting, let's create a dedicated
+// feedback.Result implementation
+type precompileResult struct {
+	archives []*rpc.PrecompiledLibraryArchive
+}
+
+func (res precompileResult) Data() interface{} {
+	return res.archives
+}
+
+func (res precompileResult) String() string {
+	t := table.New()
+	t.SetHeader(tr("FQBN"), tr("Archive"))
+	for _, archive := range res.archives {
+		t.AddRow(archive.GetFqbn(), archive.GetPath())
+	}
+	return t.Render()
+}
@@ -40,14 +40,22 @@ var (
 
 func initSearchCommand() *cobra.Command {
 	searchCommand := &cobra.Command{
-		Use:     fmt.Sprintf("search [%s]", tr("LIBRARY_NAME")),
-		Short:   tr("Searches for one or more libraries data."),
-		Long:    tr("Search for one or more libraries data (case insensitive search)."),
-		Example: "  " + os.Args[0] + " lib search audio",
-		Args:    cobra.ArbitraryArgs,
-		Run:     runSearchCommand,
+		Use:   fmt.Sprintf("search [%s]", tr("LIBRARY_NAME")),
+		Short: tr("Searches for one or more libraries data."),
+		Long: tr(`Search for one or more libraries data (case insensitive search).
+
+The results are sorted by relevance. Besides plain words the search can be
+restricted using qualifiers in the form key:value, the supported keys are:
+name, author, maintainer, category, type, arch, depends, license and includes.
+Values containing spaces must be quoted.`),
+		Example: "  " + os.Args[0] + " lib search audio\n" +
+			"  " + os.Args[0] + " lib search author:Adafruit category:Sensors arch:samd depends:Wire\n" +
+			"  " + os.Args[0] + " lib search --fqbn arduino:samd:mkr1000 temperature",
+		Args: cobra.ArbitraryArgs,
+		Run:  runSearchCommand,
 	}
 	searchCommand.Flags().BoolVar(&namesOnly, "names", false, tr("Show library names only."))
+	fqbn.AddToCommand(searchCommand)
 	return searchCommand
 }
 
@@ -76,6 +84,7 @@ func runSearchCommand(cmd *cobra.Command, args []string) {
 	searchResp, err := lib.LibrarySearch(context.Background(), &rpc.LibrarySearchRequest{
 		Instance: inst,
 		Query:    (strings.Join(args, " ")),
+		Fqbn:     fqbn.String(),
 	})
 	if err != nil {
 		feedback.Errorf(tr("Error searching for Library: %v"), err)
@@ -127,11 +136,7 @@ func (res result) String() string {
 		return tr("No libraries matching your search.")
 	}
 
-	// get a sorted slice of results
-	sort.Slice(results, func(i, j int) bool {
-		return results[i].Name < results[j].Name
-	})
-
+	// results are already sorted by relevance
 	var out strings.Builder
 
 	if res.results.GetStatus() == rpc.LibrarySearchStatus_LIBRARY_SEARCH_STATUS_FAILED {
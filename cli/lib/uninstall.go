@@ -19,6 +19,7 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"strings"
 
 	"github.com/arduino/arduino-cli/cli/arguments"
 	"github.com/arduino/arduino-cli/cli/errorcodes"
@@ -31,18 +32,27 @@ import (
 	"github.com/spf13/cobra"
 )
 
+var uninstallFlags struct {
+	force      bool
+	autoremove bool
+}
+
 func initUninstallCommand() *cobra.Command {
 	uninstallCommand := &cobra.Command{
-		Use:     fmt.Sprintf("uninstall %s...", tr("LIBRARY_NAME")),
-		Short:   tr("Uninstalls one or more libraries."),
-		Long:    tr("Uninstalls one or more libraries."),
-		Example: "  " + os.Args[0] + " lib uninstall AudioZero",
-		Args:    cobra.MinimumNArgs(1),
-		Run:     runUninstallCommand,
+		Use:   fmt.Sprintf("uninstall %s...", tr("LIBRARY_NAME")),
+		Short: tr("Uninstalls one or more libraries."),
+		Long:  tr("Uninstalls one or more libraries."),
+		Example: "" +
+			"  " + os.Args[0] + " lib uninstall AudioZero\n" +
+			"  " + os.Args[0] + " lib uninstall --autoremove ArduinoIoTCloud # " + tr("also removes the dependencies not required anymore.") + "\n",
+		Args: cobra.MinimumNArgs(1),
+		Run:  runUninstallCommand,
 		ValidArgsFunction: func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
 			return arguments.GetUninstallableLibraries(), cobra.ShellCompDirectiveDefault
 		},
 	}
+	uninstallCommand.Flags().BoolVar(&uninstallFlags.force, "force", false, tr("Uninstall the libraries even if other installed libraries depend on them."))
+	uninstallCommand.Flags().BoolVar(&uninstallFlags.autoremove, "autoremove", false, tr("Also uninstall the dependencies that are not required anymore."))
 	return uninstallCommand
 }
 
@@ -56,16 +66,20 @@ func runUninstallCommand(cmd *cobra.Command, args []string) {
 		os.Exit(errorcodes.ErrBadArgument)
 	}
 
-	for _, library := range refs {
-		err := lib.LibraryUninstall(context.Background(), &rpc.LibraryUninstallRequest{
-			Instance: instance,
-			Name:     library.Name,
-			Version:  library.Version,
-		}, output.TaskProgress())
-		if err != nil {
-			feedback.Errorf(tr("Error uninstalling %[1]s: %[2]v"), library, err)
-			os.Exit(errorcodes.ErrGeneric)
-		}
+	// All the libraries are uninstalled at once to check their reverse dependencies together
+	req := &rpc.LibraryUninstallRequest{
+		Instance:   instance,
+		Name:       refs[0].Name,
+		Version:    refs[0].Version,
+		Force:      uninstallFlags.force,
+		Autoremove: uninstallFlags.autoremove,
+	}
+	for _, libRef := range refs[1:] {
+		req.AdditionalLibraries = append(req.AdditionalLibraries, libRef.ToRPCLibraryReference())
+	}
+	if err := lib.LibraryUninstall(context.Background(), req, output.TaskProgress()); err != nil {
+		feedback.Errorf(tr("Error uninstalling %[1]s: %[2]v"), strings.Join(args, ", "), err)
+		os.Exit(errorcodes.ErrGeneric)
 	}
 
 	logrus.Info("Done")
@@ -0,0 +1,73 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package lib
+
+import (
+	"context"
+	"os"
+
+	"github.com/arduino/arduino-cli/cli/arguments"
+	"github.com/arduino/arduino-cli/cli/errorcodes"
+	"github.com/arduino/arduino-cli/cli/feedback"
+	"github.com/arduino/arduino-cli/cli/instance"
+	"github.com/arduino/arduino-cli/cli/output"
+	"github.com/arduino/arduino-cli/commands/lib"
+	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+var (
+	vendorFqbn      arguments.Fqbn
+	vendorOverwrite bool
+)
+
+func initVendorCommand() *cobra.Command {
+	vendorCommand := &cobra.Command{
+		Use:   "vendor [" + tr("sketchPath") + "]",
+		Short: tr("Copies the libraries used by a sketch inside the sketch."),
+		Long:  tr("Copies the libraries used by a sketch into its libraries folder, the vendored libraries take precedence over any other installed library."),
+		Example: "" +
+			"  " + os.Args[0] + " lib vendor -b arduino:avr:uno /home/user/Arduino/MySketch",
+		Args: cobra.MaximumNArgs(1),
+		Run:  runVendorCommand,
+	}
+	vendorFqbn.AddToCommand(vendorCommand)
+	vendorCommand.Flags().BoolVar(&vendorOverwrite, "overwrite", false, tr("Replace the libraries already vendored in the sketch."))
+	return vendorCommand
+}
+
+func runVendorCommand(cmd *cobra.Command, args []string) {
+	instance := instance.CreateAndInit()
+	logrus.Info("Executing `arduino-cli lib vendor`")
+
+	path := ""
+	if len(args) > 0 {
+		path = args[0]
+	}
+	sketchPath := arguments.InitSketchPath(path)
+
+	err := lib.LibraryVendor(context.Background(), &rpc.LibraryVendorRequest{
+		Instance:   instance,
+		SketchPath: sketchPath.String(),
+		Fqbn:       vendorFqbn.String(),
+		Overwrite:  vendorOverwrite,
+	}, output.TaskProgress())
+	if err != nil {
+		feedback.Errorf(tr("Error vendoring libraries: %v"), err)
+		os.Exit(errorcodes.ErrGeneric)
+	}
+}
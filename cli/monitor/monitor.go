@@ -44,6 +44,8 @@ var (
 	configs  []string
 	quiet    bool
 	fqbn     arguments.Fqbn
+	decode   bool
+	elfFile  string
 	tr       = i18n.Tr
 )
 
@@ -55,13 +57,16 @@ func NewCommand() *cobra.Command {
 		Long:  tr("Open a communication port with a board."),
 		Example: "" +
 			"  " + os.Args[0] + " monitor -p /dev/ttyACM0\n" +
-			"  " + os.Args[0] + " monitor -p /dev/ttyACM0 --describe",
+			"  " + os.Args[0] + " monitor -p /dev/ttyACM0 --describe\n" +
+			"  " + os.Args[0] + " monitor -p /dev/ttyUSB0 --decode --elf /tmp/build/Blink.ino.elf",
 		Run: runMonitorCmd,
 	}
 	portArgs.AddToCommand(monitorCommand)
 	monitorCommand.Flags().BoolVar(&describe, "describe", false, tr("Show all the settings of the communication port."))
 	monitorCommand.Flags().StringSliceVarP(&configs, "config", "c", []string{}, tr("Configuration of the port."))
 	monitorCommand.Flags().BoolVarP(&quiet, "quiet", "q", false, tr("Run in silent mode, show only monitor input and output."))
+	monitorCommand.Flags().BoolVar(&decode, "decode", false, tr("Decode the backtraces and register dumps printed by the board when it crashes."))
+	monitorCommand.Flags().StringVar(&elfFile, "elf", "", tr("ELF file used to decode crash dumps, defaults to the one in the build path of the sketch in the current directory."))
 	fqbn.AddToCommand(monitorCommand)
 	monitorCommand.MarkFlagRequired("port")
 	return monitorCommand
@@ -150,6 +155,9 @@ func runMonitorCmd(cmd *cobra.Command, args []string) {
 		Port:              &rpc.Port{Address: portAddress, Protocol: portProtocol},
 		Fqbn:              fqbn.String(),
 		PortConfiguration: configuration,
+		Decode:            decode,
+		ElfPath:           elfFile,
+		SketchPath:        sketchPath(),
 	})
 	if err != nil {
 		feedback.Error(err)
@@ -181,6 +189,15 @@ func runMonitorCmd(cmd *cobra.Command, args []string) {
 	<-ctx.Done()
 }
 
+// sketchPath returns the sketch whose ELF file is used to decode crash dumps
+// when the ELF file is not given
+func sketchPath() string {
+	if !decode || elfFile != "" {
+		return ""
+	}
+	return arguments.InitSketchPath("").String()
+}
+
 type detailsResult struct {
 	Settings []*rpc.MonitorPortSettingDescriptor `json:"settings"`
 }
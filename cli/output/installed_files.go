@@ -0,0 +1,86 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package output
+
+import (
+	"strings"
+
+	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
+	"github.com/arduino/arduino-cli/table"
+)
+
+// InstalledFilesVerificationResult is the feedback.Result of the verification
+// of the files of installed platforms or tools
+type InstalledFilesVerificationResult struct {
+	Results []*rpc.InstalledFilesVerification
+}
+
+// Data implements feedback.Result
+func (res InstalledFilesVerificationResult) Data() interface{} {
+	return res.Results
+}
+
+// String implements feedback.Result
+func (res InstalledFilesVerificationResult) String() string {
+	if len(res.Results) == 0 {
+		return tr("Nothing to verify.")
+	}
+	var out strings.Builder
+	t := table.New()
+	t.SetHeader(tr("ID"), tr("Status"))
+	for _, result := range res.Results {
+		t.AddRow(result.GetId(), installedFilesStatus(result))
+	}
+	out.WriteString(t.Render())
+	for _, result := range res.Results {
+		files := [][]string{result.GetModifiedFiles(), result.GetMissingFiles(), result.GetExtraFiles()}
+		labels := []string{tr("modified"), tr("missing"), tr("extra")}
+		for i, list := range files {
+			for _, file := range list {
+				out.WriteString(result.GetId() + ": " + labels[i] + " " + file + "\n")
+			}
+		}
+	}
+	return strings.TrimRight(out.String(), "\n")
+}
+
+// IsIntact returns true if all the verified releases are intact or have been
+// repaired
+func (res InstalledFilesVerificationResult) IsIntact() bool {
+	for _, result := range res.Results {
+		if !result.GetRepaired() && !isInstalledFilesIntact(result) {
+			return false
+		}
+	}
+	return true
+}
+
+func isInstalledFilesIntact(result *rpc.InstalledFilesVerification) bool {
+	return len(result.GetModifiedFiles()) == 0 && len(result.GetMissingFiles()) == 0
+}
+
+func installedFilesStatus(result *rpc.InstalledFilesVerification) string {
+	switch {
+	case result.GetRepaired():
+		return tr("Repaired")
+	case result.GetManifestMissing():
+		return tr("Unverifiable, installed without manifest")
+	case !isInstalledFilesIntact(result):
+		return tr("Damaged")
+	default:
+		return tr("OK")
+	}
+}
@@ -57,8 +57,25 @@ func TaskProgress() commands.TaskProgressCB {
 func NewDownloadProgressBarCB() func(*rpc.DownloadProgress) {
 	var bar *pb.ProgressBar
 	var prefix string
+	var overallBar *pb.ProgressBar
 	return func(curr *rpc.DownloadProgress) {
 		// fmt.Printf(">>> %v\n", curr)
+		if overall := curr.GetOverall(); overall != nil {
+			// Multiple files are downloaded at the same time: a single bar
+			// shows the progress of all of them
+			if overallBar == nil {
+				overallBar = pb.StartNew(int(overall.GetTotalSize()))
+				overallBar.SetUnits(pb.U_BYTES)
+			}
+			overallBar.Prefix(tr("Downloading %[1]d/%[2]d files", overall.GetCompletedFiles(), overall.GetFiles()))
+			overallBar.SetTotal(int(overall.GetTotalSize()))
+			overallBar.Set(int(overall.GetDownloaded()))
+			if overall.GetCompletedFiles() == overall.GetFiles() {
+				overallBar.FinishPrintOver(tr("%d files downloaded", overall.GetFiles()))
+				overallBar = nil
+			}
+			return
+		}
 		if filename := curr.GetFile(); filename != "" {
 			if curr.GetCompleted() {
 				fmt.Println(tr("%s already downloaded", filename))
@@ -0,0 +1,86 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package tools
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/arduino/arduino-cli/cli/errorcodes"
+	"github.com/arduino/arduino-cli/cli/feedback"
+	"github.com/arduino/arduino-cli/cli/instance"
+	"github.com/arduino/arduino-cli/cli/output"
+	"github.com/arduino/arduino-cli/commands/core"
+	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+var verifyRepair bool
+
+func initVerifyCommand() *cobra.Command {
+	verifyCommand := &cobra.Command{
+		Use:   fmt.Sprintf("verify [%s[:%s]] ...", tr("PACKAGER"), tr("TOOL")),
+		Short: tr("Verifies the integrity of the files of the installed tools."),
+		Long: tr(`Verifies the files of the installed tools against the ones recorded at install time,
+reporting modified, missing or extra files. If no tool is specified all the installed tools are verified.`),
+		Example: "" +
+			"  " + os.Args[0] + " tools verify\n" +
+			"  " + os.Args[0] + " tools verify arduino:avr-gcc --repair",
+		Args: cobra.ArbitraryArgs,
+		Run:  runVerifyCommand,
+	}
+	verifyCommand.Flags().BoolVar(&verifyRepair, "repair", false, tr("Reinstall the damaged tools from the archives in the downloads directory."))
+	return verifyCommand
+}
+
+func runVerifyCommand(cmd *cobra.Command, args []string) {
+	inst := instance.CreateAndInit()
+	logrus.Info("Executing `arduino-cli tools verify`")
+
+	requests := []*rpc.ToolVerifyRequest{}
+	for _, arg := range args {
+		split := strings.SplitN(arg, ":", 2)
+		req := &rpc.ToolVerifyRequest{Instance: inst, ToolPackage: split[0], Repair: verifyRepair}
+		if len(split) == 2 {
+			req.ToolName = split[1]
+		}
+		if req.ToolPackage == "" {
+			feedback.Errorf(tr("Invalid argument passed: %v"), arg)
+			os.Exit(errorcodes.ErrBadArgument)
+		}
+		requests = append(requests, req)
+	}
+	if len(requests) == 0 {
+		requests = append(requests, &rpc.ToolVerifyRequest{Instance: inst, Repair: verifyRepair})
+	}
+
+	res := output.InstalledFilesVerificationResult{}
+	for _, req := range requests {
+		resp, err := core.ToolVerify(context.Background(), req, output.ProgressBar(), output.TaskProgress())
+		if err != nil {
+			feedback.Errorf(tr("Error verifying tool: %v"), err)
+			os.Exit(errorcodes.ErrGeneric)
+		}
+		res.Results = append(res.Results, resp.GetResults()...)
+	}
+	feedback.PrintResult(res)
+	if !res.IsIntact() {
+		os.Exit(errorcodes.ErrGeneric)
+	}
+}
@@ -0,0 +1,314 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package cache
+
+import (
+	"context"
+	"crypto/sha256"
+	"io"
+	"os"
+	"sort"
+	"time"
+
+	"github.com/arduino/arduino-cli/arduino"
+	"github.com/arduino/arduino-cli/arduino/libraries"
+	"github.com/arduino/arduino-cli/arduino/libraries/librariesindex"
+	"github.com/arduino/arduino-cli/arduino/resources"
+	"github.com/arduino/arduino-cli/commands"
+	"github.com/arduino/arduino-cli/i18n"
+	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
+	"github.com/arduino/go-paths-helper"
+	"github.com/sirupsen/logrus"
+)
+
+var tr = i18n.Tr
+
+// CacheGC removes from the downloads cache the archives that are not used by
+// the installed platforms, tools and libraries, following the age and size
+// limits of the request, and replaces identical archives with hardlinks.
+func CacheGC(ctx context.Context, req *rpc.CacheGCRequest) (*rpc.CacheGCResponse, error) {
+	pm := commands.GetPackageManager(req.GetInstance().GetId())
+	if pm == nil {
+		return nil, &arduino.InvalidInstanceError{}
+	}
+	lm := commands.GetLibraryManager(req.GetInstance().GetId())
+	if lm == nil {
+		return nil, &arduino.InvalidInstanceError{}
+	}
+	if req.GetMaxAgeDays() < 0 || req.GetMaxSize() < 0 {
+		return nil, &arduino.InvalidArgumentError{Message: tr("The cache limits can't be negative")}
+	}
+
+	used := map[string]bool{}
+	addUsed := func(downloadDir *paths.Path, resource *resources.DownloadResource) error {
+		if resource == nil {
+			return nil
+		}
+		archivePath, err := resource.ArchivePath(downloadDir)
+		if err != nil {
+			return err
+		}
+		used[archivePath.Clean().String()] = true
+		return nil
+	}
+	for _, platformRelease := range pm.InstalledPlatformReleases() {
+		if err := addUsed(pm.DownloadDir, platformRelease.Resource); err != nil {
+			return nil, &arduino.PermissionDeniedError{Message: tr("Error reading the downloads cache"), Cause: err}
+		}
+	}
+	for _, toolRelease := range pm.GetAllInstalledToolsReleases() {
+		if err := addUsed(pm.DownloadDir, toolRelease.GetCompatibleFlavour()); err != nil {
+			return nil, &arduino.PermissionDeniedError{Message: tr("Error reading the downloads cache"), Cause: err}
+		}
+	}
+	for _, libAlternatives := range lm.Libraries {
+		for _, lib := range libAlternatives.Alternatives {
+			if lib.Location != libraries.User || lib.GitOrigin != nil || lib.Version == nil {
+				continue
+			}
+			release := lm.Index.FindRelease(&librariesindex.Reference{Name: lib.RealName, Version: lib.Version})
+			if release == nil {
+				continue
+			}
+			if err := addUsed(lm.DownloadsDir, release.Resource); err != nil {
+				return nil, &arduino.PermissionDeniedError{Message: tr("Error reading the downloads cache"), Cause: err}
+			}
+		}
+	}
+
+	dirs := paths.NewPathList(pm.DownloadDir.String())
+	if !lm.DownloadsDir.EquivalentTo(pm.DownloadDir) {
+		dirs.Add(lm.DownloadsDir)
+	}
+	policy := &gcPolicy{
+		maxAge:  time.Duration(req.GetMaxAgeDays()) * 24 * time.Hour,
+		maxSize: req.GetMaxSize(),
+		dryRun:  req.GetDryRun(),
+	}
+	res, err := collectGarbage(dirs, used, policy, time.Now())
+	if err != nil {
+		return nil, &arduino.PermissionDeniedError{Message: tr("Error cleaning the downloads cache"), Cause: err}
+	}
+	return res, nil
+}
+
+// gcPolicy are the rules followed to remove the unused archives
+type gcPolicy struct {
+	// maxAge removes the unused archives older than the given duration
+	maxAge time.Duration
+	// maxSize removes the oldest unused archives until the cache is smaller
+	// than the given size
+	maxSize int64
+	// dryRun only computes the changes
+	dryRun bool
+}
+
+// cachedFile is a file in the downloads cache. Hardlinked files share the
+// same inode, that is the index of the first of them in the list of files.
+type cachedFile struct {
+	path    *paths.Path
+	info    os.FileInfo
+	used    bool
+	inode   int
+	removed bool
+}
+
+// collectGarbage applies the policy to the files found in the dirs, the used
+// files are never removed.
+func collectGarbage(dirs paths.PathList, used map[string]bool, policy *gcPolicy, now time.Time) (*rpc.CacheGCResponse, error) {
+	files := []*cachedFile{}
+	for _, dir := range dirs {
+		if !dir.IsDir() {
+			continue
+		}
+		list, err := dir.ReadDirRecursive()
+		if err != nil {
+			return nil, err
+		}
+		for _, path := range list {
+			info, err := path.Stat()
+			if err != nil {
+				return nil, err
+			}
+			if !info.Mode().IsRegular() {
+				continue
+			}
+			file := &cachedFile{path: path, info: info, used: used[path.Clean().String()], inode: len(files)}
+			for _, f := range files {
+				if os.SameFile(f.info, info) {
+					file.inode = f.inode
+					break
+				}
+			}
+			files = append(files, file)
+		}
+	}
+
+	res := &rpc.CacheGCResponse{SizeBefore: diskUsage(files)}
+
+	// Oldest unused files are removed first
+	unused := []*cachedFile{}
+	for _, file := range files {
+		if !file.used {
+			unused = append(unused, file)
+		}
+	}
+	sort.SliceStable(unused, func(i, j int) bool { return unused[i].info.ModTime().Before(unused[j].info.ModTime()) })
+	for _, file := range unused {
+		remove := policy.maxAge == 0 && policy.maxSize == 0
+		if policy.maxAge > 0 && now.Sub(file.info.ModTime()) > policy.maxAge {
+			remove = true
+		}
+		if policy.maxSize > 0 && diskUsage(files) > policy.maxSize {
+			remove = true
+		}
+		if !remove {
+			continue
+		}
+		file.removed = true
+		res.Removed = append(res.Removed, &rpc.CachedArchive{Path: file.path.String(), Size: file.info.Size()})
+		if policy.dryRun {
+			continue
+		}
+		logrus.WithField("file", file.path).Info("Removing unused archive from cache")
+		if err := file.path.Remove(); err != nil {
+			return nil, err
+		}
+	}
+
+	// Identical files are replaced with hardlinks to the first one
+	kept := []*cachedFile{}
+	for _, file := range files {
+		if !file.removed {
+			kept = append(kept, file)
+		}
+		if file.used && !file.removed {
+			res.UsedArchives++
+		}
+	}
+	duplicates, err := findDuplicates(kept)
+	if err != nil {
+		return nil, err
+	}
+	for _, dup := range duplicates {
+		original, file := dup[0], dup[1]
+		res.Deduplicated = append(res.Deduplicated, &rpc.CachedArchive{Path: file.path.String(), Size: file.info.Size()})
+		file.inode = original.inode
+		if policy.dryRun {
+			continue
+		}
+		logrus.WithField("file", file.path).WithField("original", original.path).Info("Deduplicating archive in cache")
+		if err := replaceWithHardlink(original.path, file.path); err != nil {
+			return nil, err
+		}
+	}
+
+	if !policy.dryRun {
+		for _, dir := range dirs {
+			removeEmptyDirs(dir, dir)
+		}
+	}
+	res.SizeAfter = diskUsage(files)
+	return res, nil
+}
+
+// diskUsage returns the space used by the files not removed, counting the
+// hardlinked files only once
+func diskUsage(files []*cachedFile) int64 {
+	counted := map[int]bool{}
+	size := int64(0)
+	for _, file := range files {
+		if file.removed || counted[file.inode] {
+			continue
+		}
+		counted[file.inode] = true
+		size += file.info.Size()
+	}
+	return size
+}
+
+// findDuplicates returns the pairs of files with the same content that are
+// not already hardlinked, the first file of the pair is the one to keep
+func findDuplicates(files []*cachedFile) ([][2]*cachedFile, error) {
+	bySize := map[int64][]*cachedFile{}
+	for _, file := range files {
+		bySize[file.info.Size()] = append(bySize[file.info.Size()], file)
+	}
+	res := [][2]*cachedFile{}
+	for _, file := range files {
+		sameSize := bySize[file.info.Size()]
+		if len(sameSize) < 2 || sameSize[0] != file {
+			continue
+		}
+		byHash := map[string]*cachedFile{}
+		for _, f := range sameSize {
+			hash, err := fileHash(f.path)
+			if err != nil {
+				return nil, err
+			}
+			if original, ok := byHash[hash]; !ok {
+				byHash[hash] = f
+			} else if original.inode != f.inode {
+				res = append(res, [2]*cachedFile{original, f})
+			}
+		}
+	}
+	return res, nil
+}
+
+func fileHash(path *paths.Path) (string, error) {
+	f, err := path.Open()
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+	hash := sha256.New()
+	if _, err := io.Copy(hash, f); err != nil {
+		return "", err
+	}
+	return string(hash.Sum(nil)), nil
+}
+
+// replaceWithHardlink atomically replaces file with an hardlink to original
+func replaceWithHardlink(original, file *paths.Path) error {
+	tmp := file.Parent().Join("." + file.Base() + ".link")
+	if err := os.Link(original.String(), tmp.String()); err != nil {
+		return err
+	}
+	if err := tmp.Rename(file); err != nil {
+		tmp.Remove()
+		return err
+	}
+	return nil
+}
+
+// removeEmptyDirs removes the empty subfolders of dir, root is never removed
+func removeEmptyDirs(root, dir *paths.Path) {
+	list, err := dir.ReadDir()
+	if err != nil {
+		return
+	}
+	list.FilterDirs()
+	for _, subdir := range list {
+		removeEmptyDirs(root, subdir)
+	}
+	if dir.EqualsTo(root) {
+		return
+	}
+	if empty, err := resources.IsDirEmpty(dir); err == nil && empty {
+		dir.Remove()
+	}
+}
@@ -0,0 +1,112 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package cache
+
+import (
+	"os"
+	"testing"
+	"time"
+
+	"github.com/arduino/go-paths-helper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCollectGarbage(t *testing.T) {
+	now := time.Now()
+	setup := func() *paths.Path {
+		dir, err := paths.MkTempDir("", "test_cache_gc")
+		require.NoError(t, err)
+		files := []struct {
+			name    string
+			content string
+			age     time.Duration
+		}{
+			{"packages/used.zip", "used archive", 100 * 24 * time.Hour},
+			{"packages/old.zip", "old unused archive", 60 * 24 * time.Hour},
+			{"packages/recent.zip", "recent unused", 2 * 24 * time.Hour},
+			{"libraries/copy1.zip", "identical content", 1 * time.Hour},
+			{"libraries/copy2.zip", "identical content", 1 * time.Hour},
+		}
+		for _, f := range files {
+			path := dir.Join(f.name)
+			require.NoError(t, path.Parent().MkdirAll())
+			require.NoError(t, path.WriteFile([]byte(f.content)))
+			require.NoError(t, os.Chtimes(path.String(), now.Add(-f.age), now.Add(-f.age)))
+		}
+		return dir
+	}
+
+	t.Run("AllUnused", func(t *testing.T) {
+		dir := setup()
+		defer dir.RemoveAll()
+		used := map[string]bool{dir.Join("packages", "used.zip").String(): true}
+		res, err := collectGarbage(paths.NewPathList(dir.String()), used, &gcPolicy{}, now)
+		require.NoError(t, err)
+		require.Len(t, res.Removed, 4)
+		require.Equal(t, int32(1), res.UsedArchives)
+		require.Equal(t, int64(12+18+13+17+17), res.SizeBefore)
+		require.Equal(t, int64(12), res.SizeAfter)
+		require.FileExists(t, dir.Join("packages", "used.zip").String())
+		require.NoDirExists(t, dir.Join("libraries").String())
+	})
+
+	t.Run("MaxAge", func(t *testing.T) {
+		dir := setup()
+		defer dir.RemoveAll()
+		used := map[string]bool{dir.Join("packages", "used.zip").String(): true}
+		res, err := collectGarbage(paths.NewPathList(dir.String()), used, &gcPolicy{maxAge: 30 * 24 * time.Hour}, now)
+		require.NoError(t, err)
+		require.Len(t, res.Removed, 1)
+		require.Equal(t, dir.Join("packages", "old.zip").String(), res.Removed[0].Path)
+		require.NoFileExists(t, dir.Join("packages", "old.zip").String())
+
+		// Identical archives are hardlinked
+		require.Len(t, res.Deduplicated, 1)
+		require.Equal(t, int64(12+13+17), res.SizeAfter)
+		info1, err := dir.Join("libraries", "copy1.zip").Stat()
+		require.NoError(t, err)
+		info2, err := dir.Join("libraries", "copy2.zip").Stat()
+		require.NoError(t, err)
+		require.True(t, os.SameFile(info1, info2))
+
+		// Nothing else to do on a second run
+		res, err = collectGarbage(paths.NewPathList(dir.String()), used, &gcPolicy{maxAge: 30 * 24 * time.Hour}, now)
+		require.NoError(t, err)
+		require.Empty(t, res.Removed)
+		require.Empty(t, res.Deduplicated)
+		require.Equal(t, res.SizeBefore, res.SizeAfter)
+	})
+
+	t.Run("MaxSizeDryRun", func(t *testing.T) {
+		dir := setup()
+		defer dir.RemoveAll()
+		used := map[string]bool{dir.Join("packages", "used.zip").String(): true}
+		res, err := collectGarbage(paths.NewPathList(dir.String()), used, &gcPolicy{maxSize: 50, dryRun: true}, now)
+		require.NoError(t, err)
+		// The oldest unused archives are removed first
+		require.Len(t, res.Removed, 2)
+		require.Equal(t, dir.Join("packages", "old.zip").String(), res.Removed[0].Path)
+		require.Equal(t, dir.Join("packages", "recent.zip").String(), res.Removed[1].Path)
+		require.Equal(t, int64(12+17), res.SizeAfter)
+		// Nothing is changed
+		require.FileExists(t, dir.Join("packages", "old.zip").String())
+		info1, err := dir.Join("libraries", "copy1.zip").Stat()
+		require.NoError(t, err)
+		info2, err := dir.Join("libraries", "copy2.zip").Stat()
+		require.NoError(t, err)
+		require.False(t, os.SameFile(info1, info2))
+	})
+}
@@ -140,6 +140,11 @@ func Compile(ctx context.Context, req *rpc.CompileRequest, outStream, errStream
 
 	builderCtx.LibraryDirs = paths.NewPathList(req.Library...)
 
+	if sketchLibrariesDir := sk.LibrariesDir(); sketchLibrariesDir.IsDir() {
+		builderCtx.SketchLibrariesDir = sketchLibrariesDir
+	}
+	builderCtx.LibraryOverrides = sk.Metadata.LibraryOverrides
+
 	if req.GetBuildPath() == "" {
 		builderCtx.BuildPath = sk.BuildPath
 	} else {
@@ -214,6 +219,16 @@ func Compile(ctx context.Context, req *rpc.CompileRequest, outStream, errStream
 		if pl := builderCtx.ActualPlatform; pl != nil {
 			r.BuildPlatform = pl.ToRPCPlatformReference()
 		}
+		headers := []string{}
+		for header := range builderCtx.LibrariesResolutionResults {
+			headers = append(headers, header)
+		}
+		sort.Strings(headers)
+		for _, header := range headers {
+			if res := builderCtx.LibrariesResolutionResults[header].Resolution; res != nil {
+				r.LibraryResolutions = append(r.LibraryResolutions, res.ToRPCLibraryResolution())
+			}
+		}
 	}()
 
 	// if --preprocess or --show-properties were passed, we can stop here
@@ -25,6 +25,7 @@ import (
 	"github.com/arduino/arduino-cli/commands"
 	"github.com/arduino/arduino-cli/i18n"
 	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
+	"go.bug.st/downloader/v2"
 )
 
 var tr = i18n.Tr
@@ -46,21 +47,29 @@ func PlatformDownload(ctx context.Context, req *rpc.PlatformDownloadRequest, dow
 		PlatformArchitecture: req.Architecture,
 		PlatformVersion:      version,
 	}
-	platform, tools, err := pm.FindPlatformReleaseDependencies(ref)
+	platform, tools, platforms, err := pm.FindPlatformReleaseDependencies(ref)
 	if err != nil {
 		return nil, &arduino.PlatformNotFoundError{Platform: ref.String(), Cause: err}
 	}
 
-	if err := downloadPlatform(pm, platform, downloadCB); err != nil {
-		return nil, err
-	}
-
-	for _, tool := range tools {
-		if err := downloadTool(pm, tool, downloadCB); err != nil {
+	// The platforms required by the platform are downloaded too
+	for _, platformRelease := range platforms {
+		if platformRelease.IsInstalled() {
+			continue
+		}
+		platformTools, err := pm.FindPlatformReleaseTools(platformRelease)
+		if err != nil {
+			return nil, &arduino.NotFoundError{Message: tr("Can't find dependencies for platform %s", platformRelease), Cause: err}
+		}
+		if err := downloadPlatformAndTools(pm, platformRelease, platformTools, downloadCB); err != nil {
 			return nil, err
 		}
 	}
 
+	if err := downloadPlatformAndTools(pm, platform, tools, downloadCB); err != nil {
+		return nil, err
+	}
+
 	return &rpc.PlatformDownloadResponse{}, nil
 }
 
@@ -91,3 +100,49 @@ func downloadTool(pm *packagemanager.PackageManager, tool *cores.ToolRelease, do
 
 	return nil
 }
+
+// downloadPlatformAndTools downloads the platform, if not nil, and the tools
+// at the same time
+func downloadPlatformAndTools(pm *packagemanager.PackageManager, platformRelease *cores.PlatformRelease, tools []*cores.ToolRelease, downloadCB commands.DownloadProgressCB) error {
+	config, err := commands.GetDownloaderConfig()
+	if err != nil {
+		return err
+	}
+
+	tasks := []*commands.DownloadTask{}
+	if platformRelease != nil {
+		tasks = append(tasks, &commands.DownloadTask{
+			Label: platformRelease.String(),
+			Size:  platformRelease.Resource.Size,
+			Start: func() (*downloader.Downloader, error) {
+				d, err := pm.DownloadPlatformRelease(platformRelease, config)
+				if err != nil {
+					return nil, &arduino.FailedDownloadError{Message: tr("Error downloading platform %s", platformRelease), Cause: err}
+				}
+				return d, nil
+			},
+		})
+	}
+	for _, tool := range tools {
+		tool := tool
+		// Check if tool has a flavor available for the current OS
+		resource := tool.GetCompatibleFlavour()
+		if resource == nil {
+			return &arduino.FailedDownloadError{
+				Message: tr("Error downloading tool %s", tool),
+				Cause:   errors.New(tr("no versions available for the current OS", tool))}
+		}
+		tasks = append(tasks, &commands.DownloadTask{
+			Label: tool.String(),
+			Size:  resource.Size,
+			Start: func() (*downloader.Downloader, error) {
+				d, err := pm.DownloadToolRelease(tool, config)
+				if err != nil {
+					return nil, &arduino.FailedDownloadError{Message: tr("Error downloading tool %s", tool), Cause: err}
+				}
+				return d, nil
+			},
+		})
+	}
+	return commands.DownloadParallel(tasks, downloadCB)
+}
@@ -0,0 +1,97 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package core
+
+import (
+	"context"
+
+	"github.com/arduino/arduino-cli/arduino"
+	"github.com/arduino/arduino-cli/arduino/cores/packageindex"
+	"github.com/arduino/arduino-cli/arduino/lint"
+	"github.com/arduino/arduino-cli/commands"
+	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
+	"github.com/arduino/go-paths-helper"
+)
+
+// PackageIndexLint checks a package index against the package index
+// specification. If requested the archives referenced by the index are
+// downloaded to verify their size and checksum.
+func PackageIndexLint(ctx context.Context, req *rpc.PackageIndexLintRequest, downloadCB commands.DownloadProgressCB) (*rpc.PackageIndexLintResponse, error) {
+	if req.GetIndexPath() == "" {
+		return nil, &arduino.InvalidArgumentError{Message: tr("Missing package index path")}
+	}
+	indexFile, err := paths.New(req.GetIndexPath()).Abs()
+	if err != nil {
+		return nil, &arduino.InvalidArgumentError{Message: tr("Invalid package index path"), Cause: err}
+	}
+	findings, err := packageindex.Lint(indexFile)
+	if err != nil {
+		return nil, &arduino.InvalidArgumentError{Message: tr("Error reading package index"), Cause: err}
+	}
+
+	if req.GetVerifyArchives() {
+		// The archives can be verified only if the index could be loaded
+		if index, err := packageindex.LoadIndexNoSign(indexFile); err == nil {
+			if err := verifyIndexArchives(indexFile, index, &findings, downloadCB); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return &rpc.PackageIndexLintResponse{Findings: findings.ToRPC()}, nil
+}
+
+// verifyIndexArchives downloads, one at a time, the archives of the index in a
+// temporary folder and checks them against the size and checksum in the index
+func verifyIndexArchives(indexFile *paths.Path, index *packageindex.Index, findings *lint.Findings, downloadCB commands.DownloadProgressCB) error {
+	config, err := commands.GetDownloaderConfig()
+	if err != nil {
+		return err
+	}
+	downloadDir, err := paths.MkTempDir("", "package-index-lint")
+	if err != nil {
+		return &arduino.TempDirCreationFailedError{Cause: err}
+	}
+	defer downloadDir.RemoveAll()
+
+	verified := map[string]bool{}
+	for _, archive := range index.Archives() {
+		resource := archive.Resource
+		if verified[resource.URL] {
+			continue
+		}
+		verified[resource.URL] = true
+
+		d, err := resource.Download(downloadDir, config)
+		if err == nil {
+			err = commands.Download(d, archive.Name, downloadCB)
+		}
+		if err != nil {
+			findings.Add(lint.Error, "archive-download", indexFile, tr("%[1]s: downloading %[2]s: %[3]s"), archive.Name, resource.URL, err)
+			continue
+		}
+		if ok, err := resource.TestLocalArchiveSize(downloadDir); !ok {
+			findings.Add(lint.Error, "archive-size", indexFile, tr("%[1]s: archive %[2]s: %[3]s"), archive.Name, resource.ArchiveFileName, err)
+		}
+		if ok, err := resource.TestLocalArchiveChecksum(downloadDir); !ok {
+			findings.Add(lint.Error, "archive-checksum", indexFile, tr("%[1]s: archive %[2]s: %[3]s"), archive.Name, resource.ArchiveFileName, err)
+		}
+		if archivePath, err := resource.ArchivePath(downloadDir); err == nil {
+			archivePath.Remove()
+		}
+	}
+	return nil
+}
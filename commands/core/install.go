@@ -44,16 +44,21 @@ func PlatformInstall(ctx context.Context, req *rpc.PlatformInstallRequest,
 		PlatformArchitecture: req.Architecture,
 		PlatformVersion:      version,
 	}
-	platform, tools, err := pm.FindPlatformReleaseDependencies(ref)
+	platform, tools, platforms, err := pm.FindPlatformReleaseDependencies(ref)
 	if err != nil {
 		return nil, &arduino.PlatformNotFoundError{Platform: ref.String(), Cause: err}
 	}
 
-	err = installPlatform(pm, platform, tools, downloadCB, taskCB, req.GetSkipPostInstall())
-	if err != nil {
+	if err := installPlatform(pm, platform, tools, platforms, downloadCB, taskCB, req.GetSkipPostInstall()); err != nil {
 		return nil, err
 	}
 
+	// The platform has been explicitly requested, it must not be removed
+	// together with the platforms requiring it
+	if err := pm.SetPlatformInstalledAsDependency(platform.Platform, false); err != nil {
+		pm.Log.WithError(err).Warn("Cannot record how the platform has been installed")
+	}
+
 	if err := commands.Init(&rpc.InitRequest{Instance: req.Instance}, nil); err != nil {
 		return nil, err
 	}
@@ -61,119 +66,199 @@ func PlatformInstall(ctx context.Context, req *rpc.PlatformInstallRequest,
 	return &rpc.PlatformInstallResponse{}, nil
 }
 
+// platformInstall is a platform release to install through a transaction
+type platformInstall struct {
+	release *cores.PlatformRelease
+	// installed is the release of the same platform currently installed, if any
+	installed *cores.PlatformRelease
+	// installedTools are the tools required by the installed release
+	installedTools []*cores.ToolRelease
+	// dependency is true if the platform is installed because required by
+	// another platform
+	dependency bool
+}
+
+// installPlatform installs the platform release together with the releases of
+// the platforms it requires and the tools of all of them. Everything is
+// installed in a single transaction: if any of them fails the installed
+// platforms and tools are restored as they were.
 func installPlatform(pm *packagemanager.PackageManager,
-	platformRelease *cores.PlatformRelease, requiredTools []*cores.ToolRelease,
+	platformRelease *cores.PlatformRelease, requiredTools []*cores.ToolRelease, requiredPlatforms []*cores.PlatformRelease,
 	downloadCB commands.DownloadProgressCB, taskCB commands.TaskProgressCB,
 	skipPostInstall bool) error {
 	log := pm.Log.WithField("platform", platformRelease)
 
-	// Prerequisite checks before install
-	if platformRelease.IsInstalled() {
-		log.Warn("Platform already installed")
-		taskCB(&rpc.TaskProgress{Name: tr("Platform %s already installed", platformRelease), Completed: true})
-		return nil
-	}
+	// Prerequisite checks before install, the required platforms come before
+	// the platforms requiring them
+	installs := []*platformInstall{}
 	toolsToInstall := []*cores.ToolRelease{}
-	for _, tool := range requiredTools {
-		if tool.IsInstalled() {
-			log.WithField("tool", tool).Warn("Tool already installed")
-			taskCB(&rpc.TaskProgress{Name: tr("Tool %s already installed", tool), Completed: true})
-		} else {
+	addTools := func(tools []*cores.ToolRelease) {
+	nextTool:
+		for _, tool := range tools {
+			if tool.IsInstalled() {
+				log.WithField("tool", tool).Warn("Tool already installed")
+				taskCB(&rpc.TaskProgress{Name: tr("Tool %s already installed", tool), Completed: true})
+				continue
+			}
+			for _, other := range toolsToInstall {
+				if other == tool {
+					continue nextTool
+				}
+			}
 			toolsToInstall = append(toolsToInstall, tool)
 		}
 	}
+	releases := append(append([]*cores.PlatformRelease{}, requiredPlatforms...), platformRelease)
+	for _, required := range releases {
+		if required.IsInstalled() {
+			if required == platformRelease {
+				log.Warn("Platform already installed")
+			}
+			taskCB(&rpc.TaskProgress{Name: tr("Platform %s already installed", required), Completed: true})
+			continue
+		}
+		tools := requiredTools
+		if required != platformRelease {
+			var err error
+			tools, err = pm.FindPlatformReleaseTools(required)
+			if err != nil {
+				return &arduino.NotFoundError{Message: tr("Can't find dependencies for platform %s", required), Cause: err}
+			}
+		}
+		addTools(tools)
+
+		install := &platformInstall{
+			release:    required,
+			installed:  pm.GetInstalledPlatformRelease(required.Platform),
+			dependency: required != platformRelease,
+		}
+		if install.installed != nil {
+			// Get a list of tools used by the currently installed platform version.
+			// This must be done so tools used by the currently installed version are
+			// removed if not used also by the newly installed version.
+			var err error
+			install.installedTools, err = pm.FindPlatformReleaseTools(install.installed)
+			if err != nil {
+				return &arduino.NotFoundError{Message: tr("Can't find dependencies for platform %s", install.installed), Cause: err}
+			}
+		}
+		installs = append(installs, install)
+	}
+	if len(installs) == 0 {
+		return nil
+	}
 
 	// Package download
 	taskCB(&rpc.TaskProgress{Name: tr("Downloading packages")})
-	for _, tool := range toolsToInstall {
-		if err := downloadTool(pm, tool, downloadCB); err != nil {
+	for i, install := range installs {
+		var tools []*cores.ToolRelease
+		if i == 0 {
+			tools = toolsToInstall
+		}
+		if err := downloadPlatformAndTools(pm, install.release, tools, downloadCB); err != nil {
 			return err
 		}
 	}
-	if err := downloadPlatform(pm, platformRelease, downloadCB); err != nil {
-		return err
-	}
 	taskCB(&rpc.TaskProgress{Completed: true})
 
-	// Install tools first
-	for _, tool := range toolsToInstall {
-		if err := commands.InstallToolRelease(pm, tool, taskCB); err != nil {
-			return err
+	for _, install := range installs {
+		if install.installed == nil {
+			// No version of this platform is installed
+			pm.Log.WithField("platform", install.release).Info("Installing platform")
+			taskCB(&rpc.TaskProgress{Name: tr("Installing platform %s", install.release)})
+		} else {
+			// A platform with a different version is already installed
+			pm.Log.WithField("platform", install.release).Info("Upgrading platform " + install.installed.String())
+			taskCB(&rpc.TaskProgress{Name: tr("Upgrading platform %[1]s with %[2]s", install.installed, install.release)})
 		}
 	}
 
-	installed := pm.GetInstalledPlatformRelease(platformRelease.Platform)
-	installedTools := []*cores.ToolRelease{}
-	if installed == nil {
-		// No version of this platform is installed
-		log.Info("Installing platform")
-		taskCB(&rpc.TaskProgress{Name: tr("Installing platform %s", platformRelease)})
-	} else {
-		// A platform with a different version is already installed
-		log.Info("Upgrading platform " + installed.String())
-		taskCB(&rpc.TaskProgress{Name: tr("Upgrading platform %[1]s with %[2]s", installed, platformRelease)})
-		platformRef := &packagemanager.PlatformReference{
-			Package:              platformRelease.Platform.Package.Name,
-			PlatformArchitecture: platformRelease.Platform.Architecture,
-			PlatformVersion:      installed.Version,
+	// Platforms and tools are extracted in a staging area and moved in place
+	// only when all of them are ready, any failure restores the previous state
+	tx, err := pm.NewTransaction()
+	if err != nil {
+		return &arduino.FailedInstallError{Message: tr("Cannot install platform"), Cause: err}
+	}
+	if err := applyPlatformInstall(pm, tx, installs, toolsToInstall, taskCB, skipPostInstall); err != nil {
+		log.WithError(err).Error("Cannot install platform")
+		if rollbackErr := tx.Rollback(); rollbackErr != nil {
+			log.WithError(rollbackErr).Error("Error rolling-back changes.")
+			taskCB(&rpc.TaskProgress{Message: tr("Error rolling-back changes: %s", rollbackErr)})
 		}
+		return err
+	}
+	if err := tx.Commit(); err != nil {
+		log.WithError(err).Warn("Error cleaning up the previous installation")
+		taskCB(&rpc.TaskProgress{Message: tr("WARNING cannot clean up the previous installation: %s", err)})
+	}
 
-		// Get a list of tools used by the currently installed platform version.
-		// This must be done so tools used by the currently installed version are
-		// removed if not used also by the newly installed version.
-		var err error
-		_, installedTools, err = pm.FindPlatformReleaseDependencies(platformRef)
-		if err != nil {
-			return &arduino.NotFoundError{Message: tr("Can't find dependencies for platform %s", platformRef), Cause: err}
+	for _, install := range installs {
+		// Platforms already installed keep the way they have been installed
+		if install.dependency && install.installed == nil {
+			if err := pm.SetPlatformInstalledAsDependency(install.release.Platform, true); err != nil {
+				log.WithError(err).Warn("Cannot record how the platform has been installed")
+			}
 		}
+		pm.Log.WithField("platform", install.release).Info("Platform installed")
+		taskCB(&rpc.TaskProgress{Message: tr("Platform %s installed", install.release), Completed: true})
 	}
+	return nil
+}
 
-	// Install
-	if err := pm.InstallPlatform(platformRelease); err != nil {
-		log.WithError(err).Error("Cannot install platform")
-		return &arduino.FailedInstallError{Message: tr("Cannot install platform"), Cause: err}
+// applyPlatformInstall installs the platforms and the tools through the
+// transaction, replacing the installed releases of the platforms if any.
+func applyPlatformInstall(pm *packagemanager.PackageManager, tx *packagemanager.Transaction,
+	installs []*platformInstall, toolsToInstall []*cores.ToolRelease,
+	taskCB commands.TaskProgressCB, skipPostInstall bool) error {
+	for _, tool := range toolsToInstall {
+		taskCB(&rpc.TaskProgress{Name: tr("Installing %s", tool)})
+		if err := tx.StageTool(tool); err != nil {
+			return &arduino.FailedInstallError{Message: tr("Cannot install tool %s", tool), Cause: err}
+		}
+		taskCB(&rpc.TaskProgress{Message: tr("%s installed", tool), Completed: true})
 	}
+	for _, install := range installs {
+		if err := tx.StagePlatform(install.release); err != nil {
+			return &arduino.FailedInstallError{Message: tr("Cannot install platform"), Cause: err}
+		}
 
-	// If upgrading remove previous release
-	if installed != nil {
-		uninstallErr := pm.UninstallPlatform(installed)
-
-		// In case of error try to rollback
-		if uninstallErr != nil {
-			log.WithError(uninstallErr).Error("Error upgrading platform.")
-			taskCB(&rpc.TaskProgress{Message: tr("Error upgrading platform: %s", uninstallErr)})
-
-			// Rollback
-			if err := pm.UninstallPlatform(platformRelease); err != nil {
-				log.WithError(err).Error("Error rolling-back changes.")
-				taskCB(&rpc.TaskProgress{Message: tr("Error rolling-back changes: %s", err)})
+		// If upgrading the previous release is kept to allow a rollback
+		if install.installed != nil {
+			if err := tx.RemovePlatform(install.installed, true); err != nil {
+				taskCB(&rpc.TaskProgress{Message: tr("Error upgrading platform: %s", err)})
+				return &arduino.FailedInstallError{Message: tr("Cannot upgrade platform"), Cause: err}
 			}
-
-			return &arduino.FailedInstallError{Message: tr("Cannot upgrade platform"), Cause: uninstallErr}
 		}
+	}
+	if err := tx.Apply(); err != nil {
+		return &arduino.FailedInstallError{Message: tr("Cannot install platform"), Cause: err}
+	}
 
-		// Uninstall unused tools
-		for _, tool := range installedTools {
-			if !pm.IsToolRequired(tool) {
-				uninstallToolRelease(pm, tool, taskCB)
+	// Uninstall unused tools
+	for _, install := range installs {
+		for _, tool := range install.installedTools {
+			if tool.IsInstalled() && !pm.IsToolRequired(tool) {
+				if err := uninstallToolRelease(pm, tx, tool, taskCB); err != nil {
+					return err
+				}
 			}
 		}
-
 	}
 
 	// Perform post install
-	if !skipPostInstall {
-		log.Info("Running post_install script")
-		taskCB(&rpc.TaskProgress{Message: tr("Configuring platform.")})
-		if err := pm.RunPostInstallScript(platformRelease); err != nil {
-			taskCB(&rpc.TaskProgress{Message: tr("WARNING cannot configure platform: %s", err)})
+	for _, install := range installs {
+		log := pm.Log.WithField("platform", install.release)
+		if !skipPostInstall {
+			log.Info("Running post_install script")
+			taskCB(&rpc.TaskProgress{Message: tr("Configuring platform.")})
+			if err := pm.RunPostInstallScript(install.release); err != nil {
+				taskCB(&rpc.TaskProgress{Message: tr("Error configuring platform: %s", err)})
+				return &arduino.FailedInstallError{Message: tr("Cannot configure platform"), Cause: err}
+			}
+		} else {
+			log.Info("Skipping platform configuration.")
+			taskCB(&rpc.TaskProgress{Message: tr("Skipping platform configuration.")})
 		}
-	} else {
-		log.Info("Skipping platform configuration.")
-		taskCB(&rpc.TaskProgress{Message: tr("Skipping platform configuration.")})
 	}
-
-	log.Info("Platform installed")
-	taskCB(&rpc.TaskProgress{Message: tr("Platform %s installed", platformRelease), Completed: true})
 	return nil
 }
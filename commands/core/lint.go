@@ -0,0 +1,48 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package core
+
+import (
+	"context"
+
+	"github.com/arduino/arduino-cli/arduino"
+	"github.com/arduino/arduino-cli/commands"
+	"github.com/arduino/arduino-cli/configuration"
+	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
+	"github.com/arduino/go-paths-helper"
+)
+
+// PlatformLint checks the platform in the given folder against the platform
+// specification. The tools and the pluggable discoveries and monitors required
+// by the platform are resolved against the platforms installed in the instance.
+func PlatformLint(ctx context.Context, req *rpc.PlatformLintRequest) (*rpc.PlatformLintResponse, error) {
+	pm := commands.GetPackageManager(req.GetInstance().GetId())
+	if pm == nil {
+		return nil, &arduino.InvalidInstanceError{}
+	}
+	if req.GetPlatformPath() == "" {
+		return nil, &arduino.InvalidArgumentError{Message: tr("Missing platform path")}
+	}
+	platformDir, err := paths.New(req.GetPlatformPath()).Abs()
+	if err != nil {
+		return nil, &arduino.InvalidArgumentError{Message: tr("Invalid platform path"), Cause: err}
+	}
+	findings, err := pm.LintPlatform(platformDir, configuration.HardwareDirectories(configuration.Settings))
+	if err != nil {
+		return nil, &arduino.InvalidArgumentError{Message: tr("Error loading platform"), Cause: err}
+	}
+	return &rpc.PlatformLintResponse{Findings: findings.ToRPC()}, nil
+}
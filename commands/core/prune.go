@@ -0,0 +1,93 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package core
+
+import (
+	"context"
+
+	"github.com/arduino/arduino-cli/arduino"
+	"github.com/arduino/arduino-cli/commands"
+	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
+	paths "github.com/arduino/go-paths-helper"
+)
+
+// ToolPrune removes the installed tool releases that are not required by any
+// of the installed platforms, or only lists them if DryRun is set.
+func ToolPrune(ctx context.Context, req *rpc.ToolPruneRequest, taskCB commands.TaskProgressCB) (*rpc.ToolPruneResponse, error) {
+	pm := commands.GetPackageManager(req.GetInstance().GetId())
+	if pm == nil {
+		return nil, &arduino.InvalidInstanceError{}
+	}
+
+	unused, err := pm.FindUnusedToolReleases()
+	if err != nil {
+		return nil, &arduino.NotFoundError{Message: tr("Can't find the tools required by the installed platforms"), Cause: err}
+	}
+
+	res := &rpc.ToolPruneResponse{}
+	for _, tool := range unused {
+		size, err := dirSize(tool.InstallDir)
+		if err != nil {
+			return nil, &arduino.PermissionDeniedError{Message: tr("Error reading tool %s", tool), Cause: err}
+		}
+		res.Tools = append(res.Tools, &rpc.PrunedTool{
+			Id:         tool.String(),
+			InstallDir: tool.InstallDir.String(),
+			Size:       size,
+		})
+		res.TotalSize += size
+	}
+	if req.GetDryRun() || len(unused) == 0 {
+		return res, nil
+	}
+
+	tx, err := pm.NewTransaction()
+	if err != nil {
+		return nil, &arduino.FailedUninstallError{Message: tr("Error removing unused tools"), Cause: err}
+	}
+	for _, tool := range unused {
+		if err := uninstallToolRelease(pm, tx, tool, taskCB); err != nil {
+			if rollbackErr := tx.Rollback(); rollbackErr != nil {
+				pm.Log.WithError(rollbackErr).Error("Error rolling-back changes.")
+				taskCB(&rpc.TaskProgress{Message: tr("Error rolling-back changes: %s", rollbackErr)})
+			}
+			return nil, err
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, &arduino.FailedUninstallError{Message: tr("Error removing unused tools"), Cause: err}
+	}
+	return res, nil
+}
+
+// dirSize returns the size of the files contained in dir
+func dirSize(dir *paths.Path) (int64, error) {
+	files, err := dir.ReadDirRecursive()
+	if err != nil {
+		return 0, err
+	}
+	size := int64(0)
+	for _, file := range files {
+		info, err := file.Stat()
+		if err != nil {
+			return 0, err
+		}
+		if info.Mode().IsRegular() {
+			size += info.Size()
+		}
+	}
+	return size, nil
+}
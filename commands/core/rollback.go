@@ -0,0 +1,148 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package core
+
+import (
+	"context"
+	"errors"
+
+	"github.com/arduino/arduino-cli/arduino"
+	"github.com/arduino/arduino-cli/arduino/cores"
+	"github.com/arduino/arduino-cli/arduino/cores/packagemanager"
+	"github.com/arduino/arduino-cli/commands"
+	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
+)
+
+// PlatformRollback reinstalls the platform release replaced by the last
+// upgrade of an installed platform. The currently installed release is kept
+// in its place, so that a following rollback returns to it.
+func PlatformRollback(ctx context.Context, req *rpc.PlatformRollbackRequest,
+	downloadCB commands.DownloadProgressCB, taskCB commands.TaskProgressCB) (*rpc.PlatformRollbackResponse, error) {
+
+	pm := commands.GetPackageManager(req.GetInstance().GetId())
+	if pm == nil {
+		return nil, &arduino.InvalidInstanceError{}
+	}
+
+	ref := &packagemanager.PlatformReference{
+		Package:              req.GetPlatformPackage(),
+		PlatformArchitecture: req.GetArchitecture(),
+	}
+	platform := pm.FindPlatform(ref)
+	if platform == nil {
+		return nil, &arduino.PlatformNotFoundError{Platform: ref.String()}
+	}
+	installed := pm.GetInstalledPlatformRelease(platform)
+	if installed == nil {
+		return nil, &arduino.PlatformNotFoundError{Platform: ref.String(), Cause: errors.New(tr("platform not installed"))}
+	}
+	previous, err := pm.FindPlatformRollbackRelease(platform)
+	if err != nil {
+		return nil, &arduino.NotFoundError{Message: tr("Cannot rollback platform %s", platform), Cause: err}
+	}
+	tools, err := pm.FindPlatformReleaseTools(previous)
+	if err != nil {
+		return nil, &arduino.NotFoundError{Message: tr("Can't find dependencies for platform %s", previous), Cause: err}
+	}
+	installedTools, err := pm.FindPlatformReleaseTools(installed)
+	if err != nil {
+		return nil, &arduino.NotFoundError{Message: tr("Can't find dependencies for platform %s", installed), Cause: err}
+	}
+
+	// The tools of the previous release may have been removed by the upgrade
+	toolsToInstall := []*cores.ToolRelease{}
+	for _, tool := range tools {
+		if !tool.IsInstalled() {
+			toolsToInstall = append(toolsToInstall, tool)
+		}
+	}
+	if len(toolsToInstall) > 0 {
+		taskCB(&rpc.TaskProgress{Name: tr("Downloading packages")})
+		if err := downloadPlatformAndTools(pm, nil, toolsToInstall, downloadCB); err != nil {
+			return nil, err
+		}
+		taskCB(&rpc.TaskProgress{Completed: true})
+	}
+
+	log := pm.Log.WithField("platform", previous)
+	log.Info("Rolling back platform " + installed.String())
+	taskCB(&rpc.TaskProgress{Name: tr("Rolling back platform %[1]s to %[2]s", installed, previous)})
+	tx, err := pm.NewTransaction()
+	if err != nil {
+		return nil, &arduino.FailedInstallError{Message: tr("Cannot rollback platform %s", platform), Cause: err}
+	}
+	if err := applyPlatformRollback(pm, tx, previous, installed, toolsToInstall, installedTools, taskCB, req.GetSkipPostInstall()); err != nil {
+		log.WithError(err).Error("Cannot rollback platform")
+		if rollbackErr := tx.Rollback(); rollbackErr != nil {
+			log.WithError(rollbackErr).Error("Error rolling-back changes.")
+			taskCB(&rpc.TaskProgress{Message: tr("Error rolling-back changes: %s", rollbackErr)})
+		}
+		return nil, err
+	}
+	if err := tx.Commit(); err != nil {
+		log.WithError(err).Warn("Error cleaning up the previous installation")
+		taskCB(&rpc.TaskProgress{Message: tr("WARNING cannot clean up the previous installation: %s", err)})
+	}
+	taskCB(&rpc.TaskProgress{Message: tr("Platform %s installed", previous), Completed: true})
+
+	if err := commands.Init(&rpc.InitRequest{Instance: req.Instance}, nil); err != nil {
+		return nil, err
+	}
+	return &rpc.PlatformRollbackResponse{}, nil
+}
+
+// applyPlatformRollback replaces the installed release of the platform with
+// the previous one through the transaction.
+func applyPlatformRollback(pm *packagemanager.PackageManager, tx *packagemanager.Transaction,
+	previous, installed *cores.PlatformRelease, toolsToInstall, installedTools []*cores.ToolRelease,
+	taskCB commands.TaskProgressCB, skipPostInstall bool) error {
+
+	for _, tool := range toolsToInstall {
+		taskCB(&rpc.TaskProgress{Name: tr("Installing %s", tool)})
+		if err := tx.StageTool(tool); err != nil {
+			return &arduino.FailedInstallError{Message: tr("Cannot install tool %s", tool), Cause: err}
+		}
+		taskCB(&rpc.TaskProgress{Message: tr("%s installed", tool), Completed: true})
+	}
+	if err := tx.RestorePlatform(previous); err != nil {
+		return &arduino.FailedInstallError{Message: tr("Cannot rollback platform %s", previous.Platform), Cause: err}
+	}
+	if err := tx.RemovePlatform(installed, true); err != nil {
+		return &arduino.FailedInstallError{Message: tr("Cannot rollback platform %s", previous.Platform), Cause: err}
+	}
+	if err := tx.Apply(); err != nil {
+		return &arduino.FailedInstallError{Message: tr("Cannot rollback platform %s", previous.Platform), Cause: err}
+	}
+
+	for _, tool := range installedTools {
+		if tool.IsInstalled() && !pm.IsToolRequired(tool) {
+			if err := uninstallToolRelease(pm, tx, tool, taskCB); err != nil {
+				return err
+			}
+		}
+	}
+
+	if !skipPostInstall {
+		taskCB(&rpc.TaskProgress{Message: tr("Configuring platform.")})
+		if err := pm.RunPostInstallScript(previous); err != nil {
+			taskCB(&rpc.TaskProgress{Message: tr("Error configuring platform: %s", err)})
+			return &arduino.FailedInstallError{Message: tr("Cannot configure platform"), Cause: err}
+		}
+	} else {
+		taskCB(&rpc.TaskProgress{Message: tr("Skipping platform configuration.")})
+	}
+	return nil
+}
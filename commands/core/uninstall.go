@@ -17,6 +17,9 @@ package core
 
 import (
 	"context"
+	"errors"
+	"sort"
+	"strings"
 
 	"github.com/arduino/arduino-cli/arduino"
 	"github.com/arduino/arduino-cli/arduino/cores"
@@ -48,18 +51,48 @@ func PlatformUninstall(ctx context.Context, req *rpc.PlatformUninstallRequest, t
 		ref.PlatformVersion = platformRelease.Version
 	}
 
-	platform, tools, err := pm.FindPlatformReleaseDependencies(ref)
+	platform := pm.FindPlatformRelease(ref)
+	if platform == nil {
+		return nil, &arduino.PlatformNotFoundError{Platform: ref.String()}
+	}
+	tools, err := pm.FindPlatformReleaseTools(platform)
 	if err != nil {
 		return nil, &arduino.NotFoundError{Message: tr("Can't find dependencies for platform %s", ref), Cause: err}
 	}
 
-	if err := uninstallPlatformRelease(pm, platform, taskCB); err != nil {
-		return nil, err
+	// A platform required by other installed platforms can't be removed
+	if requiredBy := pm.InstalledPlatformReleasesRequiring(platform.Platform); len(requiredBy) > 0 {
+		names := []string{}
+		for _, other := range requiredBy {
+			names = append(names, other.String())
+		}
+		sort.Strings(names)
+		return nil, &arduino.FailedUninstallError{
+			Message: tr("Error uninstalling platform %s", platform),
+			Cause:   errors.New(tr("the platform is required by %s", strings.Join(names, ", ")))}
 	}
 
-	for _, tool := range tools {
-		if !pm.IsToolRequired(tool) {
-			uninstallToolRelease(pm, tool, taskCB)
+	// The platform, the platforms it requires that are no longer needed and the
+	// unused tools are removed together, if any of them can't be removed the
+	// others are restored
+	tx, err := pm.NewTransaction()
+	if err != nil {
+		return nil, &arduino.FailedUninstallError{Message: tr("Error uninstalling platform %s", platform), Cause: err}
+	}
+	removed, err := uninstallPlatformAndDependencies(pm, tx, platform, tools, taskCB)
+	if err != nil {
+		if rollbackErr := tx.Rollback(); rollbackErr != nil {
+			pm.Log.WithError(rollbackErr).Error("Error rolling-back changes.")
+			taskCB(&rpc.TaskProgress{Message: tr("Error rolling-back changes: %s", rollbackErr)})
+		}
+		return nil, err
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, &arduino.FailedUninstallError{Message: tr("Error uninstalling platform %s", platform), Cause: err}
+	}
+	for _, removedRelease := range removed {
+		if err := pm.SetPlatformInstalledAsDependency(removedRelease.Platform, false); err != nil {
+			pm.Log.WithError(err).Warn("Cannot record how the platform has been installed")
 		}
 	}
 
@@ -70,29 +103,72 @@ func PlatformUninstall(ctx context.Context, req *rpc.PlatformUninstallRequest, t
 	return &rpc.PlatformUninstallResponse{}, nil
 }
 
-func uninstallPlatformRelease(pm *packagemanager.PackageManager, platformRelease *cores.PlatformRelease, taskCB commands.TaskProgressCB) error {
+// uninstallPlatformAndDependencies uninstalls the platform and then the
+// installed platforms it requires, directly or through other platforms, that
+// have been installed only as dependencies and are not required by any other
+// installed platform. The uninstalled platform releases are returned.
+func uninstallPlatformAndDependencies(pm *packagemanager.PackageManager, tx *packagemanager.Transaction, platformRelease *cores.PlatformRelease, tools []*cores.ToolRelease, taskCB commands.TaskProgressCB) ([]*cores.PlatformRelease, error) {
+	dependencies := pm.FindInstalledPlatformDependencies(platformRelease)
+	if err := uninstallPlatformRelease(pm, tx, platformRelease, tools, taskCB); err != nil {
+		return nil, err
+	}
+	removed := []*cores.PlatformRelease{platformRelease}
+
+	// The dependencies are sorted so that each platform comes after the
+	// platforms it requires, going backwards the platforms requiring a
+	// dependency are removed before it
+	for i := len(dependencies) - 1; i >= 0; i-- {
+		dependency := dependencies[i]
+		// Platforms installed explicitly by the user are kept
+		if !pm.IsPlatformInstalledAsDependency(dependency.Platform) {
+			continue
+		}
+		if len(pm.InstalledPlatformReleasesRequiring(dependency.Platform)) > 0 {
+			continue
+		}
+		dependencyTools, err := pm.FindPlatformReleaseTools(dependency)
+		if err != nil {
+			return nil, &arduino.NotFoundError{Message: tr("Can't find dependencies for platform %s", dependency), Cause: err}
+		}
+		if err := uninstallPlatformRelease(pm, tx, dependency, dependencyTools, taskCB); err != nil {
+			return nil, err
+		}
+		removed = append(removed, dependency)
+	}
+	return removed, nil
+}
+
+func uninstallPlatformRelease(pm *packagemanager.PackageManager, tx *packagemanager.Transaction, platformRelease *cores.PlatformRelease, tools []*cores.ToolRelease, taskCB commands.TaskProgressCB) error {
 	log := pm.Log.WithField("platform", platformRelease)
 
 	log.Info("Uninstalling platform")
 	taskCB(&rpc.TaskProgress{Name: tr("Uninstalling %s", platformRelease)})
 
-	if err := pm.UninstallPlatform(platformRelease); err != nil {
+	if err := tx.RemovePlatform(platformRelease, false); err != nil {
 		log.WithError(err).Error("Error uninstalling")
 		return &arduino.FailedUninstallError{Message: tr("Error uninstalling platform %s", platformRelease), Cause: err}
 	}
 
 	log.Info("Platform uninstalled")
 	taskCB(&rpc.TaskProgress{Message: tr("Platform %s uninstalled", platformRelease), Completed: true})
+
+	for _, tool := range tools {
+		if tool.IsInstalled() && !pm.IsToolRequired(tool) {
+			if err := uninstallToolRelease(pm, tx, tool, taskCB); err != nil {
+				return err
+			}
+		}
+	}
 	return nil
 }
 
-func uninstallToolRelease(pm *packagemanager.PackageManager, toolRelease *cores.ToolRelease, taskCB commands.TaskProgressCB) error {
+func uninstallToolRelease(pm *packagemanager.PackageManager, tx *packagemanager.Transaction, toolRelease *cores.ToolRelease, taskCB commands.TaskProgressCB) error {
 	log := pm.Log.WithField("Tool", toolRelease)
 
 	log.Info("Uninstalling tool")
 	taskCB(&rpc.TaskProgress{Name: tr("Uninstalling %s, tool is no more required", toolRelease)})
 
-	if err := pm.UninstallTool(toolRelease); err != nil {
+	if err := tx.RemoveTool(toolRelease); err != nil {
 		log.WithError(err).Error("Error uninstalling")
 		return &arduino.FailedUninstallError{Message: tr("Error uninstalling tool %s", toolRelease), Cause: err}
 	}
@@ -19,6 +19,7 @@ import (
 	"context"
 
 	"github.com/arduino/arduino-cli/arduino"
+	"github.com/arduino/arduino-cli/arduino/cores"
 	"github.com/arduino/arduino-cli/arduino/cores/packagemanager"
 	"github.com/arduino/arduino-cli/commands"
 	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
@@ -49,6 +50,54 @@ func PlatformUpgrade(ctx context.Context, req *rpc.PlatformUpgradeRequest,
 	return &rpc.PlatformUpgradeResponse{}, nil
 }
 
+// UpgradeAllPlatforms upgrades to the latest release all the installed
+// platforms having a newer release in the package index. Each platform is
+// upgraded, together with its tools and required platforms, the same way as
+// PlatformUpgrade does. A platform is upgraded only up to the latest release
+// compatible with the installed platforms requiring it.
+func UpgradeAllPlatforms(pm *packagemanager.PackageManager, downloadCB commands.DownloadProgressCB, taskCB commands.TaskProgressCB, skipPostInstall bool) error {
+	isOutdated := func(platform *cores.Platform) bool {
+		installed := pm.GetInstalledPlatformRelease(platform)
+		if installed == nil {
+			return false
+		}
+		latest := platform.GetLatestRelease()
+		return latest != nil && latest.Version.GreaterThan(installed.Version)
+	}
+	isUpgradable := func(platform *cores.Platform) bool {
+		installed := pm.GetInstalledPlatformRelease(platform)
+		latest := pm.FindLatestCompatiblePlatformRelease(platform)
+		return latest != nil && latest.Version.GreaterThan(installed.Version)
+	}
+	outdated := []*cores.Platform{}
+	for _, targetPackage := range pm.Packages {
+		for _, platform := range targetPackage.Platforms {
+			if isOutdated(platform) {
+				outdated = append(outdated, platform)
+			}
+		}
+	}
+
+	for _, platform := range outdated {
+		// The platform may have been already upgraded as required by another one
+		if !isOutdated(platform) {
+			continue
+		}
+		if !isUpgradable(platform) {
+			taskCB(&rpc.TaskProgress{Message: tr("Platform %s not upgraded: the newer releases are not compatible with the installed platforms requiring it", platform), Completed: true})
+			continue
+		}
+		ref := &packagemanager.PlatformReference{
+			Package:              platform.Package.Name,
+			PlatformArchitecture: platform.Architecture,
+		}
+		if err := upgradePlatform(pm, ref, downloadCB, taskCB, skipPostInstall); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func upgradePlatform(pm *packagemanager.PackageManager, platformRef *packagemanager.PlatformReference,
 	downloadCB commands.DownloadProgressCB, taskCB commands.TaskProgressCB, skipPostInstall bool) error {
 	if platformRef.PlatformVersion != nil {
@@ -68,13 +117,25 @@ func upgradePlatform(pm *packagemanager.PackageManager, platformRef *packagemana
 	if !latest.Version.GreaterThan(installed.Version) {
 		return &arduino.PlatformAlreadyAtTheLatestVersionError{}
 	}
-	platformRef.PlatformVersion = latest.Version
 
-	platformRelease, tools, err := pm.FindPlatformReleaseDependencies(platformRef)
+	// The upgrade must not break the installed platforms requiring this one
+	compatible := pm.FindLatestCompatiblePlatformRelease(platform)
+	if compatible == nil || !compatible.Version.GreaterThan(installed.Version) {
+		// The resolution of the latest release explains the conflict
+		platformRef.PlatformVersion = latest.Version
+		_, _, _, err := pm.FindPlatformReleaseDependencies(platformRef)
+		return &arduino.FailedInstallError{Message: tr("Cannot upgrade platform %s", platform), Cause: err}
+	}
+	if compatible != latest {
+		taskCB(&rpc.TaskProgress{Message: tr("Upgrading %[1]s to %[2]s, the latest release compatible with the installed platforms requiring it", platform, compatible.Version)})
+	}
+	platformRef.PlatformVersion = compatible.Version
+
+	platformRelease, tools, platforms, err := pm.FindPlatformReleaseDependencies(platformRef)
 	if err != nil {
-		return &arduino.PlatformNotFoundError{Platform: platformRef.String()}
+		return &arduino.PlatformNotFoundError{Platform: platformRef.String(), Cause: err}
 	}
-	if err := installPlatform(pm, platformRelease, tools, downloadCB, taskCB, skipPostInstall); err != nil {
+	if err := installPlatform(pm, platformRelease, tools, platforms, downloadCB, taskCB, skipPostInstall); err != nil {
 		return err
 	}
 
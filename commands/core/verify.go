@@ -0,0 +1,175 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package core
+
+import (
+	"context"
+	"errors"
+
+	"github.com/arduino/arduino-cli/arduino"
+	"github.com/arduino/arduino-cli/arduino/cores"
+	"github.com/arduino/arduino-cli/arduino/cores/packagemanager"
+	"github.com/arduino/arduino-cli/arduino/resources"
+	"github.com/arduino/arduino-cli/commands"
+	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
+	paths "github.com/arduino/go-paths-helper"
+)
+
+// PlatformVerify checks the files of the installed platforms against the ones
+// recorded at install time and, if requested, reinstalls the damaged
+// platforms from the archives in the downloads directory.
+func PlatformVerify(ctx context.Context, req *rpc.PlatformVerifyRequest, downloadCB commands.DownloadProgressCB, taskCB commands.TaskProgressCB) (*rpc.PlatformVerifyResponse, error) {
+	pm := commands.GetPackageManager(req.GetInstance().GetId())
+	if pm == nil {
+		return nil, &arduino.InvalidInstanceError{}
+	}
+
+	platformReleases := []*cores.PlatformRelease{}
+	if req.GetPlatformPackage() == "" {
+		for _, platformRelease := range pm.InstalledPlatformReleases() {
+			// Platforms installed manually in the sketchbook have no manifest
+			if pm.IsManagedPlatformRelease(platformRelease) {
+				platformReleases = append(platformReleases, platformRelease)
+			}
+		}
+	} else {
+		ref := &packagemanager.PlatformReference{
+			Package:              req.GetPlatformPackage(),
+			PlatformArchitecture: req.GetArchitecture(),
+		}
+		platform := pm.FindPlatform(ref)
+		if platform == nil {
+			return nil, &arduino.PlatformNotFoundError{Platform: ref.String()}
+		}
+		platformRelease := pm.GetInstalledPlatformRelease(platform)
+		if platformRelease == nil {
+			return nil, &arduino.PlatformNotFoundError{Platform: ref.String(), Cause: errors.New(tr("platform not installed"))}
+		}
+		if !pm.IsManagedPlatformRelease(platformRelease) {
+			return nil, &arduino.InvalidArgumentError{Message: tr("%s is not managed by package manager", platformRelease)}
+		}
+		platformReleases = append(platformReleases, platformRelease)
+	}
+
+	res := &rpc.PlatformVerifyResponse{}
+	for _, platformRelease := range platformReleases {
+		log := pm.Log.WithField("platform", platformRelease)
+		taskCB(&rpc.TaskProgress{Name: tr("Verifying platform %s", platformRelease)})
+		report, err := pm.VerifyPlatform(platformRelease)
+		if err != nil {
+			return nil, &arduino.PermissionDeniedError{Message: tr("Error verifying platform %s", platformRelease), Cause: err}
+		}
+		result := installedFilesVerificationToRPC(platformRelease.String(), platformRelease.InstallDir, report)
+		if req.GetRepair() && !report.IsIntact() {
+			log.Info("Repairing platform")
+			taskCB(&rpc.TaskProgress{Message: tr("Reinstalling platform %s", platformRelease)})
+			if platformRelease.Resource == nil {
+				return nil, &arduino.FailedInstallError{Message: tr("Cannot repair platform %s", platformRelease), Cause: errors.New(tr("platform not found in the package index"))}
+			}
+			if err := downloadPlatform(pm, platformRelease, downloadCB); err != nil {
+				return nil, err
+			}
+			if err := pm.InstallPlatform(platformRelease); err != nil {
+				log.WithError(err).Error("Cannot repair platform")
+				return nil, &arduino.FailedInstallError{Message: tr("Cannot repair platform %s", platformRelease), Cause: err}
+			}
+			// The post install script must be run again, like after a fresh install
+			if !req.GetSkipPostInstall() {
+				log.Info("Running post_install script")
+				taskCB(&rpc.TaskProgress{Message: tr("Configuring platform.")})
+				if err := pm.RunPostInstallScript(platformRelease); err != nil {
+					taskCB(&rpc.TaskProgress{Message: tr("Error configuring platform: %s", err)})
+					return nil, &arduino.FailedInstallError{Message: tr("Cannot configure platform"), Cause: err}
+				}
+			} else {
+				log.Info("Skipping platform configuration.")
+				taskCB(&rpc.TaskProgress{Message: tr("Skipping platform configuration.")})
+			}
+			result.Repaired = true
+		}
+		res.Results = append(res.Results, result)
+		taskCB(&rpc.TaskProgress{Completed: true})
+	}
+	return res, nil
+}
+
+// ToolVerify checks the files of the installed tools against the ones
+// recorded at install time and, if requested, reinstalls the damaged tools
+// from the archives in the downloads directory.
+func ToolVerify(ctx context.Context, req *rpc.ToolVerifyRequest, downloadCB commands.DownloadProgressCB, taskCB commands.TaskProgressCB) (*rpc.ToolVerifyResponse, error) {
+	pm := commands.GetPackageManager(req.GetInstance().GetId())
+	if pm == nil {
+		return nil, &arduino.InvalidInstanceError{}
+	}
+
+	toolReleases := []*cores.ToolRelease{}
+	for _, toolRelease := range pm.GetAllInstalledToolsReleases() {
+		if req.GetToolPackage() != "" && toolRelease.Tool.Package.Name != req.GetToolPackage() {
+			continue
+		}
+		if req.GetToolName() != "" && toolRelease.Tool.Name != req.GetToolName() {
+			continue
+		}
+		// Tools bundled with the IDE have no manifest
+		if pm.IsManagedToolRelease(toolRelease) {
+			toolReleases = append(toolReleases, toolRelease)
+		}
+	}
+	if len(toolReleases) == 0 && req.GetToolPackage() != "" {
+		name := req.GetToolPackage()
+		if req.GetToolName() != "" {
+			name += ":" + req.GetToolName()
+		}
+		return nil, &arduino.NotFoundError{Message: tr("Tool %s not installed", name)}
+	}
+
+	res := &rpc.ToolVerifyResponse{}
+	for _, toolRelease := range toolReleases {
+		log := pm.Log.WithField("tool", toolRelease)
+		taskCB(&rpc.TaskProgress{Name: tr("Verifying tool %s", toolRelease)})
+		report, err := pm.VerifyTool(toolRelease)
+		if err != nil {
+			return nil, &arduino.PermissionDeniedError{Message: tr("Error verifying tool %s", toolRelease), Cause: err}
+		}
+		result := installedFilesVerificationToRPC(toolRelease.String(), toolRelease.InstallDir, report)
+		if req.GetRepair() && !report.IsIntact() {
+			log.Info("Repairing tool")
+			taskCB(&rpc.TaskProgress{Message: tr("Reinstalling tool %s", toolRelease)})
+			if err := downloadTool(pm, toolRelease, downloadCB); err != nil {
+				return nil, err
+			}
+			if err := pm.InstallTool(toolRelease); err != nil {
+				log.WithError(err).Error("Cannot repair tool")
+				return nil, &arduino.FailedInstallError{Message: tr("Cannot repair tool %s", toolRelease), Cause: err}
+			}
+			result.Repaired = true
+		}
+		res.Results = append(res.Results, result)
+		taskCB(&rpc.TaskProgress{Completed: true})
+	}
+	return res, nil
+}
+
+func installedFilesVerificationToRPC(id string, installDir *paths.Path, report *resources.InstalledFilesReport) *rpc.InstalledFilesVerification {
+	return &rpc.InstalledFilesVerification{
+		Id:              id,
+		InstallDir:      installDir.String(),
+		ManifestMissing: report.ManifestMissing,
+		ModifiedFiles:   report.Modified,
+		MissingFiles:    report.Missing,
+		ExtraFiles:      report.Extra,
+	}
+}
@@ -25,11 +25,13 @@ import (
 	"github.com/arduino/arduino-cli/arduino/utils"
 	"github.com/arduino/arduino-cli/commands"
 	"github.com/arduino/arduino-cli/commands/board"
+	"github.com/arduino/arduino-cli/commands/cache"
 	"github.com/arduino/arduino-cli/commands/compile"
 	"github.com/arduino/arduino-cli/commands/core"
 	"github.com/arduino/arduino-cli/commands/lib"
 	"github.com/arduino/arduino-cli/commands/monitor"
 	"github.com/arduino/arduino-cli/commands/sketch"
+	"github.com/arduino/arduino-cli/commands/upgrade"
 	"github.com/arduino/arduino-cli/commands/upload"
 	"github.com/arduino/arduino-cli/i18n"
 	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
@@ -208,7 +210,7 @@ func (s *ArduinoCoreServerImpl) Outdated(ctx context.Context, req *rpc.OutdatedR
 
 // Upgrade FIXMEDOC
 func (s *ArduinoCoreServerImpl) Upgrade(req *rpc.UpgradeRequest, stream rpc.ArduinoCoreService_UpgradeServer) error {
-	err := commands.Upgrade(stream.Context(), req,
+	err := upgrade.Upgrade(stream.Context(), req,
 		func(p *rpc.DownloadProgress) {
 			stream.Send(&rpc.UpgradeResponse{
 				Progress: p,
@@ -315,6 +317,18 @@ func (s *ArduinoCoreServerImpl) PlatformUninstall(req *rpc.PlatformUninstallRequ
 	return stream.Send(resp)
 }
 
+// ToolPrune FIXMEDOC
+func (s *ArduinoCoreServerImpl) ToolPrune(req *rpc.ToolPruneRequest, stream rpc.ArduinoCoreService_ToolPruneServer) error {
+	resp, err := core.ToolPrune(
+		stream.Context(), req,
+		func(p *rpc.TaskProgress) { stream.Send(&rpc.ToolPruneResponse{TaskProgress: p}) },
+	)
+	if err != nil {
+		return convertErrorToRPCStatus(err)
+	}
+	return stream.Send(resp)
+}
+
 // PlatformUpgrade FIXMEDOC
 func (s *ArduinoCoreServerImpl) PlatformUpgrade(req *rpc.PlatformUpgradeRequest, stream rpc.ArduinoCoreService_PlatformUpgradeServer) error {
 	resp, err := core.PlatformUpgrade(
@@ -328,6 +342,63 @@ func (s *ArduinoCoreServerImpl) PlatformUpgrade(req *rpc.PlatformUpgradeRequest,
 	return stream.Send(resp)
 }
 
+// PlatformRollback FIXMEDOC
+func (s *ArduinoCoreServerImpl) PlatformRollback(req *rpc.PlatformRollbackRequest, stream rpc.ArduinoCoreService_PlatformRollbackServer) error {
+	resp, err := core.PlatformRollback(
+		stream.Context(), req,
+		func(p *rpc.DownloadProgress) { stream.Send(&rpc.PlatformRollbackResponse{Progress: p}) },
+		func(p *rpc.TaskProgress) { stream.Send(&rpc.PlatformRollbackResponse{TaskProgress: p}) },
+	)
+	if err != nil {
+		return convertErrorToRPCStatus(err)
+	}
+	return stream.Send(resp)
+}
+
+// PackageIndexLint FIXMEDOC
+func (s *ArduinoCoreServerImpl) PackageIndexLint(req *rpc.PackageIndexLintRequest, stream rpc.ArduinoCoreService_PackageIndexLintServer) error {
+	resp, err := core.PackageIndexLint(
+		stream.Context(), req,
+		func(p *rpc.DownloadProgress) { stream.Send(&rpc.PackageIndexLintResponse{Progress: p}) },
+	)
+	if err != nil {
+		return convertErrorToRPCStatus(err)
+	}
+	return stream.Send(resp)
+}
+
+// PlatformLint FIXMEDOC
+func (s *ArduinoCoreServerImpl) PlatformLint(ctx context.Context, req *rpc.PlatformLintRequest) (*rpc.PlatformLintResponse, error) {
+	resp, err := core.PlatformLint(ctx, req)
+	return resp, convertErrorToRPCStatus(err)
+}
+
+// PlatformVerify FIXMEDOC
+func (s *ArduinoCoreServerImpl) PlatformVerify(req *rpc.PlatformVerifyRequest, stream rpc.ArduinoCoreService_PlatformVerifyServer) error {
+	resp, err := core.PlatformVerify(
+		stream.Context(), req,
+		func(p *rpc.DownloadProgress) { stream.Send(&rpc.PlatformVerifyResponse{Progress: p}) },
+		func(p *rpc.TaskProgress) { stream.Send(&rpc.PlatformVerifyResponse{TaskProgress: p}) },
+	)
+	if err != nil {
+		return convertErrorToRPCStatus(err)
+	}
+	return stream.Send(resp)
+}
+
+// ToolVerify FIXMEDOC
+func (s *ArduinoCoreServerImpl) ToolVerify(req *rpc.ToolVerifyRequest, stream rpc.ArduinoCoreService_ToolVerifyServer) error {
+	resp, err := core.ToolVerify(
+		stream.Context(), req,
+		func(p *rpc.DownloadProgress) { stream.Send(&rpc.ToolVerifyResponse{Progress: p}) },
+		func(p *rpc.TaskProgress) { stream.Send(&rpc.ToolVerifyResponse{TaskProgress: p}) },
+	)
+	if err != nil {
+		return convertErrorToRPCStatus(err)
+	}
+	return stream.Send(resp)
+}
+
 // PlatformSearch FIXMEDOC
 func (s *ArduinoCoreServerImpl) PlatformSearch(ctx context.Context, req *rpc.PlatformSearchRequest) (*rpc.PlatformSearchResponse, error) {
 	resp, err := core.PlatformSearch(req)
@@ -388,6 +459,19 @@ func (s *ArduinoCoreServerImpl) BurnBootloader(req *rpc.BurnBootloaderRequest, s
 	return stream.Send(resp)
 }
 
+// BoardRead reads back the content of a memory region of a board
+func (s *ArduinoCoreServerImpl) BoardRead(req *rpc.BoardReadRequest, stream rpc.ArduinoCoreService_BoardReadServer) error {
+	resp, err := upload.Read(
+		stream.Context(), req,
+		utils.FeedStreamTo(func(data []byte) { stream.Send(&rpc.BoardReadResponse{OutStream: data}) }),
+		utils.FeedStreamTo(func(data []byte) { stream.Send(&rpc.BoardReadResponse{ErrStream: data}) }),
+	)
+	if err != nil {
+		return convertErrorToRPCStatus(err)
+	}
+	return stream.Send(resp)
+}
+
 // ListProgrammersAvailableForUpload FIXMEDOC
 func (s *ArduinoCoreServerImpl) ListProgrammersAvailableForUpload(ctx context.Context, req *rpc.ListProgrammersAvailableForUploadRequest) (*rpc.ListProgrammersAvailableForUploadResponse, error) {
 	resp, err := upload.ListProgrammersAvailableForUpload(ctx, req)
@@ -460,6 +544,12 @@ func (s *ArduinoCoreServerImpl) LibraryList(ctx context.Context, req *rpc.Librar
 	return resp, convertErrorToRPCStatus(err)
 }
 
+// CacheGC FIXMEDOC
+func (s *ArduinoCoreServerImpl) CacheGC(ctx context.Context, req *rpc.CacheGCRequest) (*rpc.CacheGCResponse, error) {
+	resp, err := cache.CacheGC(ctx, req)
+	return resp, convertErrorToRPCStatus(err)
+}
+
 // ArchiveSketch FIXMEDOC
 func (s *ArduinoCoreServerImpl) ArchiveSketch(ctx context.Context, req *rpc.ArchiveSketchRequest) (*rpc.ArchiveSketchResponse, error) {
 	resp, err := sketch.ArchiveSketch(ctx, req)
@@ -490,6 +580,44 @@ func (s *ArduinoCoreServerImpl) GitLibraryInstall(req *rpc.GitLibraryInstallRequ
 	return stream.Send(&rpc.GitLibraryInstallResponse{})
 }
 
+// LibraryPack FIXMEDOC
+func (s *ArduinoCoreServerImpl) LibraryPack(ctx context.Context, req *rpc.LibraryPackRequest) (*rpc.LibraryPackResponse, error) {
+	resp, err := lib.LibraryPack(ctx, req)
+	return resp, convertErrorToRPCStatus(err)
+}
+
+// LibraryPrecompile FIXMEDOC
+func (s *ArduinoCoreServerImpl) LibraryPrecompile(req *rpc.LibraryPrecompileRequest, stream rpc.ArduinoCoreService_LibraryPrecompileServer) error {
+	resp, err := lib.LibraryPrecompile(
+		stream.Context(), req,
+		utils.FeedStreamTo(func(data []byte) { stream.Send(&rpc.LibraryPrecompileResponse{OutStream: data}) }),
+		utils.FeedStreamTo(func(data []byte) { stream.Send(&rpc.LibraryPrecompileResponse{ErrStream: data}) }),
+		func(p *rpc.TaskProgress) { stream.Send(&rpc.LibraryPrecompileResponse{TaskProgress: p}) },
+	)
+	if err != nil {
+		return convertErrorToRPCStatus(err)
+	}
+	return stream.Send(resp)
+}
+
+// LibraryVendor FIXMEDOC
+func (s *ArduinoCoreServerImpl) LibraryVendor(req *rpc.LibraryVendorRequest, stream rpc.ArduinoCoreService_LibraryVendorServer) error {
+	err := lib.LibraryVendor(
+		stream.Context(), req,
+		func(p *rpc.TaskProgress) { stream.Send(&rpc.LibraryVendorResponse{TaskProgress: p}) },
+	)
+	if err != nil {
+		return convertErrorToRPCStatus(err)
+	}
+	return stream.Send(&rpc.LibraryVendorResponse{})
+}
+
+// LibraryLint FIXMEDOC
+func (s *ArduinoCoreServerImpl) LibraryLint(ctx context.Context, req *rpc.LibraryLintRequest) (*rpc.LibraryLintResponse, error) {
+	resp, err := lib.LibraryLint(ctx, req)
+	return resp, convertErrorToRPCStatus(err)
+}
+
 // EnumerateMonitorPortSettings FIXMEDOC
 func (s *ArduinoCoreServerImpl) EnumerateMonitorPortSettings(ctx context.Context, req *rpc.EnumerateMonitorPortSettingsRequest) (*rpc.EnumerateMonitorPortSettingsResponse, error) {
 	resp, err := monitor.EnumerateMonitorPortSettings(ctx, req)
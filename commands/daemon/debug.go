@@ -47,6 +47,10 @@ func (s *DebugService) Debug(stream dbg.DebugService_DebugServer) error {
 		return errors.Errorf(tr("First message must contain debug request, not data"))
 	}
 
+	if msg.GetStructuredEvents() {
+		return s.debugWithEvents(stream, req)
+	}
+
 	// Launch debug recipe attaching stdin and out to grpc streaming
 	signalChan := make(chan os.Signal)
 	defer close(signalChan)
@@ -68,6 +72,29 @@ func (s *DebugService) Debug(stream dbg.DebugService_DebugServer) error {
 	return stream.Send(resp)
 }
 
+// debugWithEvents runs a debug session where the debugger output is sent
+// as structured events
+func (s *DebugService) debugWithEvents(stream dbg.DebugService_DebugServer, req *dbg.DebugConfigRequest) error {
+	requests := make(chan *dbg.DebugRequest)
+	go func() {
+		defer close(requests)
+		for {
+			msg, err := stream.Recv()
+			if err != nil {
+				return
+			}
+			requests <- msg
+		}
+	}()
+	resp, debugErr := cmd.DebugWithEvents(stream.Context(), req, requests, func(event *dbg.DebugEvent) error {
+		return stream.Send(&dbg.DebugResponse{Event: event})
+	})
+	if debugErr != nil {
+		return debugErr
+	}
+	return stream.Send(resp)
+}
+
 // GetDebugConfig return metadata about a debug session
 func (s *DebugService) GetDebugConfig(ctx context.Context, req *dbg.DebugConfigRequest) (*dbg.GetDebugConfigResponse, error) {
 	return cmd.GetDebugConfig(ctx, req)
@@ -0,0 +1,662 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package dap
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/arduino/arduino-cli/commands/debug/gdbmi"
+	"github.com/arduino/arduino-cli/i18n"
+	"github.com/sirupsen/logrus"
+)
+
+var tr = i18n.Tr
+
+// Launcher starts a debugger for the given launch arguments and returns a
+// stream connected to a GDB process running the MI2 interpreter. Closing the
+// stream must terminate the debugger. Anything written to stderr is forwarded
+// to the client as output.
+type Launcher func(args *LaunchArguments, stderr io.Writer) (io.ReadWriteCloser, error)
+
+// Server is a Debug Adapter Protocol server that translates DAP requests
+// coming from an editor into GDB/MI commands.
+type Server struct {
+	in       *bufio.Reader
+	out      io.Writer
+	launcher Launcher
+
+	outMutex sync.Mutex
+	seq      int
+
+	gdb         *gdbmi.Session
+	gdbConn     io.ReadWriteCloser
+	stopOnEntry bool
+
+	// All the following fields are guarded by handlesMutex
+	handlesMutex      sync.Mutex
+	exiting           bool
+	nextHandle        int
+	frames            map[int]*frameRef
+	variables         map[int]*variablesRef
+	varObjects        []string
+	sourceBreakpoints map[string][]string
+	funcBreakpoints   []string
+}
+
+type frameRef struct {
+	thread int
+	level  int
+}
+
+type variablesRef struct {
+	frame  *frameRef
+	varObj string
+}
+
+// NewServer creates a new DAP Server that reads requests from in and writes
+// responses and events to out. The launcher is used to start the debugger
+// when a "launch" or "attach" request is received.
+func NewServer(in io.Reader, out io.Writer, launcher Launcher) *Server {
+	return &Server{
+		in:                bufio.NewReader(in),
+		out:               out,
+		launcher:          launcher,
+		nextHandle:        1,
+		frames:            map[int]*frameRef{},
+		variables:         map[int]*variablesRef{},
+		sourceBreakpoints: map[string][]string{},
+	}
+}
+
+// Run serves DAP requests until the client disconnects or the input stream
+// is closed. The debugger, if started, is terminated before returning.
+func (s *Server) Run() error {
+	defer s.closeDebugger()
+	for {
+		data, err := ReadMessage(s.in)
+		if err == io.EOF {
+			return nil
+		} else if err != nil {
+			return err
+		}
+		var req Request
+		if err := json.Unmarshal(data, &req); err != nil {
+			return errors.New(tr("invalid DAP message: %v", err))
+		}
+		if req.Type != "request" {
+			logrus.Debugf("Ignoring DAP message of type %s", req.Type)
+			continue
+		}
+		logrus.Debugf("Received DAP request: %s %s", req.Command, req.Arguments)
+
+		body, err := s.handleRequest(&req)
+		s.sendResponse(&req, body, err)
+
+		switch req.Command {
+		case "launch", "attach":
+			if err == nil {
+				s.sendEvent("initialized", nil)
+			}
+		case "disconnect":
+			return nil
+		}
+	}
+}
+
+func (s *Server) handleRequest(req *Request) (interface{}, error) {
+	switch req.Command {
+	case "initialize":
+		return &Capabilities{
+			SupportsConfigurationDoneRequest: true,
+			SupportsFunctionBreakpoints:      true,
+			SupportsConditionalBreakpoints:   true,
+			SupportsEvaluateForHovers:        true,
+			SupportsTerminateRequest:         true,
+		}, nil
+	case "launch", "attach":
+		args := &LaunchArguments{}
+		if err := unmarshalArguments(req, args); err != nil {
+			return nil, err
+		}
+		return nil, s.launch(args)
+	case "disconnect", "terminate":
+		s.closeDebugger()
+		if req.Command == "terminate" {
+			s.sendEvent("terminated", nil)
+		}
+		return nil, nil
+	}
+
+	// All the other requests needs a running debugger
+	if s.gdb == nil {
+		return nil, errors.New(tr("debugger not started, a launch or attach request is required"))
+	}
+	switch req.Command {
+	case "configurationDone":
+		if s.stopOnEntry {
+			s.sendEvent("stopped", map[string]interface{}{"reason": "entry", "threadId": 1, "allThreadsStopped": true})
+			return nil, nil
+		}
+		return nil, s.resume("exec-continue")
+	case "setBreakpoints":
+		args := &SetBreakpointsArguments{}
+		if err := unmarshalArguments(req, args); err != nil {
+			return nil, err
+		}
+		return s.setBreakpoints(args)
+	case "setFunctionBreakpoints":
+		args := &SetFunctionBreakpointsArguments{}
+		if err := unmarshalArguments(req, args); err != nil {
+			return nil, err
+		}
+		return s.setFunctionBreakpoints(args)
+	case "setExceptionBreakpoints":
+		return map[string]interface{}{"breakpoints": []*Breakpoint{}}, nil
+	case "threads":
+		return s.threads()
+	case "stackTrace":
+		args := &stackTraceArguments{}
+		if err := unmarshalArguments(req, args); err != nil {
+			return nil, err
+		}
+		return s.stackTrace(args)
+	case "scopes":
+		args := &scopesArguments{}
+		if err := unmarshalArguments(req, args); err != nil {
+			return nil, err
+		}
+		return s.scopes(args)
+	case "variables":
+		args := &variablesArguments{}
+		if err := unmarshalArguments(req, args); err != nil {
+			return nil, err
+		}
+		return s.getVariables(args)
+	case "evaluate":
+		args := &evaluateArguments{}
+		if err := unmarshalArguments(req, args); err != nil {
+			return nil, err
+		}
+		return s.evaluate(args)
+	case "continue":
+		return map[string]interface{}{"allThreadsContinued": true}, s.resume("exec-continue")
+	case "next":
+		return nil, s.resume("exec-next")
+	case "stepIn":
+		return nil, s.resume("exec-step")
+	case "stepOut":
+		return nil, s.resume("exec-finish")
+	case "pause":
+		_, err := s.gdb.Send("exec-interrupt")
+		return nil, err
+	}
+	return nil, errors.New(tr("unsupported DAP request: %s", req.Command))
+}
+
+func unmarshalArguments(req *Request, args interface{}) error {
+	if len(req.Arguments) == 0 {
+		return nil
+	}
+	if err := json.Unmarshal(req.Arguments, args); err != nil {
+		return errors.New(tr("invalid arguments for '%[1]s': %[2]v", req.Command, err))
+	}
+	return nil
+}
+
+func (s *Server) send(msg interface{}) {
+	s.outMutex.Lock()
+	defer s.outMutex.Unlock()
+	s.seq++
+	switch m := msg.(type) {
+	case *Response:
+		m.Seq = s.seq
+	case *Event:
+		m.Seq = s.seq
+	}
+	if err := WriteMessage(s.out, msg); err != nil {
+		logrus.WithError(err).Error("Error sending DAP message")
+	}
+}
+
+func (s *Server) sendResponse(req *Request, body interface{}, err error) {
+	resp := &Response{
+		Type:       "response",
+		RequestSeq: req.Seq,
+		Command:    req.Command,
+		Success:    err == nil,
+		Body:       body,
+	}
+	if err != nil {
+		resp.Message = err.Error()
+		resp.Body = nil
+	}
+	s.send(resp)
+}
+
+func (s *Server) sendEvent(event string, body interface{}) {
+	s.send(&Event{Type: "event", Event: event, Body: body})
+}
+
+func (s *Server) sendOutput(category, text string) {
+	s.sendEvent("output", map[string]interface{}{"category": category, "output": text})
+}
+
+// outputWriter sends everything written to it as DAP output events
+type outputWriter struct {
+	server   *Server
+	category string
+}
+
+func (w *outputWriter) Write(p []byte) (int, error) {
+	w.server.sendOutput(w.category, string(p))
+	return len(p), nil
+}
+
+func (s *Server) launch(args *LaunchArguments) error {
+	if s.gdb != nil {
+		return errors.New(tr("debugger already started"))
+	}
+	conn, err := s.launcher(args, &outputWriter{server: s, category: "stderr"})
+	if err != nil {
+		return err
+	}
+	s.gdbConn = conn
+	s.stopOnEntry = args.StopOnEntry
+	s.gdb = gdbmi.NewSession(conn, conn, s.handleRecord)
+	go func() {
+		<-s.gdb.Done()
+		s.handlesMutex.Lock()
+		exiting := s.exiting
+		s.handlesMutex.Unlock()
+		if !exiting {
+			s.sendEvent("terminated", nil)
+		}
+	}()
+	return nil
+}
+
+func (s *Server) closeDebugger() {
+	s.handlesMutex.Lock()
+	exiting := s.exiting
+	s.exiting = true
+	s.handlesMutex.Unlock()
+	if s.gdb == nil || exiting {
+		return
+	}
+	// Give GDB a chance to detach gracefully before closing the streams
+	s.gdb.SetTimeout(2 * time.Second)
+	if _, err := s.gdb.Send("gdb-exit"); err != nil {
+		logrus.WithError(err).Debug("Error terminating GDB")
+	}
+	if err := s.gdbConn.Close(); err != nil {
+		logrus.WithError(err).Debug("Error closing GDB streams")
+	}
+}
+
+// handleRecord forwards the asynchronous GDB records to the DAP client.
+// It is called from the GDB reader goroutine so it must not send commands
+// to GDB.
+func (s *Server) handleRecord(rec *gdbmi.Record) {
+	switch rec.Type {
+	case gdbmi.ConsoleStreamRecord:
+		s.sendOutput("console", rec.Stream)
+	case gdbmi.TargetStreamRecord:
+		s.sendOutput("stdout", rec.Stream)
+	case gdbmi.LogStreamRecord:
+		s.sendOutput("stderr", rec.Stream)
+	case gdbmi.ExecAsyncRecord:
+		switch rec.Class {
+		case "stopped":
+			s.handleStopped(rec.Results)
+		case "running":
+			threadID, err := strconv.Atoi(rec.Results.String("thread-id"))
+			s.sendEvent("continued", map[string]interface{}{"threadId": threadID, "allThreadsContinued": err != nil})
+		}
+	case gdbmi.NotifyAsyncRecord:
+		switch rec.Class {
+		case "thread-created":
+			s.sendEvent("thread", map[string]interface{}{"reason": "started", "threadId": rec.Results.Int("id", 0)})
+		case "thread-exited":
+			s.sendEvent("thread", map[string]interface{}{"reason": "exited", "threadId": rec.Results.Int("id", 0)})
+		}
+	}
+}
+
+func (s *Server) handleStopped(results *gdbmi.Tuple) {
+	reason := results.String("reason")
+	if strings.HasPrefix(reason, "exited") {
+		s.sendEvent("exited", map[string]interface{}{"exitCode": results.Int("exit-code", 0)})
+		s.sendEvent("terminated", nil)
+		return
+	}
+
+	body := map[string]interface{}{
+		"threadId":          results.Int("thread-id", 1),
+		"allThreadsStopped": true,
+	}
+	switch reason {
+	case "breakpoint-hit", "watchpoint-trigger", "read-watchpoint-trigger", "access-watchpoint-trigger":
+		body["reason"] = "breakpoint"
+	case "end-stepping-range", "function-finished", "location-reached":
+		body["reason"] = "step"
+	case "signal-received":
+		if signal := results.String("signal-name"); signal == "SIGINT" || signal == "SIGTRAP" || signal == "0" {
+			body["reason"] = "pause"
+		} else {
+			body["reason"] = "exception"
+			body["description"] = results.String("signal-meaning")
+		}
+	default:
+		body["reason"] = "pause"
+	}
+	s.sendEvent("stopped", body)
+}
+
+// resume invalidates the references to frames and variables (they are valid
+// only while the target is stopped) and sends the given execution command.
+func (s *Server) resume(operation string) error {
+	s.handlesMutex.Lock()
+	varObjects := s.varObjects
+	s.varObjects = nil
+	s.frames = map[int]*frameRef{}
+	s.variables = map[int]*variablesRef{}
+	s.handlesMutex.Unlock()
+
+	for _, varObj := range varObjects {
+		if _, err := s.gdb.Send("var-delete", gdbmi.Quote(varObj)); err != nil {
+			logrus.WithError(err).Debug("Error deleting GDB variable object")
+		}
+	}
+	_, err := s.gdb.Send(operation)
+	return err
+}
+
+func (s *Server) newHandle() int {
+	h := s.nextHandle
+	s.nextHandle++
+	return h
+}
+
+func (s *Server) setBreakpoints(args *SetBreakpointsArguments) (interface{}, error) {
+	path := args.Source.Path
+	if path == "" {
+		path = args.Source.Name
+	}
+	if old := s.sourceBreakpoints[path]; len(old) > 0 {
+		if _, err := s.gdb.Send("break-delete", old...); err != nil {
+			return nil, err
+		}
+	}
+	s.sourceBreakpoints[path] = nil
+
+	res := []*Breakpoint{}
+	for _, bp := range args.Breakpoints {
+		location := gdbmi.Quote(fmt.Sprintf("%s:%d", path, bp.Line))
+		b := s.insertBreakpoint(location, bp.Condition)
+		if b.Verified {
+			s.sourceBreakpoints[path] = append(s.sourceBreakpoints[path], strconv.Itoa(b.ID))
+		}
+		if b.Line == 0 {
+			b.Line = bp.Line
+		}
+		res = append(res, b)
+	}
+	return map[string]interface{}{"breakpoints": res}, nil
+}
+
+func (s *Server) setFunctionBreakpoints(args *SetFunctionBreakpointsArguments) (interface{}, error) {
+	if len(s.funcBreakpoints) > 0 {
+		if _, err := s.gdb.Send("break-delete", s.funcBreakpoints...); err != nil {
+			return nil, err
+		}
+	}
+	s.funcBreakpoints = nil
+
+	res := []*Breakpoint{}
+	for _, bp := range args.Breakpoints {
+		b := s.insertBreakpoint(gdbmi.Quote(bp.Name), bp.Condition)
+		if b.Verified {
+			s.funcBreakpoints = append(s.funcBreakpoints, strconv.Itoa(b.ID))
+		}
+		res = append(res, b)
+	}
+	return map[string]interface{}{"breakpoints": res}, nil
+}
+
+func (s *Server) insertBreakpoint(location, condition string) *Breakpoint {
+	args := []string{"-f"}
+	if condition != "" {
+		args = append(args, "-c", gdbmi.Quote(condition))
+	}
+	args = append(args, location)
+	rec, err := s.gdb.Send("break-insert", args...)
+	if err != nil {
+		return &Breakpoint{Verified: false, Message: err.Error()}
+	}
+	bkpt := rec.Results.Tuple("bkpt")
+	if locations := bkpt.List("locations"); bkpt.String("line") == "" && len(locations) > 0 {
+		// Multi-location breakpoint: report the first location
+		if location, ok := locations[0].(*gdbmi.Tuple); ok {
+			bkpt.Set("line", location.String("line"))
+			bkpt.Set("file", location.String("file"))
+			bkpt.Set("fullname", location.String("fullname"))
+		}
+	}
+	b := &Breakpoint{
+		ID:       bkpt.Int("number", 0),
+		Verified: true,
+		Line:     bkpt.Int("line", 0),
+	}
+	if fullname := bkpt.String("fullname"); fullname != "" {
+		b.Source = &Source{Name: bkpt.String("file"), Path: fullname}
+	}
+	return b
+}
+
+func (s *Server) threads() (interface{}, error) {
+	rec, err := s.gdb.Send("thread-info")
+	if err != nil {
+		return nil, err
+	}
+	threads := []*Thread{}
+	for _, t := range rec.Results.List("threads") {
+		thread, ok := t.(*gdbmi.Tuple)
+		if !ok {
+			continue
+		}
+		name := thread.String("name")
+		if name == "" {
+			name = thread.String("target-id")
+		}
+		threads = append(threads, &Thread{ID: thread.Int("id", 0), Name: name})
+	}
+	if len(threads) == 0 {
+		// Bare metal targets may not report any thread
+		threads = append(threads, &Thread{ID: 1, Name: "main"})
+	}
+	return map[string]interface{}{"threads": threads}, nil
+}
+
+func (s *Server) stackTrace(args *stackTraceArguments) (interface{}, error) {
+	threadID := args.ThreadID
+	if threadID == 0 {
+		threadID = 1
+	}
+	cmdArgs := []string{"--thread", strconv.Itoa(threadID)}
+	if args.Levels > 0 {
+		cmdArgs = append(cmdArgs, strconv.Itoa(args.StartFrame), strconv.Itoa(args.StartFrame+args.Levels-1))
+	}
+	rec, err := s.gdb.Send("stack-list-frames", cmdArgs...)
+	if err != nil {
+		return nil, err
+	}
+
+	s.handlesMutex.Lock()
+	defer s.handlesMutex.Unlock()
+	frames := []*StackFrame{}
+	for _, f := range rec.Results.List("stack") {
+		frame, ok := f.(*gdbmi.Tuple)
+		if !ok {
+			continue
+		}
+		id := s.newHandle()
+		s.frames[id] = &frameRef{thread: threadID, level: frame.Int("level", 0)}
+		stackFrame := &StackFrame{
+			ID:                          id,
+			Name:                        frame.String("func"),
+			Line:                        frame.Int("line", 0),
+			Column:                      0,
+			InstructionPointerReference: frame.String("addr"),
+		}
+		if stackFrame.Name == "" {
+			stackFrame.Name = frame.String("addr")
+		}
+		if fullname := frame.String("fullname"); fullname != "" {
+			stackFrame.Source = &Source{Name: frame.String("file"), Path: fullname}
+		}
+		frames = append(frames, stackFrame)
+	}
+	return map[string]interface{}{"stackFrames": frames, "totalFrames": len(frames)}, nil
+}
+
+func (s *Server) scopes(args *scopesArguments) (interface{}, error) {
+	s.handlesMutex.Lock()
+	defer s.handlesMutex.Unlock()
+	frame, ok := s.frames[args.FrameID]
+	if !ok {
+		return nil, errors.New(tr("invalid frame id %d", args.FrameID))
+	}
+	id := s.newHandle()
+	s.variables[id] = &variablesRef{frame: frame}
+	return map[string]interface{}{
+		"scopes": []*Scope{{Name: tr("Locals"), VariablesReference: id}},
+	}, nil
+}
+
+func (s *Server) getVariables(args *variablesArguments) (interface{}, error) {
+	s.handlesMutex.Lock()
+	ref, ok := s.variables[args.VariablesReference]
+	s.handlesMutex.Unlock()
+	if !ok {
+		return nil, errors.New(tr("invalid variables reference %d", args.VariablesReference))
+	}
+
+	vars := []*Variable{}
+	if ref.varObj == "" {
+		// List the local variables of the frame
+		rec, err := s.gdb.Send("stack-list-variables",
+			"--thread", strconv.Itoa(ref.frame.thread),
+			"--frame", strconv.Itoa(ref.frame.level),
+			"--simple-values")
+		if err != nil {
+			return nil, err
+		}
+		for _, v := range rec.Results.List("variables") {
+			variable, ok := v.(*gdbmi.Tuple)
+			if !ok {
+				continue
+			}
+			res := &Variable{
+				Name:  variable.String("name"),
+				Type:  variable.String("type"),
+				Value: variable.String("value"),
+			}
+			if variable.Get("value") == nil {
+				// Aggregate types have no simple value: create a variable object
+				// to allow the client to expand it
+				if err := s.createVarObject(ref.frame, res); err != nil {
+					res.Value = err.Error()
+				}
+			}
+			vars = append(vars, res)
+		}
+	} else {
+		rec, err := s.gdb.Send("var-list-children", "--simple-values", gdbmi.Quote(ref.varObj))
+		if err != nil {
+			return nil, err
+		}
+		for _, c := range rec.Results.List("children") {
+			child, ok := c.(*gdbmi.Tuple)
+			if !ok {
+				continue
+			}
+			res := &Variable{
+				Name:  child.String("exp"),
+				Type:  child.String("type"),
+				Value: child.String("value"),
+			}
+			if child.Int("numchild", 0) > 0 {
+				res.VariablesReference = s.addVariablesRef(ref.frame, child.String("name"))
+			}
+			vars = append(vars, res)
+		}
+	}
+	return map[string]interface{}{"variables": vars}, nil
+}
+
+func (s *Server) createVarObject(frame *frameRef, v *Variable) error {
+	rec, err := s.gdb.Send("var-create",
+		"--thread", strconv.Itoa(frame.thread),
+		"--frame", strconv.Itoa(frame.level),
+		"-", "*", gdbmi.Quote(v.Name))
+	if err != nil {
+		return err
+	}
+	varObj := rec.Results.String("name")
+	s.handlesMutex.Lock()
+	s.varObjects = append(s.varObjects, varObj)
+	s.handlesMutex.Unlock()
+	v.Value = rec.Results.String("value")
+	if v.Type == "" {
+		v.Type = rec.Results.String("type")
+	}
+	if rec.Results.Int("numchild", 0) > 0 {
+		v.VariablesReference = s.addVariablesRef(frame, varObj)
+	}
+	return nil
+}
+
+func (s *Server) addVariablesRef(frame *frameRef, varObj string) int {
+	s.handlesMutex.Lock()
+	defer s.handlesMutex.Unlock()
+	id := s.newHandle()
+	s.variables[id] = &variablesRef{frame: frame, varObj: varObj}
+	return id
+}
+
+func (s *Server) evaluate(args *evaluateArguments) (interface{}, error) {
+	cmdArgs := []string{}
+	s.handlesMutex.Lock()
+	frame, ok := s.frames[args.FrameID]
+	s.handlesMutex.Unlock()
+	if ok {
+		cmdArgs = append(cmdArgs, "--thread", strconv.Itoa(frame.thread), "--frame", strconv.Itoa(frame.level))
+	}
+	cmdArgs = append(cmdArgs, gdbmi.Quote(args.Expression))
+	rec, err := s.gdb.Send("data-evaluate-expression", cmdArgs...)
+	if err != nil {
+		return nil, err
+	}
+	return map[string]interface{}{"result": rec.Results.String("value"), "variablesReference": 0}, nil
+}
@@ -0,0 +1,322 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package dap
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// mockGDB is a fake GDB process speaking GDB/MI. It answers every command with
+// the canned response found in the responses map (by operation name).
+type mockGDB struct {
+	stdinR  *io.PipeReader
+	stdinW  *io.PipeWriter
+	stdoutR *io.PipeReader
+	stdoutW *io.PipeWriter
+
+	mutex     sync.Mutex
+	commands  []string
+	responses map[string]string
+}
+
+func newMockGDB(responses map[string]string) *mockGDB {
+	m := &mockGDB{responses: responses}
+	m.stdinR, m.stdinW = io.Pipe()
+	m.stdoutR, m.stdoutW = io.Pipe()
+	go m.run()
+	return m
+}
+
+func (m *mockGDB) run() {
+	scanner := bufio.NewScanner(m.stdinR)
+	for scanner.Scan() {
+		line := scanner.Text()
+		dash := strings.Index(line, "-")
+		token, command := line[:dash], line[dash+1:]
+		operation := strings.SplitN(command, " ", 2)[0]
+		m.mutex.Lock()
+		m.commands = append(m.commands, command)
+		m.mutex.Unlock()
+
+		if operation == "gdb-exit" {
+			fmt.Fprintf(m.stdoutW, "%s^exit\n", token)
+			m.stdoutW.Close()
+			return
+		}
+		resp, ok := m.responses[operation]
+		if !ok {
+			resp = "^done"
+		}
+		lines := strings.Split(resp, "\n")
+		// Prepend the token to the result record
+		for i, l := range lines {
+			if strings.HasPrefix(l, "^") {
+				lines[i] = token + l
+			}
+		}
+		fmt.Fprintf(m.stdoutW, "%s\n(gdb)\n", strings.Join(lines, "\n"))
+	}
+}
+
+func (m *mockGDB) Read(p []byte) (int, error)  { return m.stdoutR.Read(p) }
+func (m *mockGDB) Write(p []byte) (int, error) { return m.stdinW.Write(p) }
+func (m *mockGDB) Close() error {
+	m.stdinW.Close()
+	m.stdoutW.Close()
+	return nil
+}
+
+func (m *mockGDB) Commands() []string {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	return append([]string{}, m.commands...)
+}
+
+// dapClient drives a Server through pipes
+type dapClient struct {
+	t        *testing.T
+	toServer *io.PipeWriter
+	messages chan map[string]interface{}
+	events   []map[string]interface{}
+	seq      int
+}
+
+func newDapClient(t *testing.T, launcher Launcher) (*dapClient, chan error) {
+	serverIn, toServer := io.Pipe()
+	fromServer, serverOut := io.Pipe()
+	c := &dapClient{t: t, toServer: toServer, messages: make(chan map[string]interface{}, 100)}
+	go func() {
+		in := bufio.NewReader(fromServer)
+		for {
+			data, err := ReadMessage(in)
+			if err != nil {
+				close(c.messages)
+				return
+			}
+			var msg map[string]interface{}
+			require.NoError(t, json.Unmarshal(data, &msg))
+			c.messages <- msg
+		}
+	}()
+	done := make(chan error, 1)
+	go func() {
+		done <- NewServer(serverIn, serverOut, launcher).Run()
+		serverOut.Close()
+	}()
+	return c, done
+}
+
+func (c *dapClient) request(command string, args interface{}) map[string]interface{} {
+	c.seq++
+	req := map[string]interface{}{"seq": c.seq, "type": "request", "command": command}
+	if args != nil {
+		req["arguments"] = args
+	}
+	require.NoError(c.t, WriteMessage(c.toServer, req))
+	for {
+		msg := c.next()
+		if msg["type"] != "response" {
+			// Events may be sent before the response, keep them for waitEvent
+			c.events = append(c.events, msg)
+			continue
+		}
+		require.Equal(c.t, float64(c.seq), msg["request_seq"])
+		require.Equal(c.t, command, msg["command"])
+		return msg
+	}
+}
+
+func (c *dapClient) next() map[string]interface{} {
+	select {
+	case msg, ok := <-c.messages:
+		require.True(c.t, ok, "DAP stream closed")
+		return msg
+	case <-time.After(5 * time.Second):
+		require.FailNow(c.t, "timeout waiting for DAP message")
+	}
+	return nil
+}
+
+func (c *dapClient) waitEvent(event string) map[string]interface{} {
+	for {
+		var msg map[string]interface{}
+		if len(c.events) > 0 {
+			msg, c.events = c.events[0], c.events[1:]
+		} else {
+			msg = c.next()
+		}
+		if msg["type"] == "event" && msg["event"] == event {
+			return msg
+		}
+	}
+}
+
+func body(msg map[string]interface{}) map[string]interface{} {
+	return msg["body"].(map[string]interface{})
+}
+
+func TestDebugSession(t *testing.T) {
+	gdb := newMockGDB(map[string]string{
+		"break-insert":             `^done,bkpt={number="1",type="breakpoint",file="Blink.ino",fullname="/tmp/Blink/Blink.ino",line="31"}`,
+		"exec-continue":            "^running\n*running,thread-id=\"all\"\n*stopped,reason=\"breakpoint-hit\",bkptno=\"1\",frame={func=\"loop\"},thread-id=\"1\"",
+		"exec-next":                "^running\n*running,thread-id=\"all\"\n*stopped,reason=\"end-stepping-range\",thread-id=\"1\"",
+		"thread-info":              `^done,threads=[{id="1",target-id="Remote target",frame={level="0",func="loop"},state="stopped"}],current-thread-id="1"`,
+		"stack-list-frames":        `^done,stack=[frame={level="0",addr="0x00002154",func="loop",file="Blink.ino",fullname="/tmp/Blink/Blink.ino",line="31"},frame={level="1",addr="0x00002200",func="main",file="main.cpp",fullname="/tmp/core/main.cpp",line="50"}]`,
+		"stack-list-variables":     `^done,variables=[{name="counter",type="int",value="42"},{name="led",type="Led"}]`,
+		"var-create":               `^done,name="var1",numchild="2",value="{...}",type="Led",has_more="0"`,
+		"var-list-children":        `^done,numchild="2",children=[child={name="var1.pin",exp="pin",numchild="0",value="13",type="int"},child={name="var1.on",exp="on",numchild="0",value="true",type="bool"}],has_more="0"`,
+		"data-evaluate-expression": `^done,value="84"`,
+	})
+	var launchArgs *LaunchArguments
+	client, done := newDapClient(t, func(args *LaunchArguments, stderr io.Writer) (io.ReadWriteCloser, error) {
+		launchArgs = args
+		return gdb, nil
+	})
+
+	resp := client.request("initialize", map[string]interface{}{"adapterID": "arduino"})
+	require.Equal(t, true, resp["success"])
+	require.Equal(t, true, body(resp)["supportsConfigurationDoneRequest"])
+
+	// Requests needing a debugger fail before launch
+	resp = client.request("threads", nil)
+	require.Equal(t, false, resp["success"])
+
+	resp = client.request("launch", map[string]interface{}{"fqbn": "arduino:samd:mkr1000", "sketchPath": "/tmp/Blink"})
+	require.Equal(t, true, resp["success"])
+	require.Equal(t, "arduino:samd:mkr1000", launchArgs.Fqbn)
+	require.Equal(t, "/tmp/Blink", launchArgs.SketchPath)
+	client.waitEvent("initialized")
+
+	resp = client.request("setBreakpoints", map[string]interface{}{
+		"source":      map[string]interface{}{"path": "/tmp/Blink/Blink.ino"},
+		"breakpoints": []interface{}{map[string]interface{}{"line": 31}},
+	})
+	require.Equal(t, true, resp["success"])
+	bps := body(resp)["breakpoints"].([]interface{})
+	require.Len(t, bps, 1)
+	require.Equal(t, true, bps[0].(map[string]interface{})["verified"])
+	require.Equal(t, float64(31), bps[0].(map[string]interface{})["line"])
+
+	resp = client.request("configurationDone", nil)
+	require.Equal(t, true, resp["success"])
+	stopped := client.waitEvent("stopped")
+	require.Equal(t, "breakpoint", body(stopped)["reason"])
+	require.Equal(t, float64(1), body(stopped)["threadId"])
+
+	resp = client.request("threads", nil)
+	threads := body(resp)["threads"].([]interface{})
+	require.Len(t, threads, 1)
+	require.Equal(t, "Remote target", threads[0].(map[string]interface{})["name"])
+
+	resp = client.request("stackTrace", map[string]interface{}{"threadId": 1})
+	frames := body(resp)["stackFrames"].([]interface{})
+	require.Len(t, frames, 2)
+	frame := frames[0].(map[string]interface{})
+	require.Equal(t, "loop", frame["name"])
+	require.Equal(t, float64(31), frame["line"])
+	require.Equal(t, "/tmp/Blink/Blink.ino", frame["source"].(map[string]interface{})["path"])
+
+	resp = client.request("scopes", map[string]interface{}{"frameId": frame["id"]})
+	scopes := body(resp)["scopes"].([]interface{})
+	require.Len(t, scopes, 1)
+	localsRef := scopes[0].(map[string]interface{})["variablesReference"]
+
+	resp = client.request("variables", map[string]interface{}{"variablesReference": localsRef})
+	vars := body(resp)["variables"].([]interface{})
+	require.Len(t, vars, 2)
+	require.Equal(t, "counter", vars[0].(map[string]interface{})["name"])
+	require.Equal(t, "42", vars[0].(map[string]interface{})["value"])
+	require.Equal(t, float64(0), vars[0].(map[string]interface{})["variablesReference"])
+	led := vars[1].(map[string]interface{})
+	require.Equal(t, "led", led["name"])
+	require.NotEqual(t, float64(0), led["variablesReference"])
+
+	resp = client.request("variables", map[string]interface{}{"variablesReference": led["variablesReference"]})
+	children := body(resp)["variables"].([]interface{})
+	require.Len(t, children, 2)
+	require.Equal(t, "pin", children[0].(map[string]interface{})["name"])
+	require.Equal(t, "13", children[0].(map[string]interface{})["value"])
+
+	resp = client.request("evaluate", map[string]interface{}{"expression": "counter * 2", "frameId": frame["id"]})
+	require.Equal(t, "84", body(resp)["result"])
+
+	resp = client.request("next", map[string]interface{}{"threadId": 1})
+	require.Equal(t, true, resp["success"])
+	stopped = client.waitEvent("stopped")
+	require.Equal(t, "step", body(stopped)["reason"])
+
+	// Frame references are invalidated after resuming the target
+	resp = client.request("scopes", map[string]interface{}{"frameId": frame["id"]})
+	require.Equal(t, false, resp["success"])
+
+	resp = client.request("disconnect", nil)
+	require.Equal(t, true, resp["success"])
+	require.NoError(t, <-done)
+
+	require.Equal(t, []string{
+		`break-insert -f "/tmp/Blink/Blink.ino:31"`,
+		`exec-continue`,
+		`thread-info`,
+		`stack-list-frames --thread 1`,
+		`stack-list-variables --thread 1 --frame 0 --simple-values`,
+		`var-create --thread 1 --frame 0 - * "led"`,
+		`var-list-children --simple-values "var1"`,
+		`data-evaluate-expression --thread 1 --frame 0 "counter * 2"`,
+		`var-delete "var1"`,
+		`exec-next`,
+		`gdb-exit`,
+	}, gdb.Commands())
+}
+
+func TestDebuggerTermination(t *testing.T) {
+	gdb := newMockGDB(map[string]string{
+		"exec-continue": "^running\n~\"Hello\\n\"\n*stopped,reason=\"exited-normally\"",
+	})
+	client, done := newDapClient(t, func(args *LaunchArguments, stderr io.Writer) (io.ReadWriteCloser, error) {
+		return gdb, nil
+	})
+	client.request("initialize", nil)
+	resp := client.request("launch", map[string]interface{}{"stopOnEntry": true})
+	require.Equal(t, true, resp["success"])
+
+	// With stopOnEntry the target is not resumed at configuration end
+	client.request("configurationDone", nil)
+	stopped := client.waitEvent("stopped")
+	require.Equal(t, "entry", body(stopped)["reason"])
+
+	client.request("continue", map[string]interface{}{"threadId": 1})
+	output := client.waitEvent("output")
+	require.Equal(t, "Hello\n", body(output)["output"])
+	require.Equal(t, "console", body(output)["category"])
+	client.waitEvent("exited")
+	client.waitEvent("terminated")
+
+	// GDB process dies
+	gdb.Close()
+	client.waitEvent("terminated")
+
+	client.toServer.Close()
+	require.NoError(t, <-done)
+}
@@ -19,9 +19,8 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"net"
 	"os"
-	"path/filepath"
-	"runtime"
 	"time"
 
 	"github.com/arduino/arduino-cli/arduino"
@@ -30,7 +29,6 @@ import (
 	"github.com/arduino/arduino-cli/executils"
 	"github.com/arduino/arduino-cli/i18n"
 	dbg "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/debug/v1"
-	"github.com/arduino/go-paths-helper"
 	"github.com/sirupsen/logrus"
 )
 
@@ -46,10 +44,18 @@ func Debug(ctx context.Context, req *dbg.DebugConfigRequest, inStream io.Reader,
 
 	// Get debugging command line to run debugger
 	pm := commands.GetPackageManager(req.GetInstance().GetId())
-	commandLine, err := getCommandLine(req, pm)
+	session, err := getDebugSessionForRequest(req, pm, false)
 	if err != nil {
 		return nil, err
 	}
+	commandLine := session.Client
+
+	// Start the GDB server, if not started by the debugger itself
+	stopServer, err := startDebugServer(pm, session, out)
+	if err != nil {
+		return nil, err
+	}
+	defer stopServer()
 
 	for i, arg := range commandLine {
 		fmt.Printf("%2d: %s\n", i, arg)
@@ -113,74 +119,67 @@ func Debug(ctx context.Context, req *dbg.DebugConfigRequest, inStream io.Reader,
 
 // getCommandLine compose a debug command represented by a core recipe
 func getCommandLine(req *dbg.DebugConfigRequest, pm *packagemanager.PackageManager) ([]string, error) {
+	session, err := getDebugSessionForRequest(req, pm, false)
+	if err != nil {
+		return nil, err
+	}
+	return session.Client, nil
+}
+
+func getDebugSessionForRequest(req *dbg.DebugConfigRequest, pm *packagemanager.PackageManager, async bool) (*debugSession, error) {
 	debugInfo, err := getDebugProperties(req, pm)
 	if err != nil {
 		return nil, err
 	}
+	return getDebugSession(debugInfo, req.GetInterpreter(), async)
+}
 
-	cmdArgs := []string{}
-	add := func(s string) { cmdArgs = append(cmdArgs, s) }
+// startDebugServer starts the GDB server of the given session, if needed, and
+// waits until it's ready to accept connections from the client. The returned
+// function must be called to stop the server.
+func startDebugServer(pm *packagemanager.PackageManager, session *debugSession, out io.Writer) (func(), error) {
+	if len(session.Server) == 0 {
+		return func() {}, nil
+	}
 
-	// Add path to GDB Client to command line
-	var gdbPath *paths.Path
-	switch debugInfo.GetToolchain() {
-	case "gcc":
-		gdbexecutable := debugInfo.ToolchainPrefix + "gdb"
-		if runtime.GOOS == "windows" {
-			gdbexecutable += ".exe"
-		}
-		gdbPath = paths.New(debugInfo.ToolchainPath).Join(gdbexecutable)
-	default:
-		return nil, &arduino.FailedDebugError{Message: tr("Toolchain '%s' is not supported", debugInfo.GetToolchain())}
+	entry := logrus.NewEntry(logrus.StandardLogger())
+	for i, param := range session.Server {
+		entry = entry.WithField(fmt.Sprintf("param%d", i), param)
 	}
-	add(gdbPath.String())
+	entry.Debug("Executing GDB server")
 
-	// Set GDB interpreter (default value should be "console")
-	gdbInterpreter := req.GetInterpreter()
-	if gdbInterpreter == "" {
-		gdbInterpreter = "console"
+	server, err := executils.NewProcess(pm.GetEnvVarsForSpawnedProcess(), session.Server...)
+	if err != nil {
+		return nil, &arduino.FailedDebugError{Message: tr("Cannot execute GDB server"), Cause: err}
 	}
-	add("--interpreter=" + gdbInterpreter)
-	if gdbInterpreter != "console" {
-		add("-ex")
-		add("set pagination off")
+	server.RedirectStdoutTo(out)
+	server.RedirectStderrTo(out)
+	if err := server.Start(); err != nil {
+		return nil, &arduino.FailedDebugError{Message: tr("Cannot execute GDB server"), Cause: err}
+	}
+	exited := make(chan error, 1)
+	go func() { exited <- server.Wait() }()
+	stop := func() {
+		server.Kill()
+		<-exited
 	}
 
-	// Add extra GDB execution commands
-	add("-ex")
-	add("set remotetimeout 5")
-
-	// Extract path to GDB Server
-	switch debugInfo.GetServer() {
-	case "openocd":
-		serverCmd := fmt.Sprintf(`target extended-remote | "%s"`, debugInfo.ServerPath)
-
-		if cfg := debugInfo.ServerConfiguration["scripts_dir"]; cfg != "" {
-			serverCmd += fmt.Sprintf(` -s "%s"`, cfg)
+	// Wait for the server to listen on its port
+	deadline := time.Now().Add(10 * time.Second)
+	for {
+		if conn, err := net.DialTimeout("tcp", session.ServerAddress, time.Second); err == nil {
+			conn.Close()
+			return stop, nil
 		}
-
-		if script := debugInfo.ServerConfiguration["script"]; script != "" {
-			serverCmd += fmt.Sprintf(` --file "%s"`, script)
+		select {
+		case err := <-exited:
+			exited <- err
+			return nil, &arduino.FailedDebugError{Message: tr("GDB server terminated unexpectedly"), Cause: err}
+		case <-time.After(200 * time.Millisecond):
+		}
+		if time.Now().After(deadline) {
+			stop()
+			return nil, &arduino.FailedDebugError{Message: tr("Timeout waiting for GDB server on %s", session.ServerAddress)}
 		}
-
-		serverCmd += ` -c "gdb_port pipe"`
-		serverCmd += ` -c "telnet_port 0"`
-
-		add("-ex")
-		add(serverCmd)
-
-	default:
-		return nil, &arduino.FailedDebugError{Message: tr("GDB server '%s' is not supported", debugInfo.GetServer())}
-	}
-
-	// Add executable
-	add(debugInfo.Executable)
-
-	// Transform every path to forward slashes (on Windows some tools further
-	// escapes the command line so the backslash "\" gets in the way).
-	for i, param := range cmdArgs {
-		cmdArgs[i] = filepath.ToSlash(param)
 	}
-
-	return cmdArgs, nil
 }
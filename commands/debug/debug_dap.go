@@ -0,0 +1,136 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package debug
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/arduino/arduino-cli/arduino"
+	"github.com/arduino/arduino-cli/commands"
+	"github.com/arduino/arduino-cli/commands/debug/dap"
+	"github.com/arduino/arduino-cli/executils"
+	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
+	dbg "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/debug/v1"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/protobuf/proto"
+)
+
+// DebugDAP runs a Debug Adapter Protocol server on the given streams.
+// The debugger is started when the client sends a "launch" or "attach" request:
+// the debug configuration is resolved in the same way as GetDebugConfig using
+// req, eventually overridden by the arguments of the request. The function
+// returns when the client disconnects.
+func DebugDAP(ctx context.Context, req *dbg.DebugConfigRequest, in io.Reader, out io.Writer) error {
+	pm := commands.GetPackageManager(req.GetInstance().GetId())
+	if pm == nil {
+		return &arduino.InvalidInstanceError{}
+	}
+
+	launcher := func(args *dap.LaunchArguments, stderr io.Writer) (io.ReadWriteCloser, error) {
+		launchReq := proto.Clone(req).(*dbg.DebugConfigRequest)
+		if args.Fqbn != "" {
+			launchReq.Fqbn = args.Fqbn
+		}
+		if args.SketchPath != "" {
+			launchReq.SketchPath = args.SketchPath
+		}
+		if args.ImportDir != "" {
+			launchReq.ImportDir = args.ImportDir
+		}
+		if args.Port != "" {
+			launchReq.Port = &rpc.Port{Address: args.Port}
+		}
+		if args.Programmer != "" {
+			launchReq.Programmer = args.Programmer
+		}
+		launchReq.Interpreter = "mi2"
+
+		// Enable asynchronous execution to allow interrupting the target while
+		// it's running
+		session, err := getDebugSessionForRequest(launchReq, pm, true)
+		if err != nil {
+			return nil, err
+		}
+		commandLine := session.Client
+
+		entry := logrus.NewEntry(logrus.StandardLogger())
+		for i, param := range commandLine {
+			entry = entry.WithField(fmt.Sprintf("param%d", i), param)
+		}
+		entry.Debug("Executing debugger for DAP session")
+
+		cmd, err := executils.NewProcess(pm.GetEnvVarsForSpawnedProcess(), commandLine...)
+		if err != nil {
+			return nil, &arduino.FailedDebugError{Message: tr("Cannot execute debug tool"), Cause: err}
+		}
+		stdin, err := cmd.StdinPipe()
+		if err != nil {
+			return nil, &arduino.FailedDebugError{Message: tr("Cannot execute debug tool"), Cause: err}
+		}
+		stdout, err := cmd.StdoutPipe()
+		if err != nil {
+			return nil, &arduino.FailedDebugError{Message: tr("Cannot execute debug tool"), Cause: err}
+		}
+		cmd.RedirectStderrTo(stderr)
+
+		// Start the GDB server, if not started by the debugger itself
+		stopServer, err := startDebugServer(pm, session, stderr)
+		if err != nil {
+			return nil, err
+		}
+		if err := cmd.Start(); err != nil {
+			stopServer()
+			return nil, &arduino.FailedDebugError{Message: tr("Cannot execute debug tool"), Cause: err}
+		}
+		return &debuggerProcess{cmd: cmd, stdin: stdin, stdout: stdout, stopServer: stopServer}, nil
+	}
+
+	return dap.NewServer(in, out, launcher).Run()
+}
+
+// debuggerProcess exposes the MI streams of a running GDB process
+type debuggerProcess struct {
+	cmd        *executils.Process
+	stdin      io.WriteCloser
+	stdout     io.Reader
+	stopServer func()
+}
+
+func (p *debuggerProcess) Read(data []byte) (int, error) {
+	return p.stdout.Read(data)
+}
+
+func (p *debuggerProcess) Write(data []byte) (int, error) {
+	return p.stdin.Write(data)
+}
+
+func (p *debuggerProcess) Close() error {
+	p.stdin.Close()
+	exited := make(chan error, 1)
+	go func() { exited <- p.cmd.Wait() }()
+	select {
+	case <-exited:
+	case <-time.After(time.Second):
+		// Avoid leaving zombie processes around
+		p.cmd.Kill()
+		<-exited
+	}
+	p.stopServer()
+	return nil
+}
@@ -0,0 +1,309 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package debug
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/arduino/arduino-cli/arduino"
+	"github.com/arduino/arduino-cli/commands"
+	"github.com/arduino/arduino-cli/commands/debug/gdbmi"
+	"github.com/arduino/arduino-cli/executils"
+	dbg "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/debug/v1"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// DebugWithEvents launches a debug tool for a sketch using the GDB/MI
+// interpreter and translates its output into structured DebugEvents.
+// The requests channel receives the messages sent by the client after the
+// first one: raw data is sent as-is to the debugger, structured commands are
+// translated into GDB/MI commands. The debug session ends when the requests
+// channel is closed, the debugger terminates or an event can't be sent, in
+// the latter case the error returned by events is returned.
+func DebugWithEvents(ctx context.Context, req *dbg.DebugConfigRequest, requests <-chan *dbg.DebugRequest, events func(*dbg.DebugEvent) error) (*dbg.DebugResponse, error) {
+	pm := commands.GetPackageManager(req.GetInstance().GetId())
+	if pm == nil {
+		return nil, &arduino.InvalidInstanceError{}
+	}
+
+	req = proto.Clone(req).(*dbg.DebugConfigRequest)
+	if !isMIInterpreter(req.GetInterpreter()) {
+		req.Interpreter = "mi2"
+	}
+	session, err := getDebugSessionForRequest(req, pm, false)
+	if err != nil {
+		return nil, err
+	}
+	commandLine := session.Client
+
+	entry := logrus.NewEntry(logrus.StandardLogger())
+	for i, param := range commandLine {
+		entry = entry.WithField(fmt.Sprintf("param%d", i), param)
+	}
+	entry.Debug("Executing debugger with structured events")
+
+	cmd, err := executils.NewProcess(pm.GetEnvVarsForSpawnedProcess(), commandLine...)
+	if err != nil {
+		return nil, &arduino.FailedDebugError{Message: tr("Cannot execute debug tool"), Cause: err}
+	}
+	stdin, err := cmd.StdinPipe()
+	if err != nil {
+		return &dbg.DebugResponse{Error: err.Error()}, nil
+	}
+	defer stdin.Close()
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		return &dbg.DebugResponse{Error: err.Error()}, nil
+	}
+
+	// gRPC streams can't be used concurrently: serialize the events. If an
+	// event can't be sent the client is gone and the debugger is terminated.
+	var eventsMutex sync.Mutex
+	var eventsErr error
+	sendEvent := func(event *dbg.DebugEvent) {
+		eventsMutex.Lock()
+		defer eventsMutex.Unlock()
+		if eventsErr != nil {
+			return
+		}
+		if err := events(event); err != nil {
+			eventsErr = err
+			cmd.Kill()
+		}
+	}
+	logWriter := &streamWriter{streamType: dbg.DebugStreamType_DEBUG_STREAM_TYPE_LOG, send: sendEvent}
+	cmd.RedirectStderrTo(logWriter)
+
+	// Start the GDB server, if not started by the debugger itself
+	stopServer, err := startDebugServer(pm, session, logWriter)
+	if err != nil {
+		return nil, err
+	}
+	defer stopServer()
+
+	if err := cmd.Start(); err != nil {
+		return &dbg.DebugResponse{Error: err.Error()}, nil
+	}
+
+	// Raw data and the session commands share the debugger input
+	in := &lockedWriter{w: stdin}
+	mi := gdbmi.NewSession(stdout, in, func(rec *gdbmi.Record) {
+		sendEvent(recordToEvent(rec, 0))
+	})
+
+	go func() {
+		for request := range requests {
+			if request.GetSendInterrupt() {
+				cmd.Signal(os.Interrupt)
+			}
+			if data := request.GetData(); len(data) > 0 {
+				if _, err := in.Write(data); err != nil {
+					logrus.WithError(err).Error("Error sending data to debugger")
+				}
+			}
+			if command := request.GetCommand(); command != nil {
+				operation, args, err := translateCommand(command)
+				if err != nil {
+					sendEvent(&dbg.DebugEvent{Event: &dbg.DebugEvent_Result{Result: &dbg.DebugResultRecord{
+						CommandId:   command.GetCommandId(),
+						ResultClass: "error",
+						Results:     &structpb.Struct{Fields: map[string]*structpb.Value{"msg": structpb.NewStringValue(err.Error())}},
+					}}})
+					continue
+				}
+				go func() {
+					rec, err := mi.Send(operation, args...)
+					if rec == nil {
+						// The session has been terminated or timed out
+						results := gdbmi.NewTuple()
+						results.Set("msg", err.Error())
+						rec = &gdbmi.Record{Type: gdbmi.ResultRecord, Class: "error", Results: results}
+					}
+					sendEvent(recordToEvent(rec, command.GetCommandId()))
+				}()
+			}
+		}
+		// In any case, try process termination after a second to avoid leaving
+		// zombie process.
+		stdin.Close()
+		time.Sleep(time.Second)
+		cmd.Kill()
+	}()
+
+	// Wait for process to finish
+	<-mi.Done()
+	waitErr := cmd.Wait()
+	eventsMutex.Lock()
+	defer eventsMutex.Unlock()
+	if eventsErr != nil {
+		return nil, eventsErr
+	}
+	if waitErr != nil {
+		return &dbg.DebugResponse{Error: waitErr.Error()}, nil
+	}
+	return &dbg.DebugResponse{}, nil
+}
+
+// translateCommand returns the GDB/MI operation and arguments for the given command
+func translateCommand(command *dbg.DebugCommand) (string, []string, error) {
+	switch {
+	case command.GetInsertBreakpoint() != nil:
+		bp := command.GetInsertBreakpoint()
+		if bp.GetLocation() == "" {
+			return "", nil, &arduino.InvalidArgumentError{Message: tr("Missing breakpoint location")}
+		}
+		args := []string{}
+		if bp.GetTemporary() {
+			args = append(args, "-t")
+		}
+		if bp.GetHardware() {
+			args = append(args, "-h")
+		}
+		if bp.GetCondition() != "" {
+			args = append(args, "-c", gdbmi.Quote(bp.GetCondition()))
+		}
+		args = append(args, "-f", gdbmi.Quote(bp.GetLocation()))
+		return "break-insert", args, nil
+	case command.GetContinue() != nil:
+		if command.GetContinue().GetAllThreads() {
+			return "exec-continue", []string{"--all"}, nil
+		}
+		return "exec-continue", nil, nil
+	case command.GetReadMemory() != nil:
+		mem := command.GetReadMemory()
+		if mem.GetAddress() == "" {
+			return "", nil, &arduino.InvalidArgumentError{Message: tr("Missing memory address")}
+		}
+		args := []string{}
+		if mem.GetOffset() != 0 {
+			args = append(args, "-o", strconv.FormatInt(mem.GetOffset(), 10))
+		}
+		args = append(args, gdbmi.Quote(mem.GetAddress()), strconv.FormatUint(mem.GetCount(), 10))
+		return "data-read-memory-bytes", args, nil
+	case command.GetMiCommand() != nil:
+		mi := command.GetMiCommand()
+		operation := strings.TrimPrefix(mi.GetOperation(), "-")
+		if operation == "" || strings.ContainsAny(operation, " \t\r\n") {
+			return "", nil, &arduino.InvalidArgumentError{Message: tr("Invalid GDB/MI operation: %s", mi.GetOperation())}
+		}
+		args := []string{}
+		for _, arg := range mi.GetArguments() {
+			if arg == "" || strings.ContainsAny(arg, " \t\r\n\"\\") {
+				arg = gdbmi.Quote(arg)
+			}
+			args = append(args, arg)
+		}
+		return operation, args, nil
+	}
+	return "", nil, &arduino.InvalidArgumentError{Message: tr("Empty debug command")}
+}
+
+// recordToEvent converts a GDB/MI record into a DebugEvent
+func recordToEvent(rec *gdbmi.Record, commandID uint32) *dbg.DebugEvent {
+	switch rec.Type {
+	case gdbmi.ResultRecord:
+		return &dbg.DebugEvent{Event: &dbg.DebugEvent_Result{Result: &dbg.DebugResultRecord{
+			CommandId:   commandID,
+			ResultClass: rec.Class,
+			Results:     tupleToStruct(rec.Results),
+		}}}
+	case gdbmi.ExecAsyncRecord:
+		return &dbg.DebugEvent{Event: &dbg.DebugEvent_ExecAsync{ExecAsync: &dbg.DebugAsyncRecord{
+			AsyncClass: rec.Class,
+			Results:    tupleToStruct(rec.Results),
+		}}}
+	case gdbmi.StatusAsyncRecord:
+		return &dbg.DebugEvent{Event: &dbg.DebugEvent_StatusAsync{StatusAsync: &dbg.DebugAsyncRecord{
+			AsyncClass: rec.Class,
+			Results:    tupleToStruct(rec.Results),
+		}}}
+	case gdbmi.NotifyAsyncRecord:
+		return &dbg.DebugEvent{Event: &dbg.DebugEvent_NotifyAsync{NotifyAsync: &dbg.DebugAsyncRecord{
+			AsyncClass: rec.Class,
+			Results:    tupleToStruct(rec.Results),
+		}}}
+	}
+	streamType := dbg.DebugStreamType_DEBUG_STREAM_TYPE_CONSOLE
+	switch rec.Type {
+	case gdbmi.TargetStreamRecord:
+		streamType = dbg.DebugStreamType_DEBUG_STREAM_TYPE_TARGET
+	case gdbmi.LogStreamRecord:
+		streamType = dbg.DebugStreamType_DEBUG_STREAM_TYPE_LOG
+	}
+	return &dbg.DebugEvent{Event: &dbg.DebugEvent_Stream{Stream: &dbg.DebugStreamRecord{
+		Type: streamType,
+		Text: rec.Stream,
+	}}}
+}
+
+func tupleToStruct(t *gdbmi.Tuple) *structpb.Struct {
+	res := &structpb.Struct{Fields: map[string]*structpb.Value{}}
+	for _, k := range t.Keys() {
+		res.Fields[k] = miValueToValue(t.Get(k))
+	}
+	return res
+}
+
+func miValueToValue(v interface{}) *structpb.Value {
+	switch value := v.(type) {
+	case *gdbmi.Tuple:
+		return structpb.NewStructValue(tupleToStruct(value))
+	case gdbmi.List:
+		list := &structpb.ListValue{}
+		for _, item := range value {
+			list.Values = append(list.Values, miValueToValue(item))
+		}
+		return structpb.NewListValue(list)
+	case string:
+		return structpb.NewStringValue(value)
+	}
+	return structpb.NewNullValue()
+}
+
+// lockedWriter allows concurrent writes on the same io.Writer
+type lockedWriter struct {
+	mutex sync.Mutex
+	w     io.Writer
+}
+
+func (l *lockedWriter) Write(data []byte) (int, error) {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	return l.w.Write(data)
+}
+
+// streamWriter sends everything written to it as a stream DebugEvent
+type streamWriter struct {
+	streamType dbg.DebugStreamType
+	send       func(*dbg.DebugEvent)
+}
+
+func (w *streamWriter) Write(data []byte) (int, error) {
+	w.send(&dbg.DebugEvent{Event: &dbg.DebugEvent_Stream{Stream: &dbg.DebugStreamRecord{
+		Type: w.streamType,
+		Text: string(data),
+	}}})
+	return len(data), nil
+}
@@ -0,0 +1,95 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package debug
+
+import (
+	"testing"
+
+	"github.com/arduino/arduino-cli/commands/debug/gdbmi"
+	dbg "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/debug/v1"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTranslateCommand(t *testing.T) {
+	check := func(command *dbg.DebugCommand, operation string, args ...string) {
+		op, a, err := translateCommand(command)
+		require.NoError(t, err)
+		require.Equal(t, operation, op)
+		require.Equal(t, args, a)
+	}
+
+	check(&dbg.DebugCommand{Command: &dbg.DebugCommand_InsertBreakpoint{InsertBreakpoint: &dbg.InsertBreakpoint{
+		Location: "Blink.ino:12",
+	}}}, "break-insert", "-f", `"Blink.ino:12"`)
+	check(&dbg.DebugCommand{Command: &dbg.DebugCommand_InsertBreakpoint{InsertBreakpoint: &dbg.InsertBreakpoint{
+		Location:  "loop",
+		Condition: `counter == 10`,
+		Temporary: true,
+		Hardware:  true,
+	}}}, "break-insert", "-t", "-h", "-c", `"counter == 10"`, "-f", `"loop"`)
+	check(&dbg.DebugCommand{Command: &dbg.DebugCommand_Continue{Continue: &dbg.Continue{}}}, "exec-continue")
+	check(&dbg.DebugCommand{Command: &dbg.DebugCommand_Continue{Continue: &dbg.Continue{AllThreads: true}}}, "exec-continue", "--all")
+	check(&dbg.DebugCommand{Command: &dbg.DebugCommand_ReadMemory{ReadMemory: &dbg.ReadMemory{
+		Address: "&buffer",
+		Count:   16,
+		Offset:  4,
+	}}}, "data-read-memory-bytes", "-o", "4", `"&buffer"`, "16")
+	check(&dbg.DebugCommand{Command: &dbg.DebugCommand_MiCommand{MiCommand: &dbg.MICommand{
+		Operation: "-data-evaluate-expression",
+		Arguments: []string{"--frame", "0", "a + b"},
+	}}}, "data-evaluate-expression", "--frame", "0", `"a + b"`)
+
+	for _, invalid := range []*dbg.DebugCommand{
+		{},
+		{Command: &dbg.DebugCommand_InsertBreakpoint{InsertBreakpoint: &dbg.InsertBreakpoint{}}},
+		{Command: &dbg.DebugCommand_ReadMemory{ReadMemory: &dbg.ReadMemory{Count: 4}}},
+		{Command: &dbg.DebugCommand_MiCommand{MiCommand: &dbg.MICommand{Operation: "exec-next\n-gdb-exit"}}},
+	} {
+		_, _, err := translateCommand(invalid)
+		require.Error(t, err)
+	}
+}
+
+func TestRecordToEvent(t *testing.T) {
+	rec, err := gdbmi.ParseRecord(`^done,memory=[{begin="0x20000000",offset="0x00000000",end="0x20000004",contents="01020304"}]`)
+	require.NoError(t, err)
+	event := recordToEvent(rec, 7)
+	result := event.GetResult()
+	require.NotNil(t, result)
+	require.Equal(t, uint32(7), result.GetCommandId())
+	require.Equal(t, "done", result.GetResultClass())
+	memory := result.GetResults().GetFields()["memory"].GetListValue().GetValues()
+	require.Len(t, memory, 1)
+	require.Equal(t, "01020304", memory[0].GetStructValue().GetFields()["contents"].GetStringValue())
+
+	rec, err = gdbmi.ParseRecord(`*stopped,reason="breakpoint-hit",frame={func="loop",line="12"}`)
+	require.NoError(t, err)
+	async := recordToEvent(rec, 0).GetExecAsync()
+	require.NotNil(t, async)
+	require.Equal(t, "stopped", async.GetAsyncClass())
+	require.Equal(t, "breakpoint-hit", async.GetResults().AsMap()["reason"])
+	require.Equal(t, "loop", async.GetResults().AsMap()["frame"].(map[string]interface{})["func"])
+
+	rec, err = gdbmi.ParseRecord(`=breakpoint-modified,bkpt={number="1",times="1"}`)
+	require.NoError(t, err)
+	require.Equal(t, "breakpoint-modified", recordToEvent(rec, 0).GetNotifyAsync().GetAsyncClass())
+
+	rec, err = gdbmi.ParseRecord(`@"Hello from target\n"`)
+	require.NoError(t, err)
+	stream := recordToEvent(rec, 0).GetStream()
+	require.Equal(t, dbg.DebugStreamType_DEBUG_STREAM_TYPE_TARGET, stream.GetType())
+	require.Equal(t, "Hello from target\n", stream.GetText())
+}
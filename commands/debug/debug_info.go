@@ -33,7 +33,21 @@ import (
 // GetDebugConfig returns metadata to start debugging with the specified board
 func GetDebugConfig(ctx context.Context, req *debug.DebugConfigRequest) (*debug.GetDebugConfigResponse, error) {
 	pm := commands.GetPackageManager(req.GetInstance().GetId())
-	return getDebugProperties(req, pm)
+	res, err := getDebugProperties(req, pm)
+	if err != nil {
+		return nil, err
+	}
+
+	// Resolve the command lines of the debug session, if the toolchain and the
+	// server are supported
+	if session, err := getDebugSession(res, req.GetInterpreter(), false); err != nil {
+		logrus.WithError(err).Warn("Cannot compose debug session command lines")
+	} else {
+		res.ServerCommandLine = session.Server
+		res.ServerAddress = session.ServerAddress
+		res.ClientCommandLine = session.Client
+	}
+	return res, nil
 }
 
 func getDebugProperties(req *debug.DebugConfigRequest, pm *packagemanager.PackageManager) (*debug.GetDebugConfigResponse, error) {
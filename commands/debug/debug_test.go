@@ -88,3 +88,88 @@ func TestGetCommandLine(t *testing.T) {
 	commandToTest2 := strings.Join(command2[:], " ")
 	assert.Equal(t, filepath.FromSlash(goldCommand2), filepath.FromSlash(commandToTest2))
 }
+
+func TestGetDebugSession(t *testing.T) {
+	exe := ""
+	if runtime.GOOS == "windows" {
+		exe = ".exe"
+	}
+	info := func(toolchain, server string, conf map[string]string) *dbg.GetDebugConfigResponse {
+		return &dbg.GetDebugConfigResponse{
+			Executable:          "/build/hello.ino.elf",
+			Toolchain:           toolchain,
+			ToolchainPath:       "/tools/gcc/bin",
+			ToolchainPrefix:     "arm-none-eabi-",
+			Server:              server,
+			ServerPath:          conf["path"],
+			ServerConfiguration: conf,
+		}
+	}
+
+	// OpenOCD is started by GDB through a pipe
+	session, err := getDebugSession(info("gcc", "openocd", map[string]string{"path": "/tools/openocd", "script": "board.cfg"}), "", false)
+	require.NoError(t, err)
+	require.Empty(t, session.Server)
+	require.Empty(t, session.ServerAddress)
+	require.Equal(t, []string{
+		"/tools/gcc/bin/arm-none-eabi-gdb" + exe, "--interpreter=console",
+		"-ex", "set remotetimeout 5",
+		"-ex", `target extended-remote | "/tools/openocd" --file "board.cfg" -c "gdb_port pipe" -c "telnet_port 0"`,
+		"/build/hello.ino.elf",
+	}, session.Client)
+
+	// LLDB can't use pipes, OpenOCD is started as a standalone server
+	session, err = getDebugSession(info("lldb", "openocd", map[string]string{"path": "/tools/openocd", "script": "board.cfg", "port": "4444"}), "console", false)
+	require.NoError(t, err)
+	require.Equal(t, []string{"/tools/openocd", "--file", "board.cfg", "-c", "gdb_port 4444", "-c", "telnet_port 0"}, session.Server)
+	require.Equal(t, "localhost:4444", session.ServerAddress)
+	require.Equal(t, []string{"/tools/gcc/bin/lldb" + exe, "/build/hello.ino.elf", "-o", "gdb-remote localhost:4444"}, session.Client)
+	_, err = getDebugSession(info("lldb", "openocd", map[string]string{"path": "/tools/openocd"}), "mi2", false)
+	require.Error(t, err)
+
+	session, err = getDebugSession(info("gcc", "pyocd", map[string]string{"path": "/tools/pyocd", "target": "nrf52840"}), "mi2", false)
+	require.NoError(t, err)
+	require.Equal(t, []string{"/tools/pyocd", "gdbserver", "--port", "3333", "--telnet-port", "0", "--target", "nrf52840"}, session.Server)
+	require.Equal(t, []string{
+		"/tools/gcc/bin/arm-none-eabi-gdb" + exe, "--interpreter=mi2",
+		"-ex", "set pagination off",
+		"-ex", "set remotetimeout 5",
+		"-ex", "target extended-remote localhost:3333",
+		"/build/hello.ino.elf",
+	}, session.Client)
+
+	// Asynchronous execution is enabled before connecting to the server
+	session, err = getDebugSession(info("gcc", "pyocd", map[string]string{"path": "/tools/pyocd"}), "mi2", true)
+	require.NoError(t, err)
+	require.Equal(t, []string{
+		"/tools/gcc/bin/arm-none-eabi-gdb" + exe, "--interpreter=mi2",
+		"-ex", "set pagination off",
+		"-ex", "set remotetimeout 5",
+		"-ex", "set mi-async on",
+		"-ex", "target extended-remote localhost:3333",
+		"/build/hello.ino.elf",
+	}, session.Client)
+
+	session, err = getDebugSession(info("gcc", "jlink", map[string]string{"path": "/tools/JLinkGDBServerCL", "device": "ATSAMD21G18"}), "", false)
+	require.NoError(t, err)
+	require.Equal(t, []string{"/tools/JLinkGDBServerCL", "-singlerun", "-nogui", "-if", "SWD", "-speed", "auto", "-device", "ATSAMD21G18", "-port", "2331"}, session.Server)
+	require.Equal(t, "localhost:2331", session.ServerAddress)
+	_, err = getDebugSession(info("gcc", "jlink", map[string]string{"path": "/tools/JLinkGDBServerCL"}), "", false)
+	require.Error(t, err)
+
+	session, err = getDebugSession(info("gcc", "probe-rs", map[string]string{"path": "/tools/probe-rs", "chip": "nRF52840_xxAA"}), "", false)
+	require.NoError(t, err)
+	require.Equal(t, []string{"/tools/probe-rs", "gdb", "--chip", "nRF52840_xxAA", "--gdb-connection-string", "127.0.0.1:1337"}, session.Server)
+	require.Equal(t, "localhost:1337", session.ServerAddress)
+
+	// Custom servers are described by a command line pattern
+	session, err = getDebugSession(info("gcc", "bmp", map[string]string{"path": "/tools/bmp proxy", "port": "2000", "pattern": `"{path}" --listen {port}`}), "", false)
+	require.NoError(t, err)
+	require.Equal(t, []string{"/tools/bmp proxy", "--listen", "2000"}, session.Server)
+	require.Equal(t, "localhost:2000", session.ServerAddress)
+
+	_, err = getDebugSession(info("gcc", "unknown", map[string]string{}), "", false)
+	require.Error(t, err)
+	_, err = getDebugSession(info("iar", "openocd", map[string]string{}), "", false)
+	require.Error(t, err)
+}
@@ -0,0 +1,274 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package debug
+
+import (
+	"fmt"
+	"path/filepath"
+	"runtime"
+	"strings"
+
+	"github.com/arduino/arduino-cli/arduino"
+	dbg "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/debug/v1"
+	"github.com/arduino/go-paths-helper"
+	"github.com/arduino/go-properties-orderedmap"
+)
+
+// debugSession contains the command lines of the processes needed to debug
+// a board: an optional GDB server, that must be started first, and the
+// debugger client.
+type debugSession struct {
+	// Server is the command line of the GDB server, empty if the server is
+	// started by the client itself (through a pipe).
+	Server []string
+	// ServerAddress is the address where the server listens for the client.
+	ServerAddress string
+	// Client is the command line of the debugger client.
+	Client []string
+}
+
+// debugServer describes a supported GDB server. The server configuration
+// is taken from the `debug.server.<name>.*` properties, the `path` and `port`
+// keys are common to all servers.
+//
+// A server command line can be fully customized through the
+// `debug.server.<name>.pattern` property: in this case the `{path}`, `{port}`
+// and all the other server configuration keys are available as placeholders.
+// A platform can use this to describe any GDB server not explicitly supported.
+type debugServer struct {
+	// defaultPort is the TCP port used if not specified in the configuration
+	defaultPort string
+	// args returns the command line arguments to start the server listening
+	// for a client on the given port.
+	args func(conf *properties.Map, port string) ([]string, error)
+	// pipeCommand, if not nil, returns the GDB command to start the server
+	// through a pipe. Servers supporting pipes are started directly by GDB.
+	pipeCommand func(conf *properties.Map) string
+}
+
+var debugServers = map[string]*debugServer{
+	"openocd": {
+		defaultPort: "3333",
+		args: func(conf *properties.Map, port string) ([]string, error) {
+			args := []string{}
+			if dir := conf.Get("scripts_dir"); dir != "" {
+				args = append(args, "-s", dir)
+			}
+			if script := conf.Get("script"); script != "" {
+				args = append(args, "--file", script)
+			}
+			args = append(args, "-c", "gdb_port "+port, "-c", "telnet_port 0")
+			return args, nil
+		},
+		pipeCommand: func(conf *properties.Map) string {
+			serverCmd := fmt.Sprintf(`target extended-remote | "%s"`, conf.Get("path"))
+			if cfg := conf.Get("scripts_dir"); cfg != "" {
+				serverCmd += fmt.Sprintf(` -s "%s"`, cfg)
+			}
+			if script := conf.Get("script"); script != "" {
+				serverCmd += fmt.Sprintf(` --file "%s"`, script)
+			}
+			serverCmd += ` -c "gdb_port pipe"`
+			serverCmd += ` -c "telnet_port 0"`
+			return serverCmd
+		},
+	},
+	"pyocd": {
+		defaultPort: "3333",
+		args: func(conf *properties.Map, port string) ([]string, error) {
+			args := []string{"gdbserver", "--port", port, "--telnet-port", "0"}
+			if target := conf.Get("target"); target != "" {
+				args = append(args, "--target", target)
+			}
+			if config := conf.Get("config"); config != "" {
+				args = append(args, "--config", config)
+			}
+			if uid := conf.Get("uid"); uid != "" {
+				args = append(args, "--uid", uid)
+			}
+			return args, nil
+		},
+	},
+	"jlink": {
+		defaultPort: "2331",
+		args: func(conf *properties.Map, port string) ([]string, error) {
+			device := conf.Get("device")
+			if device == "" {
+				return nil, &arduino.FailedDebugError{Message: tr("Missing '%s' property in debug configuration", "debug.server.jlink.device")}
+			}
+			iface := conf.Get("interface")
+			if iface == "" {
+				iface = "SWD"
+			}
+			speed := conf.Get("speed")
+			if speed == "" {
+				speed = "auto"
+			}
+			return []string{"-singlerun", "-nogui", "-if", iface, "-speed", speed, "-device", device, "-port", port}, nil
+		},
+	},
+	"probe-rs": {
+		defaultPort: "1337",
+		args: func(conf *properties.Map, port string) ([]string, error) {
+			chip := conf.Get("chip")
+			if chip == "" {
+				return nil, &arduino.FailedDebugError{Message: tr("Missing '%s' property in debug configuration", "debug.server.probe-rs.chip")}
+			}
+			args := []string{"gdb", "--chip", chip, "--gdb-connection-string", "127.0.0.1:" + port}
+			if probe := conf.Get("probe"); probe != "" {
+				args = append(args, "--probe", probe)
+			}
+			return args, nil
+		},
+	},
+}
+
+// debugToolchain describes a supported debugger client
+type debugToolchain struct {
+	// client is the name of the debugger executable
+	client string
+	// prefixed is true if the client executable name is prefixed with the
+	// toolchain prefix (like arm-none-eabi-gdb)
+	prefixed bool
+	// supportsPipe is true if the client can start the server through a pipe
+	supportsPipe bool
+	// args returns the client command line arguments. target is the GDB
+	// command to connect to the server (through a pipe) or the server address.
+	// async enables the asynchronous execution of the target, so that it can
+	// be interrupted while running through the MI interpreter.
+	args func(interpreter string, target string, pipe bool, async bool, executable string) ([]string, error)
+}
+
+var debugToolchains = map[string]*debugToolchain{
+	"gcc": {
+		client:       "gdb",
+		prefixed:     true,
+		supportsPipe: true,
+		args: func(interpreter, target string, pipe bool, async bool, executable string) ([]string, error) {
+			// Set GDB interpreter (default value should be "console")
+			args := []string{"--interpreter=" + interpreter}
+			if interpreter != "console" {
+				args = append(args, "-ex", "set pagination off")
+			}
+			// Add extra GDB execution commands
+			args = append(args, "-ex", "set remotetimeout 5")
+			if async {
+				// It must be set before connecting to the GDB server
+				args = append(args, "-ex", "set mi-async on")
+			}
+			if pipe {
+				args = append(args, "-ex", target)
+			} else {
+				args = append(args, "-ex", "target extended-remote "+target)
+			}
+			return append(args, executable), nil
+		},
+	},
+	"lldb": {
+		client: "lldb",
+		args: func(interpreter, target string, pipe bool, async bool, executable string) ([]string, error) {
+			if interpreter != "console" {
+				return nil, &arduino.FailedDebugError{Message: tr("Interpreter '%[1]s' is not supported by toolchain '%[2]s'", interpreter, "lldb")}
+			}
+			return []string{executable, "-o", "gdb-remote " + target}, nil
+		},
+	},
+}
+
+// getDebugSession compose the command lines needed to start a debug session
+// with the given configuration. If async is true the debugger is configured
+// to run the target asynchronously, if supported by the toolchain.
+func getDebugSession(debugInfo *dbg.GetDebugConfigResponse, interpreter string, async bool) (*debugSession, error) {
+	toolchain, ok := debugToolchains[debugInfo.GetToolchain()]
+	if !ok {
+		return nil, &arduino.FailedDebugError{Message: tr("Toolchain '%s' is not supported", debugInfo.GetToolchain())}
+	}
+	if interpreter == "" {
+		interpreter = "console"
+	}
+
+	serverConf := properties.NewFromHashmap(debugInfo.GetServerConfiguration())
+	server, ok := debugServers[debugInfo.GetServer()]
+	if !ok && !serverConf.ContainsKey("pattern") {
+		return nil, &arduino.FailedDebugError{Message: tr("GDB server '%s' is not supported", debugInfo.GetServer())}
+	}
+
+	session := &debugSession{}
+	var target string
+	pipe := false
+	if server != nil && server.pipeCommand != nil && toolchain.supportsPipe && !serverConf.ContainsKey("pattern") {
+		// The server is launched directly by the client
+		target = server.pipeCommand(serverConf)
+		pipe = true
+	} else {
+		port := serverConf.Get("port")
+		if port == "" && server != nil {
+			port = server.defaultPort
+		}
+		if port == "" {
+			port = "3333"
+		}
+		serverConf.Set("port", port)
+
+		if pattern, ok := serverConf.GetOk("pattern"); ok {
+			args, err := properties.SplitQuotedString(serverConf.ExpandPropsInString(pattern), `"'`, false)
+			if err != nil {
+				return nil, &arduino.FailedDebugError{Message: tr("Invalid GDB server command line"), Cause: err}
+			}
+			session.Server = args
+		} else {
+			args, err := server.args(serverConf, port)
+			if err != nil {
+				return nil, err
+			}
+			session.Server = append([]string{debugInfo.GetServerPath()}, args...)
+		}
+		session.ServerAddress = "localhost:" + port
+		target = session.ServerAddress
+	}
+
+	clientExecutable := toolchain.client
+	if toolchain.prefixed {
+		clientExecutable = debugInfo.GetToolchainPrefix() + clientExecutable
+	}
+	if runtime.GOOS == "windows" {
+		clientExecutable += ".exe"
+	}
+	clientPath := clientExecutable
+	if toolchainPath := debugInfo.GetToolchainPath(); toolchainPath != "" {
+		clientPath = paths.New(toolchainPath).Join(clientExecutable).String()
+	}
+	args, err := toolchain.args(interpreter, target, pipe, async, debugInfo.GetExecutable())
+	if err != nil {
+		return nil, err
+	}
+	session.Client = append([]string{clientPath}, args...)
+
+	// Transform every path to forward slashes (on Windows some tools further
+	// escapes the command line so the backslash "\" gets in the way).
+	for i, param := range session.Client {
+		session.Client[i] = filepath.ToSlash(param)
+	}
+	for i, param := range session.Server {
+		session.Server[i] = filepath.ToSlash(param)
+	}
+	return session, nil
+}
+
+// isMIInterpreter returns true if the given interpreter is a GDB/MI interpreter
+func isMIInterpreter(interpreter string) bool {
+	return strings.HasPrefix(interpreter, "mi")
+}
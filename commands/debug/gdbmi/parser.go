@@ -0,0 +1,469 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package gdbmi
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/arduino/arduino-cli/i18n"
+)
+
+var tr = i18n.Tr
+
+// RecordType is the kind of an output record emitted by GDB
+type RecordType int
+
+const (
+	// ResultRecord is the answer to a command ("^done", "^error", ...)
+	ResultRecord RecordType = iota
+	// ExecAsyncRecord is a change of the target execution state ("*stopped", "*running")
+	ExecAsyncRecord
+	// StatusAsyncRecord is a progress notification of a slow operation ("+download")
+	StatusAsyncRecord
+	// NotifyAsyncRecord is a supplementary information for the client ("=breakpoint-modified")
+	NotifyAsyncRecord
+	// ConsoleStreamRecord is text that should be displayed in the CLI console ("~")
+	ConsoleStreamRecord
+	// TargetStreamRecord is the output produced by the target program ("@")
+	TargetStreamRecord
+	// LogStreamRecord is output text coming from GDB internals ("&")
+	LogStreamRecord
+	// PromptRecord is the "(gdb)" line that terminates each block of output
+	PromptRecord
+)
+
+func (t RecordType) String() string {
+	switch t {
+	case ResultRecord:
+		return "result"
+	case ExecAsyncRecord:
+		return "exec-async"
+	case StatusAsyncRecord:
+		return "status-async"
+	case NotifyAsyncRecord:
+		return "notify-async"
+	case ConsoleStreamRecord:
+		return "console-stream"
+	case TargetStreamRecord:
+		return "target-stream"
+	case LogStreamRecord:
+		return "log-stream"
+	case PromptRecord:
+		return "prompt"
+	}
+	return "unknown"
+}
+
+// Record is a single line of GDB/MI output
+type Record struct {
+	Type RecordType
+	// Token is the numeric token of the command that generated the record, or -1
+	Token int
+	// Class is the result or async class ("done", "error", "stopped", ...)
+	Class string
+	// Results contains the key/value pairs that follows the class
+	Results *Tuple
+	// Stream is the decoded text of a stream record
+	Stream string
+}
+
+// IsAsync returns true if the record is an asynchronous notification
+func (r *Record) IsAsync() bool {
+	return r.Type == ExecAsyncRecord || r.Type == StatusAsyncRecord || r.Type == NotifyAsyncRecord
+}
+
+// IsStream returns true if the record contains stream output
+func (r *Record) IsStream() bool {
+	return r.Type == ConsoleStreamRecord || r.Type == TargetStreamRecord || r.Type == LogStreamRecord
+}
+
+func (r *Record) String() string {
+	if r.IsStream() {
+		return fmt.Sprintf("%s %q", r.Type, r.Stream)
+	}
+	return fmt.Sprintf("%s %s %v", r.Type, r.Class, r.Results)
+}
+
+// Tuple is a set of MI results, in the order they have been received. Values
+// may be a string, a *Tuple or a List.
+type Tuple struct {
+	keys   []string
+	values map[string]interface{}
+}
+
+// List is an MI list. When the list contains results (`[a=..,b=..]`) the keys are
+// discarded and only the values are kept.
+type List []interface{}
+
+// NewTuple creates an empty Tuple
+func NewTuple() *Tuple {
+	return &Tuple{values: map[string]interface{}{}}
+}
+
+// Set sets the value of the given key, a new key is added after the others
+func (t *Tuple) Set(key string, value interface{}) {
+	if _, ok := t.values[key]; !ok {
+		t.keys = append(t.keys, key)
+	}
+	t.values[key] = value
+}
+
+// Get returns the value of the given key, or nil if not available
+func (t *Tuple) Get(key string) interface{} {
+	if t == nil {
+		return nil
+	}
+	return t.values[key]
+}
+
+// Keys returns the keys of the tuple in the order they have been received
+func (t *Tuple) Keys() []string {
+	if t == nil {
+		return nil
+	}
+	return t.keys
+}
+
+// Len returns the number of results in the tuple
+func (t *Tuple) Len() int {
+	return len(t.Keys())
+}
+
+// Format prints the tuple results in order, implements fmt.Formatter
+func (t *Tuple) Format(f fmt.State, verb rune) {
+	items := []string{}
+	for _, key := range t.Keys() {
+		items = append(items, fmt.Sprintf("%s=%v", key, t.values[key]))
+	}
+	fmt.Fprint(f, "{"+strings.Join(items, ",")+"}")
+}
+
+// String returns the string value of the given key, or "" if not available
+func (t *Tuple) String(key string) string {
+	if s, ok := t.Get(key).(string); ok {
+		return s
+	}
+	return ""
+}
+
+// Int returns the integer value of the given key, or def if not available
+func (t *Tuple) Int(key string, def int) int {
+	if n, err := strconv.Atoi(t.String(key)); err == nil {
+		return n
+	}
+	return def
+}
+
+// Tuple returns the tuple value of the given key, or nil if not available
+func (t *Tuple) Tuple(key string) *Tuple {
+	if res, ok := t.Get(key).(*Tuple); ok {
+		return res
+	}
+	return nil
+}
+
+// List returns the list value of the given key, or nil if not available.
+// An empty tuple `{}` is considered an empty list.
+func (t *Tuple) List(key string) List {
+	switch v := t.Get(key).(type) {
+	case List:
+		return v
+	case *Tuple:
+		// `{}` and `[]` are interchangeable in some GDB versions
+		res := List{}
+		for _, key := range v.keys {
+			res = append(res, v.values[key])
+		}
+		return res
+	}
+	return nil
+}
+
+// ParseRecord parses a single line of GDB/MI output
+func ParseRecord(line string) (*Record, error) {
+	line = strings.TrimRight(line, "\r\n")
+	if strings.TrimSpace(line) == "(gdb)" {
+		return &Record{Type: PromptRecord, Token: -1}, nil
+	}
+	p := &parser{in: line}
+	return p.parseRecord()
+}
+
+type parser struct {
+	in  string
+	pos int
+}
+
+func (p *parser) error(msg string) error {
+	return errors.New(tr("invalid GDB/MI output at position %[1]d: %[2]s", p.pos, msg))
+}
+
+func (p *parser) peek() byte {
+	if p.pos >= len(p.in) {
+		return 0
+	}
+	return p.in[p.pos]
+}
+
+func (p *parser) next() byte {
+	c := p.peek()
+	if c != 0 {
+		p.pos++
+	}
+	return c
+}
+
+func (p *parser) parseRecord() (*Record, error) {
+	rec := &Record{Token: -1}
+
+	start := p.pos
+	for c := p.peek(); c >= '0' && c <= '9'; c = p.peek() {
+		p.pos++
+	}
+	if p.pos > start {
+		token, err := strconv.Atoi(p.in[start:p.pos])
+		if err != nil {
+			return nil, p.error(tr("invalid token"))
+		}
+		rec.Token = token
+	}
+
+	switch p.next() {
+	case '^':
+		rec.Type = ResultRecord
+	case '*':
+		rec.Type = ExecAsyncRecord
+	case '+':
+		rec.Type = StatusAsyncRecord
+	case '=':
+		rec.Type = NotifyAsyncRecord
+	case '~':
+		rec.Type = ConsoleStreamRecord
+	case '@':
+		rec.Type = TargetStreamRecord
+	case '&':
+		rec.Type = LogStreamRecord
+	default:
+		return nil, p.error(tr("unknown record type"))
+	}
+
+	if rec.IsStream() {
+		s, err := p.parseCString()
+		if err != nil {
+			return nil, err
+		}
+		rec.Stream = s
+		return rec, nil
+	}
+
+	rec.Class = p.parseIdentifier()
+	if rec.Class == "" {
+		return nil, p.error(tr("missing record class"))
+	}
+	rec.Results = NewTuple()
+	last := ""
+	for p.peek() == ',' {
+		p.pos++
+		if err := p.parseResultInto(rec.Results, &last); err != nil {
+			return nil, err
+		}
+	}
+	if p.pos != len(p.in) {
+		return nil, p.error(tr("unexpected trailing data"))
+	}
+	return rec, nil
+}
+
+func (p *parser) parseIdentifier() string {
+	start := p.pos
+	for {
+		c := p.peek()
+		if c == 0 || c == ',' || c == '=' || c == '{' || c == '}' || c == '[' || c == ']' || c == '"' {
+			break
+		}
+		p.pos++
+	}
+	return p.in[start:p.pos]
+}
+
+func (p *parser) parseResult() (string, interface{}, error) {
+	key := p.parseIdentifier()
+	if key == "" {
+		return "", nil, p.error(tr("missing variable name"))
+	}
+	if p.next() != '=' {
+		return "", nil, p.error(tr("expected '='"))
+	}
+	value, err := p.parseValue()
+	if err != nil {
+		return "", nil, err
+	}
+	return key, value, nil
+}
+
+// parseResultInto parses a result and adds it to the tuple. GDB versions
+// before 13 report the locations of a multi-location breakpoint as unnamed
+// tuples following the breakpoint (`bkpt={...},{...},{...}`): they are added
+// to the `locations` list of the preceding tuple, the way newer versions
+// report them.
+func (p *parser) parseResultInto(t *Tuple, last *string) error {
+	if p.peek() == '{' && *last != "" {
+		value, err := p.parseValue()
+		if err != nil {
+			return err
+		}
+		previous, ok := t.Get(*last).(*Tuple)
+		if !ok {
+			return p.error(tr("missing variable name"))
+		}
+		previous.Set("locations", append(previous.List("locations"), value))
+		return nil
+	}
+	key, value, err := p.parseResult()
+	if err != nil {
+		return err
+	}
+	t.Set(key, value)
+	*last = key
+	return nil
+}
+
+func (p *parser) parseValue() (interface{}, error) {
+	switch p.peek() {
+	case '"':
+		return p.parseCString()
+	case '{':
+		p.pos++
+		res := NewTuple()
+		if p.peek() == '}' {
+			p.pos++
+			return res, nil
+		}
+		last := ""
+		for {
+			if err := p.parseResultInto(res, &last); err != nil {
+				return nil, err
+			}
+			switch p.next() {
+			case ',':
+				continue
+			case '}':
+				return res, nil
+			default:
+				return nil, p.error(tr("expected ',' or '}'"))
+			}
+		}
+	case '[':
+		p.pos++
+		res := List{}
+		if p.peek() == ']' {
+			p.pos++
+			return res, nil
+		}
+		for {
+			var value interface{}
+			var err error
+			if c := p.peek(); c == '"' || c == '{' || c == '[' {
+				value, err = p.parseValue()
+			} else {
+				_, value, err = p.parseResult()
+			}
+			if err != nil {
+				return nil, err
+			}
+			res = append(res, value)
+			switch p.next() {
+			case ',':
+				continue
+			case ']':
+				return res, nil
+			default:
+				return nil, p.error(tr("expected ',' or ']'"))
+			}
+		}
+	}
+	return nil, p.error(tr("invalid value"))
+}
+
+func (p *parser) parseCString() (string, error) {
+	if p.next() != '"' {
+		return "", p.error(tr("expected '\"'"))
+	}
+	var res strings.Builder
+	for {
+		c := p.next()
+		switch c {
+		case 0:
+			return "", p.error(tr("unterminated string"))
+		case '"':
+			return res.String(), nil
+		case '\\':
+			e := p.next()
+			switch e {
+			case 'n':
+				res.WriteByte('\n')
+			case 't':
+				res.WriteByte('\t')
+			case 'r':
+				res.WriteByte('\r')
+			case 'e':
+				res.WriteByte('\033')
+			case '0', '1', '2', '3', '4', '5', '6', '7':
+				// octal escape, up to three digits
+				n := int(e - '0')
+				for i := 0; i < 2; i++ {
+					if d := p.peek(); d >= '0' && d <= '7' {
+						n = n*8 + int(d-'0')
+						p.pos++
+					}
+				}
+				res.WriteByte(byte(n))
+			case 0:
+				return "", p.error(tr("unterminated string"))
+			default:
+				res.WriteByte(e)
+			}
+		default:
+			res.WriteByte(c)
+		}
+	}
+}
+
+// Quote returns s as a C-string suitable for a GDB/MI command parameter
+func Quote(s string) string {
+	var res strings.Builder
+	res.WriteByte('"')
+	for i := 0; i < len(s); i++ {
+		switch c := s[i]; c {
+		case '"', '\\':
+			res.WriteByte('\\')
+			res.WriteByte(c)
+		case '\n':
+			res.WriteString(`\n`)
+		case '\t':
+			res.WriteString(`\t`)
+		case '\r':
+			res.WriteString(`\r`)
+		default:
+			res.WriteByte(c)
+		}
+	}
+	res.WriteByte('"')
+	return res.String()
+}
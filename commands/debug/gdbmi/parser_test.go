@@ -0,0 +1,168 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package gdbmi
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseRecord(t *testing.T) {
+	rec, err := ParseRecord(`12^done,bkpt={number="1",type="breakpoint",file="Blink.ino",fullname="/tmp/Blink/Blink.ino",line="31",thread-groups=["i1"]}`)
+	require.NoError(t, err)
+	require.Equal(t, ResultRecord, rec.Type)
+	require.Equal(t, 12, rec.Token)
+	require.Equal(t, "done", rec.Class)
+	bkpt := rec.Results.Tuple("bkpt")
+	require.NotNil(t, bkpt)
+	require.Equal(t, 1, bkpt.Int("number", 0))
+	require.Equal(t, "/tmp/Blink/Blink.ino", bkpt.String("fullname"))
+	require.Equal(t, List{"i1"}, bkpt.List("thread-groups"))
+
+	rec, err = ParseRecord(`*stopped,reason="breakpoint-hit",disp="keep",bkptno="1",frame={addr="0x00002154",func="loop",args=[],file="Blink.ino",line="31"},thread-id="1",stopped-threads="all"`)
+	require.NoError(t, err)
+	require.Equal(t, ExecAsyncRecord, rec.Type)
+	require.Equal(t, -1, rec.Token)
+	require.Equal(t, "stopped", rec.Class)
+	require.Equal(t, "breakpoint-hit", rec.Results.String("reason"))
+	require.Equal(t, "loop", rec.Results.Tuple("frame").String("func"))
+	require.Empty(t, rec.Results.Tuple("frame").List("args"))
+
+	rec, err = ParseRecord(`^done,stack=[frame={level="0",func="loop"},frame={level="1",func="main"}]`)
+	require.NoError(t, err)
+	stack := rec.Results.List("stack")
+	require.Len(t, stack, 2)
+	require.Equal(t, "main", stack[1].(*Tuple).String("func"))
+
+	rec, err = ParseRecord(`~"Reading symbols from \"Blink.ino.elf\"...\n"`)
+	require.NoError(t, err)
+	require.Equal(t, ConsoleStreamRecord, rec.Type)
+	require.Equal(t, "Reading symbols from \"Blink.ino.elf\"...\n", rec.Stream)
+
+	rec, err = ParseRecord(`@"\101\tB"`)
+	require.NoError(t, err)
+	require.Equal(t, TargetStreamRecord, rec.Type)
+	require.Equal(t, "A\tB", rec.Stream)
+
+	rec, err = ParseRecord("(gdb) \r\n")
+	require.NoError(t, err)
+	require.Equal(t, PromptRecord, rec.Type)
+
+	rec, err = ParseRecord(`=thread-group-added,id="i1"`)
+	require.NoError(t, err)
+	require.Equal(t, NotifyAsyncRecord, rec.Type)
+	require.Equal(t, "thread-group-added", rec.Class)
+
+	for _, invalid := range []string{
+		`hello`,
+		`^done,a=`,
+		`^done,a="unterminated`,
+		`^done,a={b="1"`,
+		`^done,a=["1" "2"]`,
+		`^`,
+	} {
+		_, err := ParseRecord(invalid)
+		require.Error(t, err, invalid)
+	}
+}
+
+func TestParseMultiLocationBreakpoint(t *testing.T) {
+	rec, err := ParseRecord(`^done,bkpt={number="1",type="breakpoint",addr="<MULTIPLE>"},{number="1.1",line="10"},{number="1.2",line="20"}`)
+	require.NoError(t, err)
+	bkpt := rec.Results.Tuple("bkpt")
+	require.Equal(t, "<MULTIPLE>", bkpt.String("addr"))
+	locations := bkpt.List("locations")
+	require.Len(t, locations, 2)
+	require.Equal(t, "1.1", locations[0].(*Tuple).String("number"))
+	require.Equal(t, "1.2", locations[1].(*Tuple).String("number"))
+
+	_, err = ParseRecord(`^done,a="1",{b="2"}`)
+	require.Error(t, err)
+}
+
+func TestTupleOrder(t *testing.T) {
+	rec, err := ParseRecord(`^done,args={a="1",b="2",c="3",d="4",e="5",f="6"}`)
+	require.NoError(t, err)
+	require.Equal(t, List{"1", "2", "3", "4", "5", "6"}, rec.Results.List("args"))
+	require.Equal(t, []string{"a", "b", "c", "d", "e", "f"}, rec.Results.Tuple("args").Keys())
+	require.Equal(t, `{args={a=1,b=2,c=3,d=4,e=5,f=6}}`, fmt.Sprint(rec.Results))
+}
+
+func TestQuote(t *testing.T) {
+	require.Equal(t, `"C:\\sketch\\Blink.ino:12"`, Quote(`C:\sketch\Blink.ino:12`))
+	require.Equal(t, `"say \"hi\"\n"`, Quote("say \"hi\"\n"))
+	rec, err := ParseRecord(`~` + Quote("a \"quoted\"\\ string\n"))
+	require.NoError(t, err)
+	require.Equal(t, "a \"quoted\"\\ string\n", rec.Stream)
+}
+
+func TestSession(t *testing.T) {
+	gdbIn, toGdb := io.Pipe()
+	fromGdb, gdbOut := io.Pipe()
+
+	// Fake GDB answering to commands
+	go func() {
+		buf := make([]byte, 1024)
+		for {
+			n, err := gdbIn.Read(buf)
+			if err != nil {
+				gdbOut.Close()
+				return
+			}
+			cmd := strings.TrimSpace(string(buf[:n]))
+			token := cmd[:strings.Index(cmd, "-")]
+			switch {
+			case strings.HasSuffix(cmd, "-exec-continue"):
+				fmt.Fprintf(gdbOut, "%s^running\n*running,thread-id=\"all\"\n(gdb)\n", token)
+			case strings.HasSuffix(cmd, "-wrong"):
+				fmt.Fprintf(gdbOut, "~\"some output\"\n%s^error,msg=\"Undefined MI command: wrong\"\n(gdb)\n", token)
+			default:
+				fmt.Fprintf(gdbOut, "%s^done\n(gdb)\n", token)
+			}
+		}
+	}()
+
+	records := make(chan *Record, 10)
+	session := NewSession(fromGdb, toGdb, func(rec *Record) { records <- rec })
+
+	rec, err := session.Send("exec-continue")
+	require.NoError(t, err)
+	require.Equal(t, "running", rec.Class)
+	async := <-records
+	require.Equal(t, ExecAsyncRecord, async.Type)
+	require.Equal(t, "running", async.Class)
+
+	_, err = session.Send("wrong")
+	require.Error(t, err)
+	require.IsType(t, &CommandError{}, err)
+	require.Contains(t, err.Error(), "Undefined MI command: wrong")
+	stream := <-records
+	require.Equal(t, "some output", stream.Stream)
+
+	rec, err = session.Send("gdb-set", "mi-async", "on")
+	require.NoError(t, err)
+	require.Equal(t, "done", rec.Class)
+
+	toGdb.Close()
+	<-session.Done()
+	require.NoError(t, session.Err())
+	_, err = session.Send("exec-next")
+	require.Error(t, err)
+}
@@ -0,0 +1,182 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package gdbmi
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+// CommandError is returned when GDB answers a command with an "^error" record
+type CommandError struct {
+	Command string
+	Message string
+}
+
+func (e *CommandError) Error() string {
+	return tr("GDB command '%[1]s' failed: %[2]s", e.Command, e.Message)
+}
+
+// Session is a GDB/MI session running on top of a pair of streams connected to a
+// GDB process started with an MI interpreter. Commands are tagged with a unique
+// token so that the corresponding result record can be routed back to the caller;
+// all the other records (async and stream) are passed to the records handler.
+type Session struct {
+	out     io.Writer
+	handler func(*Record)
+	timeout time.Duration
+	done    chan struct{}
+
+	// All the following fields are guarded by mutex
+	mutex     sync.Mutex
+	nextToken int
+	pending   map[int]chan *Record
+	readErr   error
+}
+
+// NewSession creates a new Session that writes commands to out and reads
+// records from in. The handler is called, from a single goroutine, for every
+// record that is not a result of a command sent through the Session.
+func NewSession(in io.Reader, out io.Writer, handler func(*Record)) *Session {
+	s := &Session{
+		out:       out,
+		handler:   handler,
+		timeout:   30 * time.Second,
+		done:      make(chan struct{}),
+		nextToken: 1,
+		pending:   map[int]chan *Record{},
+	}
+	go s.readLoop(in)
+	return s
+}
+
+// SetTimeout changes the maximum time to wait for a command result
+func (s *Session) SetTimeout(timeout time.Duration) {
+	s.timeout = timeout
+}
+
+// Done returns a channel that is closed when the GDB output stream ends
+func (s *Session) Done() <-chan struct{} {
+	return s.done
+}
+
+// Err returns the error that terminated the GDB output stream, if any
+func (s *Session) Err() error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	if s.readErr == io.EOF {
+		return nil
+	}
+	return s.readErr
+}
+
+func (s *Session) readLoop(in io.Reader) {
+	scanner := bufio.NewScanner(in)
+	scanner.Buffer(make([]byte, 64*1024), 16*1024*1024)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+		rec, err := ParseRecord(line)
+		if err != nil {
+			// GDB may print plain text (for example from the GDB server) on its
+			// output: forward it as console output instead of failing.
+			logrus.WithError(err).Debug("Unparsable GDB/MI output")
+			rec = &Record{Type: ConsoleStreamRecord, Token: -1, Stream: line + "\n"}
+		}
+		if rec.Type == ResultRecord && rec.Token != -1 {
+			s.mutex.Lock()
+			resChan, ok := s.pending[rec.Token]
+			delete(s.pending, rec.Token)
+			s.mutex.Unlock()
+			if ok {
+				resChan <- rec
+				continue
+			}
+		}
+		if rec.Type == PromptRecord {
+			continue
+		}
+		if s.handler != nil {
+			s.handler(rec)
+		}
+	}
+
+	s.mutex.Lock()
+	s.readErr = scanner.Err()
+	if s.readErr == nil {
+		s.readErr = io.EOF
+	}
+	for token, resChan := range s.pending {
+		close(resChan)
+		delete(s.pending, token)
+	}
+	s.mutex.Unlock()
+	close(s.done)
+}
+
+// Send sends a command to GDB and waits for its result. The operation must be
+// given without the leading dash, arguments are sent as-is and must be quoted
+// by the caller if needed (see Quote). If GDB answers with an "^error" record
+// a *CommandError is returned.
+func (s *Session) Send(operation string, args ...string) (*Record, error) {
+	s.mutex.Lock()
+	if s.readErr != nil {
+		s.mutex.Unlock()
+		return nil, errors.New(tr("GDB session terminated"))
+	}
+	token := s.nextToken
+	s.nextToken++
+	resChan := make(chan *Record, 1)
+	s.pending[token] = resChan
+	s.mutex.Unlock()
+
+	command := "-" + operation
+	if len(args) > 0 {
+		command += " " + strings.Join(args, " ")
+	}
+	logrus.Debugf("Sending to GDB: %d%s", token, command)
+	if _, err := fmt.Fprintf(s.out, "%d%s\n", token, command); err != nil {
+		s.mutex.Lock()
+		delete(s.pending, token)
+		s.mutex.Unlock()
+		return nil, err
+	}
+
+	select {
+	case rec, ok := <-resChan:
+		if !ok {
+			return nil, errors.New(tr("GDB session terminated"))
+		}
+		if rec.Class == "error" {
+			return rec, &CommandError{Command: command, Message: rec.Results.String("msg")}
+		}
+		return rec, nil
+	case <-time.After(s.timeout):
+		s.mutex.Lock()
+		delete(s.pending, token)
+		s.mutex.Unlock()
+		return nil, errors.New(tr("timeout waiting for GDB to answer '%s'", command))
+	}
+}
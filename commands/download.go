@@ -16,9 +16,11 @@
 package commands
 
 import (
+	"sync"
 	"time"
 
 	"github.com/arduino/arduino-cli/arduino"
+	"github.com/arduino/arduino-cli/configuration"
 	"github.com/arduino/arduino-cli/httpclient"
 	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
 	"go.bug.st/downloader/v2"
@@ -65,3 +67,116 @@ func Download(d *downloader.Downloader, label string, downloadCB DownloadProgres
 	downloadCB(&rpc.DownloadProgress{Completed: true})
 	return nil
 }
+
+// DownloadTask is a download performed by DownloadParallel
+type DownloadTask struct {
+	// Label is used as text for the File field of the progress messages
+	Label string
+	// Size is the expected size of the file, used to compute the overall
+	// progress until the real size is known
+	Size int64
+	// Start starts the download, a nil Downloader means that the file is
+	// already downloaded
+	Start func() (*downloader.Downloader, error)
+}
+
+// DownloadParallel performs the downloads concurrently, running at most
+// network.parallel_downloads of them at the same time. The messages passed
+// to the DownloadProgressCB have the Url field always set and report the
+// overall progress of the downloads. The first error is returned after all
+// the started downloads are finished.
+func DownloadParallel(tasks []*DownloadTask, downloadCB DownloadProgressCB) error {
+	if len(tasks) == 1 {
+		d, err := tasks[0].Start()
+		if err != nil {
+			return err
+		}
+		return Download(d, tasks[0].Label, downloadCB)
+	}
+
+	limit := configuration.Settings.GetInt("network.parallel_downloads")
+	if limit < 1 {
+		limit = 1
+	}
+	progress := newDownloadProgressAggregator(tasks, downloadCB)
+	slots := make(chan bool, limit)
+	errs := make([]error, len(tasks))
+	var wg sync.WaitGroup
+	for i, task := range tasks {
+		wg.Add(1)
+		slots <- true
+		go func(i int, task *DownloadTask) {
+			defer func() { <-slots }()
+			defer wg.Done()
+			d, err := task.Start()
+			if err != nil {
+				errs[i] = err
+				return
+			}
+			url := ""
+			if d != nil {
+				url = d.URL
+			}
+			errs[i] = Download(d, task.Label, progress.callback(i, url))
+		}(i, task)
+	}
+	wg.Wait()
+	for _, err := range errs {
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// downloadProgressAggregator adds the overall progress to the messages of
+// concurrent downloads and serializes the calls to the DownloadProgressCB
+type downloadProgressAggregator struct {
+	lock       sync.Mutex
+	downloadCB DownloadProgressCB
+	sizes      []int64
+	downloaded []int64
+	completed  int32
+}
+
+func newDownloadProgressAggregator(tasks []*DownloadTask, downloadCB DownloadProgressCB) *downloadProgressAggregator {
+	a := &downloadProgressAggregator{
+		downloadCB: downloadCB,
+		sizes:      make([]int64, len(tasks)),
+		downloaded: make([]int64, len(tasks)),
+	}
+	for i, task := range tasks {
+		a.sizes[i] = task.Size
+	}
+	return a
+}
+
+// callback returns the DownloadProgressCB for the i-th download
+func (a *downloadProgressAggregator) callback(i int, url string) DownloadProgressCB {
+	return func(curr *rpc.DownloadProgress) {
+		a.lock.Lock()
+		defer a.lock.Unlock()
+
+		if curr.GetTotalSize() > 0 {
+			a.sizes[i] = curr.GetTotalSize()
+		}
+		if curr.GetDownloaded() > 0 {
+			a.downloaded[i] = curr.GetDownloaded()
+		}
+		if curr.GetCompleted() {
+			a.completed++
+			a.downloaded[i] = a.sizes[i]
+		}
+		overall := &rpc.DownloadProgressOverall{
+			Files:          int32(len(a.sizes)),
+			CompletedFiles: a.completed,
+		}
+		for j := range a.sizes {
+			overall.TotalSize += a.sizes[j]
+			overall.Downloaded += a.downloaded[j]
+		}
+		curr.Url = url
+		curr.Overall = overall
+		a.downloadCB(curr)
+	}
+}
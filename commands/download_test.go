@@ -0,0 +1,112 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package commands
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/arduino/arduino-cli/configuration"
+	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
+	"github.com/arduino/go-paths-helper"
+	"github.com/stretchr/testify/require"
+	"go.bug.st/downloader/v2"
+)
+
+func TestDownloadParallel(t *testing.T) {
+	settings := configuration.Settings
+	t.Cleanup(func() { configuration.Settings = settings })
+	configuration.Settings = configuration.Init("")
+	configuration.Settings.Set("network.parallel_downloads", 2)
+
+	content := bytes.Repeat([]byte("0123456789"), 1000)
+	var lock sync.Mutex
+	running, maxRunning := 0, 0
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		lock.Lock()
+		running++
+		if running > maxRunning {
+			maxRunning = running
+		}
+		lock.Unlock()
+		time.Sleep(50 * time.Millisecond)
+		http.ServeContent(w, r, "file", time.Time{}, bytes.NewReader(content))
+		lock.Lock()
+		running--
+		lock.Unlock()
+	}))
+	defer srv.Close()
+
+	tmp, err := paths.MkTempDir("", "")
+	require.NoError(t, err)
+	defer tmp.RemoveAll()
+
+	tasks := []*DownloadTask{}
+	for i := 0; i < 4; i++ {
+		file := tmp.Join(fmt.Sprintf("file%d", i))
+		url := fmt.Sprintf("%s/file%d", srv.URL, i)
+		tasks = append(tasks, &DownloadTask{
+			Label: file.Base(),
+			Size:  int64(len(content)),
+			Start: func() (*downloader.Downloader, error) {
+				return downloader.Download(file.String(), url)
+			},
+		})
+	}
+	// An already downloaded file
+	tasks = append(tasks, &DownloadTask{
+		Label: "cached",
+		Size:  100,
+		Start: func() (*downloader.Downloader, error) { return nil, nil },
+	})
+
+	// The progress callback may be called by the download workers: collect
+	// the progress and check it from the test goroutine
+	var progressLock sync.Mutex
+	progress := []*rpc.DownloadProgress{}
+	require.NoError(t, DownloadParallel(tasks, func(curr *rpc.DownloadProgress) {
+		progressLock.Lock()
+		progress = append(progress, curr)
+		progressLock.Unlock()
+	}))
+	progressLock.Lock()
+	defer progressLock.Unlock()
+	require.NotEmpty(t, progress)
+	for _, curr := range progress {
+		require.NotNil(t, curr.GetOverall())
+		if curr.GetFile() != "cached" {
+			require.NotEmpty(t, curr.GetUrl())
+		}
+	}
+	last := progress[len(progress)-1]
+	lock.Lock()
+	require.LessOrEqual(t, maxRunning, 2)
+	lock.Unlock()
+	require.Equal(t, int32(5), last.GetOverall().GetFiles())
+	require.Equal(t, int32(5), last.GetOverall().GetCompletedFiles())
+	require.Equal(t, int64(4*len(content)+100), last.GetOverall().GetTotalSize())
+	require.Equal(t, last.GetOverall().GetTotalSize(), last.GetOverall().GetDownloaded())
+	for i := 0; i < 4; i++ {
+		data, err := tmp.Join(fmt.Sprintf("file%d", i)).ReadFile()
+		require.NoError(t, err)
+		require.Equal(t, content, data)
+	}
+}
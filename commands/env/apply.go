@@ -0,0 +1,240 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package env
+
+import (
+	"context"
+
+	"github.com/arduino/arduino-cli/arduino"
+	"github.com/arduino/arduino-cli/arduino/cores"
+	"github.com/arduino/arduino-cli/arduino/cores/packagemanager"
+	"github.com/arduino/arduino-cli/commands"
+	"github.com/arduino/arduino-cli/commands/core"
+	"github.com/arduino/arduino-cli/commands/lib"
+	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
+)
+
+// Apply executes the changes of the Plan on the given instance. The platforms
+// and the tools to remove are removed first, since removing a platform may
+// remove also the platforms and the tools it requires, then the missing
+// platforms, tools and libraries of the Environment are installed. The
+// libraries to remove are removed at the end. Unavailable items are skipped.
+func Apply(ctx context.Context, instance *rpc.Instance, plan *Plan, downloadCB commands.DownloadProgressCB, taskCB commands.TaskProgressCB) error {
+	removed := false
+	for _, change := range plan.Platforms {
+		if change.Kind != Remove {
+			continue
+		}
+		if done, err := uninstallPlatform(ctx, instance, change, taskCB); err != nil {
+			return err
+		} else if done {
+			removed = true
+		}
+	}
+	if err := uninstallTools(instance, plan.Tools, taskCB); err != nil {
+		return err
+	}
+
+	install := plan
+	if removed {
+		var err error
+		if install, err = ComputePlan(ctx, instance, plan.env, false); err != nil {
+			return err
+		}
+	}
+
+	for _, change := range install.Platforms {
+		if change.Kind != Install && change.Kind != Upgrade && change.Kind != Downgrade {
+			continue
+		}
+		packager, architecture, err := splitID(change.ID)
+		if err != nil {
+			return err
+		}
+		_, err = core.PlatformInstall(ctx, &rpc.PlatformInstallRequest{
+			Instance:        instance,
+			PlatformPackage: packager,
+			Architecture:    architecture,
+			Version:         change.To,
+		}, downloadCB, taskCB)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, change := range install.Tools {
+		if change.Kind == Install {
+			if err := installTool(instance, change, downloadCB, taskCB); err != nil {
+				return err
+			}
+		}
+	}
+
+	for _, change := range plan.Libraries {
+		if change.Kind != Install && change.Kind != Upgrade && change.Kind != Downgrade {
+			continue
+		}
+		if change.library != nil && change.library.Origin == OriginGit {
+			url := change.library.URL
+			if change.library.Ref != "" {
+				url += "#" + change.library.Ref
+			}
+			err := lib.GitLibraryInstall(ctx, &rpc.GitLibraryInstallRequest{
+				Instance:  instance,
+				Url:       url,
+				Overwrite: true,
+			}, taskCB)
+			if err != nil {
+				return err
+			}
+			continue
+		}
+		err := lib.LibraryInstall(ctx, &rpc.LibraryInstallRequest{
+			Instance: instance,
+			Name:     change.ID,
+			Version:  change.To,
+			NoDeps:   true,
+		}, downloadCB, taskCB)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, change := range plan.Libraries {
+		if change.Kind != Remove {
+			continue
+		}
+		err := lib.LibraryUninstall(ctx, &rpc.LibraryUninstallRequest{
+			Instance: instance,
+			Name:     change.ID,
+			Force:    true,
+		}, taskCB)
+		if err != nil {
+			return err
+		}
+	}
+
+	return commands.Init(&rpc.InitRequest{Instance: instance}, nil)
+}
+
+// uninstallPlatform removes the platform of the change, unless it has already
+// been removed together with a platform requiring it. Returns true if the
+// platform has been removed.
+func uninstallPlatform(ctx context.Context, instance *rpc.Instance, change *Change, taskCB commands.TaskProgressCB) (bool, error) {
+	pm := commands.GetPackageManager(instance.GetId())
+	if pm == nil {
+		return false, &arduino.InvalidInstanceError{}
+	}
+	packager, architecture, err := splitID(change.ID)
+	if err != nil {
+		return false, err
+	}
+	platform := pm.FindPlatform(&packagemanager.PlatformReference{Package: packager, PlatformArchitecture: architecture})
+	if platform == nil || pm.GetInstalledPlatformRelease(platform) == nil {
+		return false, nil
+	}
+	_, err = core.PlatformUninstall(ctx, &rpc.PlatformUninstallRequest{
+		Instance:        instance,
+		PlatformPackage: packager,
+		Architecture:    architecture,
+	}, taskCB)
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// installTool installs the tool release of the change, the release may have
+// been installed in the meantime as a dependency of a platform
+func installTool(instance *rpc.Instance, change *Change, downloadCB commands.DownloadProgressCB, taskCB commands.TaskProgressCB) error {
+	pm := commands.GetPackageManager(instance.GetId())
+	if pm == nil {
+		return &arduino.InvalidInstanceError{}
+	}
+	toolRelease, err := findToolRelease(pm, change.ID, change.To)
+	if err != nil {
+		return err
+	}
+	if toolRelease == nil {
+		return &arduino.NotFoundError{Message: tr("Tool %[1]s@%[2]s not found", change.ID, change.To)}
+	}
+	if toolRelease.IsInstalled() {
+		taskCB(&rpc.TaskProgress{Name: tr("Tool %s already installed", toolRelease), Completed: true})
+		return nil
+	}
+	if err := commands.DownloadToolRelease(pm, toolRelease, downloadCB); err != nil {
+		return err
+	}
+	return commands.InstallToolRelease(pm, toolRelease, taskCB)
+}
+
+// uninstallTools removes the tool releases of the changes, except the ones
+// still required by an installed platform. The tools are removed together,
+// if any of them can't be removed the others are restored.
+func uninstallTools(instance *rpc.Instance, changes []*Change, taskCB commands.TaskProgressCB) error {
+	pm := commands.GetPackageManager(instance.GetId())
+	if pm == nil {
+		return &arduino.InvalidInstanceError{}
+	}
+	toolReleases := []*cores.ToolRelease{}
+	for _, change := range changes {
+		if change.Kind != Remove {
+			continue
+		}
+		toolRelease, err := findToolRelease(pm, change.ID, change.From)
+		if err != nil {
+			return err
+		}
+		if toolRelease == nil {
+			return &arduino.NotFoundError{Message: tr("Tool %[1]s@%[2]s not found", change.ID, change.From)}
+		}
+		if !toolRelease.IsInstalled() {
+			continue
+		}
+		if pm.IsToolRequired(toolRelease) {
+			taskCB(&rpc.TaskProgress{Message: tr("Tool %s is required by an installed platform, skipping", toolRelease), Completed: true})
+			continue
+		}
+		toolReleases = append(toolReleases, toolRelease)
+	}
+	if len(toolReleases) == 0 {
+		return nil
+	}
+
+	tx, err := pm.NewTransaction()
+	if err != nil {
+		return &arduino.FailedUninstallError{Message: tr("Error uninstalling tools"), Cause: err}
+	}
+	for _, toolRelease := range toolReleases {
+		log := pm.Log.WithField("Tool", toolRelease)
+		log.Info("Uninstalling tool")
+		taskCB(&rpc.TaskProgress{Name: tr("Uninstalling %s", toolRelease)})
+		if err := tx.RemoveTool(toolRelease); err != nil {
+			log.WithError(err).Error("Error uninstalling")
+			if rollbackErr := tx.Rollback(); rollbackErr != nil {
+				pm.Log.WithError(rollbackErr).Error("Error rolling-back changes.")
+				taskCB(&rpc.TaskProgress{Message: tr("Error rolling-back changes: %s", rollbackErr)})
+			}
+			return &arduino.FailedUninstallError{Message: tr("Error uninstalling tool %s", toolRelease), Cause: err}
+		}
+		log.Info("Tool uninstalled")
+		taskCB(&rpc.TaskProgress{Message: tr("Tool %s uninstalled", toolRelease), Completed: true})
+	}
+	if err := tx.Commit(); err != nil {
+		return &arduino.FailedUninstallError{Message: tr("Error uninstalling tools"), Cause: err}
+	}
+	return nil
+}
@@ -0,0 +1,228 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package env
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/arduino/arduino-cli/arduino"
+	"github.com/arduino/arduino-cli/arduino/cores/packagemanager"
+	"github.com/arduino/arduino-cli/arduino/libraries"
+	"github.com/arduino/arduino-cli/arduino/libraries/librariesindex"
+	"github.com/arduino/arduino-cli/arduino/libraries/librariesmanager"
+	"github.com/arduino/arduino-cli/commands"
+	"github.com/arduino/arduino-cli/i18n"
+	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
+	"github.com/arduino/go-paths-helper"
+	"github.com/spf13/viper"
+	"gopkg.in/yaml.v2"
+)
+
+var tr = i18n.Tr
+
+// The origins of an installed library
+const (
+	// OriginLibraryIndex is the origin of the libraries installed from the
+	// libraries index
+	OriginLibraryIndex = "library-index"
+	// OriginGit is the origin of the libraries installed from a git repository
+	OriginGit = "git"
+	// OriginLocal is the origin of the libraries installed from a zip file or
+	// copied manually, they can't be installed automatically
+	OriginLocal = "local"
+)
+
+// Environment describes the configuration, the platforms, the tools and the
+// libraries installed on a machine. It's saved as a YAML file in the form:
+//
+//	config:
+//	  board_manager:
+//	    additional_urls:
+//	      - https://example.com/package_example_index.json
+//	  directories:
+//	    data: /home/user/.arduino15
+//	    downloads: /home/user/.arduino15/staging
+//	    user: /home/user/Arduino
+//	platforms:
+//	  - id: arduino:avr
+//	    version: 1.8.3
+//	tools:
+//	  - id: arduino:avrdude
+//	    version: 6.3.0-arduino17
+//	libraries:
+//	  - name: Servo
+//	    version: 1.1.8
+//	    origin: library-index
+//	  - name: MyLib
+//	    version: 1.0.0
+//	    origin: git
+//	    url: https://github.com/example/MyLib.git
+//	    ref: 8a2f6cf2d9ba2f6ab4e0a4e9b8c4b0f7a3d2e1c4
+type Environment struct {
+	Config    Config     `yaml:"config" json:"config"`
+	Platforms []*Release `yaml:"platforms" json:"platforms"`
+	Tools     []*Release `yaml:"tools" json:"tools"`
+	Libraries []*Library `yaml:"libraries" json:"libraries"`
+}
+
+// Config is the part of the configuration saved in an Environment, missing
+// values are left unchanged when the Environment is applied
+type Config struct {
+	BoardManager struct {
+		AdditionalURLs []string `yaml:"additional_urls" json:"additional_urls"`
+	} `yaml:"board_manager" json:"board_manager"`
+	Directories struct {
+		Data      string `yaml:"data,omitempty" json:"data,omitempty"`
+		Downloads string `yaml:"downloads,omitempty" json:"downloads,omitempty"`
+		User      string `yaml:"user,omitempty" json:"user,omitempty"`
+	} `yaml:"directories" json:"directories"`
+}
+
+// Release is an installed release of a platform or a tool
+type Release struct {
+	// ID is the PACKAGER:ARCHITECTURE of a platform or PACKAGER:NAME of a tool
+	ID      string `yaml:"id" json:"id"`
+	Version string `yaml:"version" json:"version"`
+}
+
+// Library is an installed library
+type Library struct {
+	Name    string `yaml:"name" json:"name"`
+	Version string `yaml:"version" json:"version"`
+	// Origin is one of OriginLibraryIndex, OriginGit or OriginLocal
+	Origin string `yaml:"origin" json:"origin"`
+	// URL is the URL of the git repository
+	URL string `yaml:"url,omitempty" json:"url,omitempty"`
+	// Ref is the git commit
+	Ref string `yaml:"ref,omitempty" json:"ref,omitempty"`
+}
+
+// Load reads an Environment from a YAML file
+func Load(file *paths.Path) (*Environment, error) {
+	data, err := file.ReadFile()
+	if err != nil {
+		return nil, fmt.Errorf(tr("reading environment file: %s"), err)
+	}
+	env := &Environment{}
+	if err := yaml.UnmarshalStrict(data, env); err != nil {
+		return nil, fmt.Errorf(tr("parsing environment file %[1]s: %[2]s"), file, err)
+	}
+	for _, lib := range env.Libraries {
+		switch lib.Origin {
+		case OriginLibraryIndex, OriginLocal:
+		case OriginGit:
+			if lib.URL == "" {
+				return nil, fmt.Errorf(tr("parsing environment file %[1]s: library %[2]s has no git url"), file, lib.Name)
+			}
+		default:
+			return nil, fmt.Errorf(tr("parsing environment file %[1]s: library %[2]s has an invalid origin %[3]s"), file, lib.Name, lib.Origin)
+		}
+	}
+	return env, nil
+}
+
+// Marshal returns the Environment as YAML
+func (env *Environment) Marshal() ([]byte, error) {
+	return yaml.Marshal(env)
+}
+
+// Export returns the Environment of the given instance, the configuration is
+// read from the settings
+func Export(ctx context.Context, instance *rpc.Instance, settings *viper.Viper) (*Environment, error) {
+	pm := commands.GetPackageManager(instance.GetId())
+	if pm == nil {
+		return nil, &arduino.InvalidInstanceError{}
+	}
+	lm := commands.GetLibraryManager(instance.GetId())
+	if lm == nil {
+		return nil, &arduino.InvalidInstanceError{}
+	}
+
+	env := &Environment{
+		Platforms: exportPlatforms(pm),
+		Tools:     exportTools(pm),
+		Libraries: exportLibraries(lm),
+	}
+	env.Config.BoardManager.AdditionalURLs = []string{}
+	env.Config.BoardManager.AdditionalURLs = append(env.Config.BoardManager.AdditionalURLs, settings.GetStringSlice("board_manager.additional_urls")...)
+	env.Config.Directories.Data = settings.GetString("directories.Data")
+	env.Config.Directories.Downloads = settings.GetString("directories.Downloads")
+	env.Config.Directories.User = settings.GetString("directories.User")
+	return env, nil
+}
+
+// exportPlatforms returns the platforms installed through the Boards Manager,
+// the ones copied in the sketchbook can't be reproduced
+func exportPlatforms(pm *packagemanager.PackageManager) []*Release {
+	res := []*Release{}
+	for _, platformRelease := range installedPlatforms(pm) {
+		res = append(res, &Release{
+			ID:      platformRelease.Platform.String(),
+			Version: platformRelease.Version.String(),
+		})
+	}
+	return res
+}
+
+// exportTools returns the tools installed through the Boards Manager, the
+// builtin tools are skipped since they're installed automatically
+func exportTools(pm *packagemanager.PackageManager) []*Release {
+	res := []*Release{}
+	for _, toolRelease := range installedTools(pm) {
+		res = append(res, &Release{
+			ID:      toolRelease.Tool.String(),
+			Version: toolRelease.Version.String(),
+		})
+	}
+	return res
+}
+
+// exportLibraries returns the libraries installed in the sketchbook
+func exportLibraries(lm *librariesmanager.LibrariesManager) []*Library {
+	res := []*Library{}
+	for _, lib := range installedLibraries(lm) {
+		res = append(res, libraryOrigin(lm, lib))
+	}
+	return res
+}
+
+func libraryOrigin(lm *librariesmanager.LibrariesManager, lib *libraries.Library) *Library {
+	res := &Library{Name: libraryName(lib), Version: libraryVersion(lib), Origin: OriginLocal}
+	if lib.GitOrigin != nil {
+		res.Origin = OriginGit
+		res.URL = lib.GitOrigin.URL
+		res.Ref = lib.GitOrigin.Commit
+	} else if lib.Version != nil && lm.Index != nil && lm.Index.FindRelease(&librariesindex.Reference{Name: res.Name, Version: lib.Version}) != nil {
+		res.Origin = OriginLibraryIndex
+	}
+	return res
+}
+
+// installedLibraries returns the libraries installed in the sketchbook sorted by name
+func installedLibraries(lm *librariesmanager.LibrariesManager) libraries.List {
+	res := libraries.List{}
+	for _, alternatives := range lm.Libraries {
+		for _, lib := range alternatives.Alternatives {
+			if lib.Location == libraries.User {
+				res = append(res, lib)
+			}
+		}
+	}
+	sort.Slice(res, func(i, j int) bool { return res[i].Name < res[j].Name })
+	return res
+}
@@ -0,0 +1,57 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package env
+
+import (
+	"testing"
+
+	"github.com/arduino/go-paths-helper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoad(t *testing.T) {
+	env, err := Load(paths.New("testdata", "env.yaml"))
+	require.NoError(t, err)
+	require.Equal(t, []string{"https://example.com/package_test_index.json"}, env.Config.BoardManager.AdditionalURLs)
+	require.Equal(t, "/tmp/sketchbook", env.Config.Directories.User)
+	require.Empty(t, env.Config.Directories.Data)
+	require.Equal(t, []*Release{{ID: "test:avr", Version: "1.1.0"}, {ID: "test:sam", Version: "1.0.0"}}, env.Platforms)
+	require.Equal(t, []*Release{{ID: "test:gcc", Version: "1.0.0"}}, env.Tools)
+	require.Len(t, env.Libraries, 4)
+	require.Equal(t, &Library{
+		Name:    "MyLib",
+		Version: "1.0.0",
+		Origin:  OriginGit,
+		URL:     "https://example.com/MyLib.git",
+		Ref:     "8a2f6cf2d9ba2f6ab4e0a4e9b8c4b0f7a3d2e1c4",
+	}, env.Libraries[2])
+
+	// Round trip
+	data, err := env.Marshal()
+	require.NoError(t, err)
+	tmp := paths.New(t.TempDir()).Join("env.yaml")
+	require.NoError(t, tmp.WriteFile(data))
+	reloaded, err := Load(tmp)
+	require.NoError(t, err)
+	require.Equal(t, env, reloaded)
+
+	_, err = Load(paths.New("testdata", "invalid_origin.yaml"))
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "invalid origin zip")
+
+	_, err = Load(paths.New("testdata", "not-existent.yaml"))
+	require.Error(t, err)
+}
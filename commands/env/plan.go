@@ -0,0 +1,422 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package env
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"github.com/arduino/arduino-cli/arduino"
+	"github.com/arduino/arduino-cli/arduino/cores"
+	"github.com/arduino/arduino-cli/arduino/cores/packagemanager"
+	"github.com/arduino/arduino-cli/arduino/libraries"
+	"github.com/arduino/arduino-cli/arduino/libraries/librariesindex"
+	"github.com/arduino/arduino-cli/arduino/libraries/librariesmanager"
+	"github.com/arduino/arduino-cli/commands"
+	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
+	"github.com/spf13/viper"
+	semver "go.bug.st/relaxed-semver"
+)
+
+// ChangeKind is the kind of change needed to converge to an Environment
+type ChangeKind string
+
+// The kinds of change
+const (
+	// Install installs a missing item
+	Install ChangeKind = "install"
+	// Upgrade replaces an item with a newer version
+	Upgrade ChangeKind = "upgrade"
+	// Downgrade replaces an item with an older version
+	Downgrade ChangeKind = "downgrade"
+	// Remove removes an item not in the Environment
+	Remove ChangeKind = "remove"
+	// Unavailable marks an item that can't be installed automatically
+	Unavailable ChangeKind = "unavailable"
+)
+
+// Change is a change needed to converge to an Environment
+type Change struct {
+	Kind ChangeKind `json:"kind"`
+	// ID is the PACKAGER:ARCHITECTURE of a platform, the PACKAGER:NAME of
+	// a tool or the name of a library
+	ID string `json:"id"`
+	// From is the installed version, empty if not installed
+	From string `json:"from,omitempty"`
+	// To is the version in the Environment, empty if the item is removed
+	To string `json:"to,omitempty"`
+	// Reason explains why an item is Unavailable
+	Reason string `json:"reason,omitempty"`
+
+	library *Library
+}
+
+// ConfigChange is a change of a configuration key
+type ConfigChange struct {
+	Key  string `json:"key"`
+	From string `json:"from"`
+	To   string `json:"to"`
+
+	value interface{}
+}
+
+// Plan lists the changes needed to converge to an Environment
+type Plan struct {
+	Platforms []*Change `json:"platforms"`
+	Tools     []*Change `json:"tools"`
+	Libraries []*Change `json:"libraries"`
+
+	env *Environment
+}
+
+// IsEmpty returns true if there is nothing to change
+func (plan *Plan) IsEmpty() bool {
+	return len(plan.Platforms)+len(plan.Tools)+len(plan.Libraries) == 0
+}
+
+// ConfigChanges returns the changes to the settings needed to converge to the
+// configuration of the Environment
+func ConfigChanges(env *Environment, settings *viper.Viper) []*ConfigChange {
+	res := []*ConfigChange{}
+	urls := settings.GetStringSlice("board_manager.additional_urls")
+	if value := env.Config.BoardManager.AdditionalURLs; value != nil && strings.Join(urls, ",") != strings.Join(value, ",") {
+		res = append(res, &ConfigChange{
+			Key:   "board_manager.additional_urls",
+			From:  strings.Join(urls, ","),
+			To:    strings.Join(value, ","),
+			value: value,
+		})
+	}
+	directories := []struct{ key, value string }{
+		{"directories.Data", env.Config.Directories.Data},
+		{"directories.Downloads", env.Config.Directories.Downloads},
+		{"directories.User", env.Config.Directories.User},
+	}
+	for _, dir := range directories {
+		if current := settings.GetString(dir.key); dir.value != "" && dir.value != current {
+			res = append(res, &ConfigChange{Key: dir.key, From: current, To: dir.value, value: dir.value})
+		}
+	}
+	return res
+}
+
+// ApplyConfigChanges sets the changed keys in the settings, the settings are
+// not written to the configuration file
+func ApplyConfigChanges(changes []*ConfigChange, settings *viper.Viper) {
+	for _, change := range changes {
+		settings.Set(change.Key, change.value)
+	}
+}
+
+// ComputePlan returns the changes needed to converge the platforms, the tools
+// and the libraries of the given instance to the Environment. If removeExtras
+// is true the items not in the Environment are removed.
+func ComputePlan(ctx context.Context, instance *rpc.Instance, env *Environment, removeExtras bool) (*Plan, error) {
+	pm := commands.GetPackageManager(instance.GetId())
+	if pm == nil {
+		return nil, &arduino.InvalidInstanceError{}
+	}
+	lm := commands.GetLibraryManager(instance.GetId())
+	if lm == nil {
+		return nil, &arduino.InvalidInstanceError{}
+	}
+	return computePlan(pm, lm, env, removeExtras)
+}
+
+func computePlan(pm *packagemanager.PackageManager, lm *librariesmanager.LibrariesManager, env *Environment, removeExtras bool) (*Plan, error) {
+	platforms, desiredPlatforms, err := planPlatforms(pm, env, removeExtras)
+	if err != nil {
+		return nil, err
+	}
+	tools, err := planTools(pm, env, desiredPlatforms, removeExtras)
+	if err != nil {
+		return nil, err
+	}
+	libs, err := planLibraries(lm, env, removeExtras)
+	if err != nil {
+		return nil, err
+	}
+	return &Plan{Platforms: platforms, Tools: tools, Libraries: libs, env: env}, nil
+}
+
+// planPlatforms returns the changes to the platforms and the releases of the
+// platforms in the Environment found in the package index
+func planPlatforms(pm *packagemanager.PackageManager, env *Environment, removeExtras bool) ([]*Change, []*cores.PlatformRelease, error) {
+	changes := []*Change{}
+	desired := []*cores.PlatformRelease{}
+	inEnv := map[string]bool{}
+	for _, p := range env.Platforms {
+		packager, architecture, err := splitID(p.ID)
+		if err != nil {
+			return nil, nil, err
+		}
+		version, err := semver.Parse(p.Version)
+		if err != nil {
+			return nil, nil, &arduino.InvalidVersionError{Cause: err}
+		}
+		inEnv[p.ID] = true
+
+		platform := pm.FindPlatform(&packagemanager.PlatformReference{Package: packager, PlatformArchitecture: architecture})
+		var installed, release *cores.PlatformRelease
+		if platform != nil {
+			installed = pm.GetInstalledPlatformRelease(platform)
+			release = platform.FindReleaseWithVersion(version)
+		}
+		if release != nil {
+			desired = append(desired, release)
+		}
+		if installed != nil && installed.Version.Equal(version) {
+			continue
+		}
+		change := &Change{ID: p.ID, To: version.String()}
+		if installed != nil {
+			change.From = installed.Version.String()
+		}
+		switch {
+		case release == nil:
+			change.Kind = Unavailable
+			change.Reason = tr("not found in the package index")
+		case installed == nil:
+			change.Kind = Install
+		case installed.Version.LessThan(version):
+			change.Kind = Upgrade
+		default:
+			change.Kind = Downgrade
+		}
+		changes = append(changes, change)
+	}
+
+	if removeExtras {
+		extras := []*cores.PlatformRelease{}
+		for _, installed := range installedPlatforms(pm) {
+			if !inEnv[installed.Platform.String()] && !isRequiredByPlatforms(installed, desired) {
+				extras = append(extras, installed)
+			}
+		}
+		for _, extra := range sortByDependents(extras) {
+			changes = append(changes, &Change{Kind: Remove, ID: extra.Platform.String(), From: extra.Version.String()})
+		}
+	}
+	return changes, desired, nil
+}
+
+// sortByDependents sorts the platform releases so that the releases requiring
+// another platform come before it, a platform required by another installed
+// platform can't be removed.
+func sortByDependents(releases []*cores.PlatformRelease) []*cores.PlatformRelease {
+	res := []*cores.PlatformRelease{}
+	for len(releases) > 0 {
+		next := []*cores.PlatformRelease{}
+		for _, release := range releases {
+			if isRequiredByPlatforms(release, releases) {
+				next = append(next, release)
+			} else {
+				res = append(res, release)
+			}
+		}
+		if len(next) == len(releases) {
+			// Circular dependencies, keep the remaining order
+			return append(res, next...)
+		}
+		releases = next
+	}
+	return res
+}
+
+// isRequiredByPlatforms returns true if one of the platforms depends on the
+// platform of the release
+func isRequiredByPlatforms(release *cores.PlatformRelease, platforms []*cores.PlatformRelease) bool {
+	for _, platform := range platforms {
+		for _, dep := range platform.PlatformDependencies {
+			if dep.Packager == release.Platform.Package.Name && dep.Architecture == release.Platform.Architecture {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func planTools(pm *packagemanager.PackageManager, env *Environment, desiredPlatforms []*cores.PlatformRelease, removeExtras bool) ([]*Change, error) {
+	changes := []*Change{}
+	inEnv := map[*cores.ToolRelease]bool{}
+	for _, t := range env.Tools {
+		release, err := findToolRelease(pm, t.ID, t.Version)
+		if err != nil {
+			return nil, err
+		}
+		if release == nil {
+			changes = append(changes, &Change{Kind: Unavailable, ID: t.ID, To: t.Version, Reason: tr("not found in the package index")})
+			continue
+		}
+		inEnv[release] = true
+		if !release.IsInstalled() {
+			changes = append(changes, &Change{Kind: Install, ID: t.ID, To: t.Version})
+		}
+	}
+
+	if removeExtras {
+		for _, platformRelease := range desiredPlatforms {
+			tools, err := pm.FindPlatformReleaseTools(platformRelease)
+			if err != nil {
+				return nil, &arduino.NotFoundError{Message: tr("Can't find dependencies for platform %s", platformRelease), Cause: err}
+			}
+			for _, tool := range tools {
+				inEnv[tool] = true
+			}
+		}
+		for _, installed := range installedTools(pm) {
+			if !inEnv[installed] {
+				changes = append(changes, &Change{Kind: Remove, ID: installed.Tool.String(), From: installed.Version.String()})
+			}
+		}
+	}
+	return changes, nil
+}
+
+func planLibraries(lm *librariesmanager.LibrariesManager, env *Environment, removeExtras bool) ([]*Change, error) {
+	installed := map[string]*libraries.Library{}
+	for _, lib := range installedLibraries(lm) {
+		installed[libraryName(lib)] = lib
+	}
+
+	changes := []*Change{}
+	inEnv := map[string]bool{}
+	for _, l := range env.Libraries {
+		inEnv[l.Name] = true
+		current := installed[l.Name]
+		change := &Change{ID: l.Name, To: l.Version, library: l}
+		if current != nil {
+			change.From = libraryVersion(current)
+		}
+
+		switch l.Origin {
+		case OriginGit:
+			if current != nil && current.GitOrigin != nil && current.GitOrigin.Commit == l.Ref {
+				continue
+			}
+			change.Kind = Install
+		case OriginLocal:
+			if current != nil && libraryVersion(current) == l.Version {
+				continue
+			}
+			change.Kind = Unavailable
+			change.Reason = tr("must be installed manually")
+		default:
+			version, err := semver.Parse(l.Version)
+			if err != nil {
+				return nil, &arduino.InvalidVersionError{Cause: err}
+			}
+			if current != nil && current.Version != nil && current.Version.Equal(version) {
+				continue
+			}
+			switch {
+			case lm.Index == nil || lm.Index.FindRelease(&librariesindex.Reference{Name: l.Name, Version: version}) == nil:
+				change.Kind = Unavailable
+				change.Reason = tr("not found in the libraries index")
+			case current == nil:
+				change.Kind = Install
+			case current.Version == nil || current.Version.LessThan(version):
+				change.Kind = Upgrade
+			default:
+				change.Kind = Downgrade
+			}
+		}
+		changes = append(changes, change)
+	}
+
+	if removeExtras {
+		for _, lib := range installedLibraries(lm) {
+			if name := libraryName(lib); !inEnv[name] {
+				changes = append(changes, &Change{Kind: Remove, ID: name, From: libraryVersion(lib)})
+			}
+		}
+	}
+	return changes, nil
+}
+
+// installedPlatforms returns the platform releases installed through the
+// Boards Manager sorted by ID
+func installedPlatforms(pm *packagemanager.PackageManager) []*cores.PlatformRelease {
+	res := []*cores.PlatformRelease{}
+	for _, targetPackage := range pm.Packages {
+		for _, platform := range targetPackage.Platforms {
+			if release := pm.GetInstalledPlatformRelease(platform); release != nil && pm.IsManagedPlatformRelease(release) {
+				res = append(res, release)
+			}
+		}
+	}
+	sort.Slice(res, func(i, j int) bool { return res[i].Platform.String() < res[j].Platform.String() })
+	return res
+}
+
+// installedTools returns the tool releases installed through the Boards
+// Manager sorted by ID, the builtin tools are skipped
+func installedTools(pm *packagemanager.PackageManager) []*cores.ToolRelease {
+	res := []*cores.ToolRelease{}
+	for _, release := range pm.GetAllInstalledToolsReleases() {
+		if release.Tool.Package.Name == "builtin" || !pm.IsManagedToolRelease(release) {
+			continue
+		}
+		res = append(res, release)
+	}
+	sort.Slice(res, func(i, j int) bool {
+		if res[i].Tool.String() != res[j].Tool.String() {
+			return res[i].Tool.String() < res[j].Tool.String()
+		}
+		return res[i].Version.LessThan(res[j].Version)
+	})
+	return res
+}
+
+// libraryName returns the name of the library as found in the libraries index
+func libraryName(lib *libraries.Library) string {
+	if lib.RealName != "" {
+		return lib.RealName
+	}
+	return lib.Name
+}
+
+// libraryVersion returns the version of the library, empty if the library
+// has no valid version
+func libraryVersion(lib *libraries.Library) string {
+	if lib.Version == nil {
+		return ""
+	}
+	return lib.Version.String()
+}
+
+// findToolRelease returns the release of the tool with the given PACKAGER:NAME
+// and version, nil if the release is not in the package index
+func findToolRelease(pm *packagemanager.PackageManager, id, version string) (*cores.ToolRelease, error) {
+	if _, _, err := splitID(id); err != nil {
+		return nil, err
+	}
+	tool := pm.GetTool(id)
+	if tool == nil {
+		return nil, nil
+	}
+	return tool.FindReleaseWithRelaxedVersion(semver.ParseRelaxed(version)), nil
+}
+
+// splitID splits a PACKAGER:ARCHITECTURE or PACKAGER:NAME identifier
+func splitID(id string) (string, string, error) {
+	split := strings.Split(id, ":")
+	if len(split) != 2 || split[0] == "" || split[1] == "" {
+		return "", "", &arduino.InvalidArgumentError{Message: tr("Invalid identifier %s, it must be in the form PACKAGER:NAME", id)}
+	}
+	return split[0], split[1], nil
+}
@@ -0,0 +1,168 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package env
+
+import (
+	"context"
+	"testing"
+
+	"github.com/arduino/arduino-cli/arduino/cores/packagemanager"
+	"github.com/arduino/arduino-cli/arduino/libraries"
+	"github.com/arduino/arduino-cli/arduino/libraries/librariesmanager"
+	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
+	"github.com/arduino/go-paths-helper"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+	semver "go.bug.st/relaxed-semver"
+)
+
+func TestConfigChanges(t *testing.T) {
+	env, err := Load(paths.New("testdata", "env.yaml"))
+	require.NoError(t, err)
+
+	settings := viper.New()
+	settings.Set("board_manager.additional_urls", []string{})
+	settings.Set("directories.Data", "/tmp/data")
+	settings.Set("directories.User", "/tmp/user")
+	changes := ConfigChanges(env, settings)
+	require.Len(t, changes, 2)
+	require.Equal(t, "board_manager.additional_urls", changes[0].Key)
+	require.Equal(t, "https://example.com/package_test_index.json", changes[0].To)
+	require.Equal(t, "directories.User", changes[1].Key)
+	require.Equal(t, "/tmp/user", changes[1].From)
+
+	ApplyConfigChanges(changes, settings)
+	require.Equal(t, []string{"https://example.com/package_test_index.json"}, settings.GetStringSlice("board_manager.additional_urls"))
+	require.Equal(t, "/tmp/sketchbook", settings.GetString("directories.User"))
+	require.Equal(t, "/tmp/data", settings.GetString("directories.Data"))
+	require.Empty(t, ConfigChanges(env, settings))
+}
+
+func TestComputePlan(t *testing.T) {
+	dataDir := paths.New(t.TempDir())
+	packagesDir := dataDir.Join("packages")
+	pm := packagemanager.NewPackageManager(dataDir, packagesDir, dataDir.Join("staging"), dataDir.Join("tmp"), "test")
+	_, err := pm.LoadPackageIndexFromFile(paths.New("testdata", "package_test_index.json"))
+	require.NoError(t, err)
+
+	// Fake the installation of test:avr@1.0.0, test:samd@1.0.0 and test:gcc@2.0.0
+	for _, platformID := range []string{"avr", "samd"} {
+		release := pm.Packages["test"].Platforms[platformID].FindReleaseWithVersion(semver.MustParse("1.0.0"))
+		release.InstallDir = packagesDir.Join("test", "hardware", platformID, "1.0.0")
+		require.NoError(t, release.InstallDir.MkdirAll())
+	}
+	gcc := pm.Packages["test"].Tools["gcc"].FindReleaseWithRelaxedVersion(semver.ParseRelaxed("2.0.0"))
+	gcc.InstallDir = packagesDir.Join("test", "tools", "gcc", "2.0.0")
+	require.NoError(t, gcc.InstallDir.MkdirAll())
+
+	lm := librariesmanager.NewLibraryManager(paths.New("testdata"), nil)
+	require.NoError(t, lm.LoadIndex())
+	installLib := func(lib *libraries.Library) {
+		lib.Location = libraries.User
+		lm.Libraries[lib.Name] = &librariesmanager.LibraryAlternatives{Alternatives: libraries.List{lib}}
+	}
+	installLib(&libraries.Library{Name: "Servo", Version: semver.MustParse("1.1.0")})
+	installLib(&libraries.Library{Name: "Extra", Version: semver.MustParse("1.0.0")})
+	installLib(&libraries.Library{Name: "MyLib", Version: semver.MustParse("1.0.0"), GitOrigin: &libraries.GitOrigin{
+		URL:    "https://example.com/MyLib.git",
+		Commit: "0000000000000000000000000000000000000000",
+	}})
+
+	env, err := Load(paths.New("testdata", "env.yaml"))
+	require.NoError(t, err)
+
+	plan, err := computePlan(pm, lm, env, false)
+	require.NoError(t, err)
+	require.Equal(t, []*Change{
+		{Kind: Upgrade, ID: "test:avr", From: "1.0.0", To: "1.1.0"},
+		{Kind: Unavailable, ID: "test:sam", To: "1.0.0", Reason: "not found in the package index"},
+	}, plan.Platforms)
+	require.Equal(t, []*Change{
+		{Kind: Install, ID: "test:gcc", To: "1.0.0"},
+	}, plan.Tools)
+	require.Len(t, plan.Libraries, 4)
+	require.Equal(t, Downgrade, plan.Libraries[0].Kind)
+	require.Equal(t, "1.1.0", plan.Libraries[0].From)
+	require.Equal(t, Install, plan.Libraries[1].Kind)
+	require.Equal(t, "Wire", plan.Libraries[1].ID)
+	require.Equal(t, Install, plan.Libraries[2].Kind)
+	require.Equal(t, "MyLib", plan.Libraries[2].ID)
+	require.Equal(t, Unavailable, plan.Libraries[3].Kind)
+	require.Equal(t, "Private", plan.Libraries[3].ID)
+
+	// The extra platforms and libraries are removed, test:gcc@2.0.0 is kept
+	// since it's required by test:avr@1.1.0
+	plan, err = computePlan(pm, lm, env, true)
+	require.NoError(t, err)
+	require.Equal(t, &Change{Kind: Remove, ID: "test:samd", From: "1.0.0"}, plan.Platforms[2])
+	require.Len(t, plan.Platforms, 3)
+	require.Len(t, plan.Tools, 1)
+	require.Equal(t, &Change{Kind: Remove, ID: "Extra", From: "1.0.0"}, plan.Libraries[4])
+	require.Len(t, plan.Libraries, 5)
+
+	// Once converged there's nothing to do
+	env.Platforms = env.Platforms[:1]
+	env.Platforms[0].Version = "1.0.0"
+	env.Tools = []*Release{{ID: "test:gcc", Version: "2.0.0"}}
+	env.Libraries = []*Library{
+		{Name: "Servo", Version: "1.1.0", Origin: OriginLibraryIndex},
+		{Name: "MyLib", Version: "1.0.0", Origin: OriginGit, URL: "https://example.com/MyLib.git", Ref: "0000000000000000000000000000000000000000"},
+	}
+	plan, err = computePlan(pm, lm, env, false)
+	require.NoError(t, err)
+	require.True(t, plan.IsEmpty())
+
+	env.Platforms[0].ID = "invalid"
+	_, err = computePlan(pm, lm, env, false)
+	require.Error(t, err)
+}
+
+func TestComputeTargetPlan(t *testing.T) {
+	dataDir := paths.New(t.TempDir())
+	require.NoError(t, dataDir.Join("package_index.json").WriteFile([]byte(`{"packages":[]}`)))
+	require.NoError(t, paths.New("testdata", "library_index.json").CopyTo(dataDir.Join("library_index.json")))
+
+	settings := viper.New()
+	settings.Set("board_manager.additional_urls", []string{})
+	settings.Set("directories.Data", dataDir.String())
+	settings.Set("directories.User", dataDir.Join("user").String())
+
+	env, err := Load(paths.New("testdata", "env.yaml"))
+	require.NoError(t, err)
+	testIndex, err := paths.New("testdata", "package_test_index.json").Abs()
+	require.NoError(t, err)
+	sketchbook := dataDir.Join("sketchbook")
+	env.Config.BoardManager.AdditionalURLs = []string{"file://" + testIndex.String()}
+	env.Config.Directories.User = sketchbook.String()
+
+	// The platforms of the new board manager URLs are available
+	changes := ConfigChanges(env, settings)
+	require.Len(t, changes, 2)
+	plan, err := ComputeTargetPlan(context.Background(), env, settings, changes, false, func(*rpc.DownloadProgress) {})
+	require.NoError(t, err)
+	require.Equal(t, []*Change{
+		{Kind: Install, ID: "test:avr", To: "1.1.0"},
+		{Kind: Unavailable, ID: "test:sam", To: "1.0.0", Reason: "not found in the package index"},
+	}, plan.Platforms)
+	require.Equal(t, Install, plan.Libraries[0].Kind)
+	require.Equal(t, "Servo", plan.Libraries[0].ID)
+
+	// Nothing has been changed
+	require.Empty(t, settings.GetStringSlice("board_manager.additional_urls"))
+	require.Equal(t, dataDir.Join("user").String(), settings.GetString("directories.User"))
+	require.False(t, sketchbook.Exist())
+	require.False(t, dataDir.Join("packages").Exist())
+}
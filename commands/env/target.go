@@ -0,0 +1,169 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package env
+
+import (
+	"context"
+	"net/url"
+	"path"
+
+	"github.com/arduino/arduino-cli/arduino"
+	"github.com/arduino/arduino-cli/arduino/cores/packagemanager"
+	"github.com/arduino/arduino-cli/arduino/libraries"
+	"github.com/arduino/arduino-cli/arduino/libraries/librariesmanager"
+	"github.com/arduino/arduino-cli/arduino/utils"
+	"github.com/arduino/arduino-cli/cli/globals"
+	"github.com/arduino/arduino-cli/commands"
+	"github.com/arduino/arduino-cli/configuration"
+	paths "github.com/arduino/go-paths-helper"
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/viper"
+	"go.bug.st/downloader/v2"
+)
+
+// ComputeTargetPlan returns the changes needed to converge to the Environment
+// once the configuration changes are applied to the settings, without changing
+// anything: neither the settings nor the directories are modified. The
+// platforms, tools and libraries are loaded from the target directories, the
+// indexes of the new board manager URLs and the indexes missing from the
+// target data directory are downloaded in a temporary directory.
+func ComputeTargetPlan(ctx context.Context, env *Environment, settings *viper.Viper, changes []*ConfigChange, removeExtras bool, downloadCB commands.DownloadProgressCB) (*Plan, error) {
+	target := viper.New()
+	for _, key := range settings.AllKeys() {
+		target.Set(key, settings.Get(key))
+	}
+	ApplyConfigChanges(changes, target)
+
+	tmp, err := paths.MkTempDir("", "env_plan")
+	if err != nil {
+		return nil, &arduino.TempDirCreationFailedError{Cause: err}
+	}
+	defer tmp.RemoveAll()
+
+	pm, err := loadTargetPackageManager(target, settings.GetStringSlice("board_manager.additional_urls"), tmp, downloadCB)
+	if err != nil {
+		return nil, err
+	}
+	lm, err := loadTargetLibrariesManager(target, pm, tmp, downloadCB)
+	if err != nil {
+		return nil, err
+	}
+	return computePlan(pm, lm, env, removeExtras)
+}
+
+// loadTargetPackageManager returns a PackageManager with the package indexes
+// of the board manager URLs and the hardware of the directories in the target
+// settings. The indexes not already downloaded for the current URLs are
+// downloaded in tmp.
+func loadTargetPackageManager(target *viper.Viper, currentURLs []string, tmp *paths.Path, downloadCB commands.DownloadProgressCB) (*packagemanager.PackageManager, error) {
+	dataDir := paths.New(target.GetString("directories.Data"))
+	pm := packagemanager.NewPackageManager(
+		dataDir,
+		configuration.PackagesDir(target),
+		paths.New(target.GetString("directories.Downloads")),
+		tmp,
+		"arduino-cli/"+globals.VersionInfo.VersionString,
+	)
+
+	current := map[string]bool{globals.DefaultIndexURL: true}
+	for _, u := range currentURLs {
+		current[u] = true
+	}
+	urls := append([]string{globals.DefaultIndexURL}, target.GetStringSlice("board_manager.additional_urls")...)
+	for _, u := range urls {
+		URL, err := utils.URLParse(u)
+		if err != nil {
+			return nil, &arduino.InvalidURLError{Cause: err}
+		}
+		indexFile := dataDir.Join(path.Base(URL.Path))
+		if URL.Scheme == "file" {
+			indexFile = paths.New(URL.Path)
+		} else if !current[u] || indexFile.NotExist() {
+			indexFile = tmp.Join(path.Base(URL.Path))
+			if err := downloadIndex(URL, indexFile, downloadCB); err != nil {
+				return nil, err
+			}
+		}
+		index, err := pm.LoadPackageIndexFromFile(indexFile)
+		if err != nil {
+			return nil, &arduino.InvalidArgumentError{Message: tr("Invalid package index in %s", URL), Cause: err}
+		}
+		for _, p := range index.Packages {
+			p.URL = URL.String()
+		}
+	}
+
+	for _, err := range pm.LoadHardwareFromDirectories(configuration.HardwareDirectories(target)) {
+		logrus.WithError(err.Err()).Warn("Loading hardware")
+	}
+	for _, err := range pm.LoadToolsFromBundleDirectories(configuration.BundleToolsDirectories(target)) {
+		logrus.WithError(err.Err()).Warn("Loading bundled tools")
+	}
+	return pm, nil
+}
+
+// loadTargetLibrariesManager returns a LibrariesManager with the libraries of
+// the directories in the target settings and of the platforms installed in pm.
+// The libraries index is downloaded in tmp if missing from the target data
+// directory.
+func loadTargetLibrariesManager(target *viper.Viper, pm *packagemanager.PackageManager, tmp *paths.Path, downloadCB commands.DownloadProgressCB) (*librariesmanager.LibrariesManager, error) {
+	lm := librariesmanager.NewLibraryManager(paths.New(target.GetString("directories.Data")), nil)
+	if lm.IndexFile.NotExist() {
+		indexGz := tmp.Join("library_index.json.gz")
+		if err := downloadIndex(librariesmanager.LibraryIndexGZURL, indexGz, downloadCB); err != nil {
+			return nil, err
+		}
+		lm.IndexFile = tmp.Join("library_index.json")
+		if err := paths.GUnzip(indexGz, lm.IndexFile); err != nil {
+			return nil, &arduino.PermissionDeniedError{Message: tr("Error extracting library_index.json.gz"), Cause: err}
+		}
+	}
+	if err := lm.LoadIndex(); err != nil {
+		return nil, &arduino.InvalidArgumentError{Message: tr("Invalid library index in %s", lm.IndexFile), Cause: err}
+	}
+
+	if bundledLibsDir := configuration.IDEBundledLibrariesDir(target); bundledLibsDir != nil {
+		lm.AddLibrariesDir(bundledLibsDir, libraries.IDEBuiltIn)
+	}
+	lm.AddLibrariesDir(configuration.LibrariesDir(target), libraries.User)
+	for _, targetPackage := range pm.Packages {
+		for _, platform := range targetPackage.Platforms {
+			if platformRelease := pm.GetInstalledPlatformRelease(platform); platformRelease != nil {
+				lm.AddPlatformReleaseLibrariesDir(platformRelease, libraries.PlatformBuiltIn)
+			}
+		}
+	}
+	for _, err := range lm.RescanLibraries() {
+		logrus.WithError(err.Err()).Warn("Loading libraries")
+	}
+	return lm, nil
+}
+
+// downloadIndex downloads the index at URL in the file dest
+func downloadIndex(URL *url.URL, dest *paths.Path, downloadCB commands.DownloadProgressCB) error {
+	config, err := commands.GetDownloaderConfig()
+	if err != nil {
+		return err
+	}
+	d, err := downloader.DownloadWithConfig(dest.String(), URL.String(), *config, downloader.NoResume)
+	if err == nil {
+		err = commands.Download(d, tr("Downloading index: %s", dest.Base()), downloadCB)
+	}
+	if err != nil {
+		return &arduino.FailedDownloadError{Message: tr("Error downloading index '%s'", URL), Cause: err}
+	}
+	return nil
+}
@@ -17,7 +17,6 @@ package commands
 
 import (
 	"context"
-	"errors"
 	"fmt"
 	"io/ioutil"
 	"net/url"
@@ -140,6 +139,8 @@ func Create(req *rpc.CreateRequest, extraUserAgent ...string) (*rpc.CreateRespon
 		dataDir.Join("tmp"),
 		userAgent,
 	)
+	instance.PackageManager.Keyring = security.NewKeyring(configuration.KeyringDir(configuration.Settings))
+	instance.PackageManager.RequireSignedIndexes = configuration.Settings.GetBool("board_manager.require_signed_indexes")
 
 	// Create library manager and add libraries directories
 	instance.lm = librariesmanager.NewLibraryManager(
@@ -437,6 +438,9 @@ func UpdateIndex(ctx context.Context, req *rpc.UpdateIndexRequest, downloadCB Do
 
 	indexpath := paths.New(configuration.Settings.GetString("directories.Data"))
 
+	keyring := security.NewKeyring(configuration.KeyringDir(configuration.Settings))
+	requireSigned := configuration.Settings.GetBool("board_manager.require_signed_indexes")
+
 	urls := []string{globals.DefaultIndexURL}
 	urls = append(urls, configuration.Settings.GetStringSlice("board_manager.additional_urls")...)
 	for _, u := range urls {
@@ -489,39 +493,59 @@ func UpdateIndex(ctx context.Context, req *rpc.UpdateIndexRequest, downloadCB Do
 		}
 
 		// Check for signature
+		URLSig, err := url.Parse(URL.String())
+		if err != nil {
+			return nil, &arduino.InvalidURLError{Cause: err}
+		}
+		URLSig.Path += ".sig"
+		coreIndexSigPath := indexpath.Join(path.Base(URLSig.Path))
+
+		// The Arduino indexes must be signed with the Arduino key, the other
+		// indexes may be signed with any key of the user keyring
+		isArduinoIndex := URL.Hostname() == "downloads.arduino.cc"
+		signatureRequired := isArduinoIndex || requireSigned
+		verifySignature := keyring.VerifyDetachedSignature
+		if isArduinoIndex {
+			verifySignature = security.VerifyArduinoDetachedSignature
+		}
+
 		var tmpSig *paths.Path
-		var coreIndexSigPath *paths.Path
-		if URL.Hostname() == "downloads.arduino.cc" {
-			URLSig, err := url.Parse(URL.String())
-			if err != nil {
-				return nil, &arduino.InvalidURLError{Cause: err}
-			}
-			URLSig.Path += ".sig"
-
-			if t, err := ioutil.TempFile("", ""); err != nil {
-				return nil, &arduino.TempFileCreationFailedError{Cause: err}
-			} else if err := t.Close(); err != nil {
-				return nil, &arduino.TempFileCreationFailedError{Cause: err}
-			} else {
-				tmpSig = paths.New(t.Name())
-			}
-			defer tmpSig.Remove()
+		if t, err := ioutil.TempFile("", ""); err != nil {
+			return nil, &arduino.TempFileCreationFailedError{Cause: err}
+		} else if err := t.Close(); err != nil {
+			return nil, &arduino.TempFileCreationFailedError{Cause: err}
+		} else {
+			tmpSig = paths.New(t.Name())
+		}
+		defer tmpSig.Remove()
 
-			d, err := downloader.DownloadWithConfig(tmpSig.String(), URLSig.String(), *config)
-			if err != nil {
+		sigDownloadCB := downloadCB
+		if !signatureRequired {
+			// Most third party indexes are not signed, don't show a failed
+			// download to the user
+			sigDownloadCB = func(*rpc.DownloadProgress) {}
+		}
+		if d, err := downloader.DownloadWithConfig(tmpSig.String(), URLSig.String(), *config); err != nil {
+			if signatureRequired {
 				return nil, &arduino.FailedDownloadError{Message: tr("Error downloading index signature '%s'", URLSig), Cause: err}
 			}
-
-			coreIndexSigPath = indexpath.Join(path.Base(URLSig.Path))
-			Download(d, tr("Updating index: %s", coreIndexSigPath.Base()), downloadCB)
-			if d.Error() != nil {
+			tmpSig = nil
+		} else if err := Download(d, tr("Updating index: %s", coreIndexSigPath.Base()), sigDownloadCB); err != nil {
+			if signatureRequired {
 				return nil, &arduino.FailedDownloadError{Message: tr("Error downloading index signature '%s'", URLSig), Cause: err}
 			}
+			tmpSig = nil
+		}
 
-			if valid, _, err := security.VerifyArduinoDetachedSignature(tmp, tmpSig); err != nil {
+		if tmpSig != nil {
+			valid, _, err := verifySignature(tmp, tmpSig)
+			if err != nil && signatureRequired {
 				return nil, &arduino.PermissionDeniedError{Message: tr("Error verifying signature"), Cause: err}
-			} else if !valid {
+			} else if !valid && signatureRequired {
 				return nil, &arduino.SignatureVerificationFailedError{File: URL.String()}
+			} else if !valid {
+				logrus.WithField("url", URL).WithError(err).Warn("Ignoring index signature not made with a trusted key")
+				tmpSig = nil
 			}
 		}
 
@@ -540,6 +564,11 @@ func UpdateIndex(ctx context.Context, req *rpc.UpdateIndexRequest, downloadCB Do
 			if err := tmpSig.CopyTo(coreIndexSigPath); err != nil {
 				return nil, &arduino.PermissionDeniedError{Message: tr("Error saving downloaded index signature"), Cause: err}
 			}
+		} else if coreIndexSigPath.Exist() {
+			// Remove the signature of a previous version of the index
+			if err := coreIndexSigPath.Remove(); err != nil {
+				return nil, &arduino.PermissionDeniedError{Message: tr("Error removing stale index signature"), Cause: err}
+			}
 		}
 	}
 
@@ -683,193 +712,6 @@ func getOutputRelease(lib *librariesindex.Release) *rpc.LibraryRelease {
 	return &rpc.LibraryRelease{}
 }
 
-// Upgrade downloads and installs outdated Cores and Libraries
-func Upgrade(ctx context.Context, req *rpc.UpgradeRequest, downloadCB DownloadProgressCB, taskCB TaskProgressCB) error {
-	downloaderConfig, err := GetDownloaderConfig()
-	if err != nil {
-		return err
-	}
-
-	lm := GetLibraryManager(req.Instance.Id)
-	if lm == nil {
-		return &arduino.InvalidInstanceError{}
-	}
-
-	for _, libAlternatives := range lm.Libraries {
-		for _, library := range libAlternatives.Alternatives {
-			if library.Location != libraries.User {
-				continue
-			}
-			available := lm.Index.FindLibraryUpdate(library)
-			if available == nil {
-				continue
-			}
-
-			// Downloads latest library release
-			taskCB(&rpc.TaskProgress{Name: tr("Downloading %s", available)})
-			if d, err := available.Resource.Download(lm.DownloadsDir, downloaderConfig); err != nil {
-				return &arduino.FailedDownloadError{Message: tr("Error downloading library"), Cause: err}
-			} else if err := Download(d, available.String(), downloadCB); err != nil {
-				return &arduino.FailedDownloadError{Message: tr("Error downloading library"), Cause: err}
-			}
-
-			// Installs downloaded library
-			taskCB(&rpc.TaskProgress{Name: tr("Installing %s", available)})
-			libPath, libReplaced, err := lm.InstallPrerequisiteCheck(available)
-			if errors.Is(err, librariesmanager.ErrAlreadyInstalled) {
-				taskCB(&rpc.TaskProgress{Message: tr("Already installed %s", available), Completed: true})
-				continue
-			} else if err != nil {
-				return &arduino.FailedLibraryInstallError{Cause: err}
-			}
-
-			if libReplaced != nil {
-				taskCB(&rpc.TaskProgress{Message: tr("Replacing %[1]s with %[2]s", libReplaced, available)})
-			}
-
-			if err := lm.Install(available, libPath); err != nil {
-				return &arduino.FailedLibraryInstallError{Cause: err}
-			}
-
-			taskCB(&rpc.TaskProgress{Message: tr("Installed %s", available), Completed: true})
-		}
-	}
-
-	pm := GetPackageManager(req.Instance.Id)
-	if pm == nil {
-		return &arduino.InvalidInstanceError{}
-	}
-
-	for _, targetPackage := range pm.Packages {
-		for _, installed := range targetPackage.Platforms {
-			if installedRelease := pm.GetInstalledPlatformRelease(installed); installedRelease != nil {
-				latest := installed.GetLatestRelease()
-				if latest == nil || latest == installedRelease {
-					continue
-				}
-
-				ref := &packagemanager.PlatformReference{
-					Package:              installedRelease.Platform.Package.Name,
-					PlatformArchitecture: installedRelease.Platform.Architecture,
-					PlatformVersion:      installedRelease.Version,
-				}
-				// Get list of installed tools needed by the currently installed version
-				_, installedTools, err := pm.FindPlatformReleaseDependencies(ref)
-				if err != nil {
-					return &arduino.NotFoundError{Message: tr("Can't find dependencies for platform %s", ref), Cause: err}
-				}
-
-				ref = &packagemanager.PlatformReference{
-					Package:              latest.Platform.Package.Name,
-					PlatformArchitecture: latest.Platform.Architecture,
-					PlatformVersion:      latest.Version,
-				}
-
-				taskCB(&rpc.TaskProgress{Name: tr("Downloading %s", latest)})
-				_, tools, err := pm.FindPlatformReleaseDependencies(ref)
-				if err != nil {
-					return &arduino.NotFoundError{Message: tr("Can't find dependencies for platform %s", ref), Cause: err}
-				}
-
-				toolsToInstall := []*cores.ToolRelease{}
-				for _, tool := range tools {
-					if tool.IsInstalled() {
-						logrus.WithField("tool", tool).Warn("Tool already installed")
-						taskCB(&rpc.TaskProgress{Name: tr("Tool %s already installed", tool), Completed: true})
-					} else {
-						toolsToInstall = append(toolsToInstall, tool)
-					}
-				}
-
-				// Downloads platform tools
-				for _, tool := range toolsToInstall {
-					if err := DownloadToolRelease(pm, tool, downloadCB); err != nil {
-						taskCB(&rpc.TaskProgress{Message: tr("Error downloading tool %s", tool)})
-						return &arduino.FailedDownloadError{Message: tr("Error downloading tool %s", tool), Cause: err}
-					}
-				}
-
-				// Downloads platform
-				if d, err := pm.DownloadPlatformRelease(latest, downloaderConfig); err != nil {
-					return &arduino.FailedDownloadError{Message: tr("Error downloading platform %s", latest), Cause: err}
-				} else if err := Download(d, latest.String(), downloadCB); err != nil {
-					return &arduino.FailedDownloadError{Message: tr("Error downloading platform %s", latest), Cause: err}
-				}
-
-				logrus.Info("Updating platform " + installed.String())
-				taskCB(&rpc.TaskProgress{Name: tr("Updating platform %s", latest)})
-
-				// Installs tools
-				for _, tool := range toolsToInstall {
-					if err := InstallToolRelease(pm, tool, taskCB); err != nil {
-						msg := tr("Error installing tool %s", tool)
-						taskCB(&rpc.TaskProgress{Message: msg})
-						return &arduino.FailedInstallError{Message: msg, Cause: err}
-					}
-				}
-
-				// Installs platform
-				err = pm.InstallPlatform(latest)
-				if err != nil {
-					logrus.WithError(err).Error("Cannot install platform")
-					msg := tr("Error installing platform %s", latest)
-					taskCB(&rpc.TaskProgress{Message: msg})
-					return &arduino.FailedInstallError{Message: msg, Cause: err}
-				}
-
-				// Uninstall previously installed release
-				err = pm.UninstallPlatform(installedRelease)
-
-				// In case uninstall fails tries to rollback
-				if err != nil {
-					logrus.WithError(err).Error("Error updating platform.")
-					taskCB(&rpc.TaskProgress{Message: tr("Error upgrading platform: %s", err)})
-
-					// Rollback
-					if err := pm.UninstallPlatform(latest); err != nil {
-						logrus.WithError(err).Error("Error rolling-back changes.")
-						msg := tr("Error rolling-back changes")
-						taskCB(&rpc.TaskProgress{Message: fmt.Sprintf("%s: %s", msg, err)})
-						return &arduino.FailedInstallError{Message: msg, Cause: err}
-					}
-				}
-
-				// Uninstall unused tools
-				for _, toolRelease := range installedTools {
-					if !pm.IsToolRequired(toolRelease) {
-						log := pm.Log.WithField("Tool", toolRelease)
-
-						log.Info("Uninstalling tool")
-						taskCB(&rpc.TaskProgress{Name: tr("Uninstalling %s: tool is no more required", toolRelease)})
-
-						if err := pm.UninstallTool(toolRelease); err != nil {
-							log.WithError(err).Error("Error uninstalling")
-							return &arduino.FailedInstallError{Message: tr("Error uninstalling tool %s", toolRelease), Cause: err}
-						}
-
-						log.Info("Tool uninstalled")
-						taskCB(&rpc.TaskProgress{Message: tr("%s uninstalled", toolRelease), Completed: true})
-					}
-				}
-
-				// Perform post install
-				if !req.SkipPostInstall {
-					logrus.Info("Running post_install script")
-					taskCB(&rpc.TaskProgress{Message: tr("Configuring platform")})
-					if err := pm.RunPostInstallScript(latest); err != nil {
-						taskCB(&rpc.TaskProgress{Message: tr("WARNING: cannot run post install: %s", err)})
-					}
-				} else {
-					logrus.Info("Skipping platform configuration (post_install run).")
-					taskCB(&rpc.TaskProgress{Message: tr("Skipping platform configuration")})
-				}
-			}
-		}
-	}
-
-	return nil
-}
-
 // LoadSketch collects and returns all files composing a sketch
 func LoadSketch(ctx context.Context, req *rpc.LoadSketchRequest) (*rpc.LoadSketchResponse, error) {
 	// TODO: This should be a ToRpc function for the Sketch struct
@@ -20,10 +20,13 @@ import (
 	"errors"
 
 	"github.com/arduino/arduino-cli/arduino"
+	"github.com/arduino/arduino-cli/arduino/libraries"
 	"github.com/arduino/arduino-cli/arduino/libraries/librariesindex"
 	"github.com/arduino/arduino-cli/arduino/libraries/librariesmanager"
 	"github.com/arduino/arduino-cli/commands"
+	"github.com/arduino/arduino-cli/configuration"
 	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
+	"github.com/arduino/go-paths-helper"
 	"github.com/sirupsen/logrus"
 )
 
@@ -36,32 +39,55 @@ func LibraryInstall(ctx context.Context, req *rpc.LibraryInstallRequest,
 		return &arduino.InvalidInstanceError{}
 	}
 
-	toInstall := map[string]*rpc.LibraryDependencyStatus{}
+	toInstall := []*rpc.LibraryDependencyStatus{}
+	// asDependency are the libraries installed only to satisfy the dependencies
+	// of the requested libraries
+	asDependency := map[string]bool{}
 	if req.NoDeps {
-		toInstall[req.Name] = &rpc.LibraryDependencyStatus{
+		toInstall = append(toInstall, &rpc.LibraryDependencyStatus{
 			Name:            req.Name,
 			VersionRequired: req.Version,
+		})
+		for _, lib := range req.GetAdditionalLibraries() {
+			toInstall = append(toInstall, &rpc.LibraryDependencyStatus{
+				Name:            lib.GetName(),
+				VersionRequired: lib.GetVersion(),
+			})
+		}
+		if req.GetLockfile() != "" {
+			lockfile, err := librariesindex.LoadLockfile(paths.New(req.GetLockfile()))
+			if err != nil {
+				return &arduino.InvalidArgumentError{Message: tr("Invalid lockfile"), Cause: err}
+			}
+			for _, lib := range toInstall {
+				if pin, ok := lockfile.Libraries[lib.Name]; ok && lib.VersionRequired == "" {
+					lib.VersionRequired = pin.String()
+				}
+			}
 		}
 	} else {
 		res, err := LibraryResolveDependencies(ctx, &rpc.LibraryResolveDependenciesRequest{
-			Instance: req.Instance,
-			Name:     req.Name,
-			Version:  req.Version,
+			Instance:            req.Instance,
+			Name:                req.Name,
+			Version:             req.Version,
+			AdditionalLibraries: req.AdditionalLibraries,
+			Lockfile:            req.Lockfile,
 		})
 		if err != nil {
 			return err
 		}
 
+		requested := map[string]bool{req.Name: true}
+		for _, lib := range req.GetAdditionalLibraries() {
+			requested[lib.GetName()] = true
+		}
 		for _, dep := range res.Dependencies {
-			if existingDep, has := toInstall[dep.Name]; has {
-				if existingDep.VersionRequired != dep.VersionRequired {
-					err := errors.New(
-						tr("two different versions of the library %[1]s are required: %[2]s and %[3]s",
-							dep.Name, dep.VersionRequired, existingDep.VersionRequired))
-					return &arduino.LibraryDependenciesResolutionFailedError{Cause: err}
-				}
+			if !requested[dep.Name] && dep.VersionInstalled == dep.VersionRequired {
+				// The dependency is satisfied by an installed library
+				continue
 			}
-			toInstall[dep.Name] = dep
+			toInstall = append(toInstall, dep)
+			asDependency[dep.Name] = !requested[dep.Name]
 		}
 	}
 
@@ -78,7 +104,7 @@ func LibraryInstall(ctx context.Context, req *rpc.LibraryInstallRequest,
 			return err
 		}
 
-		if err := installLibrary(lm, libRelease, taskCB); err != nil {
+		if err := installLibrary(lm, libRelease, asDependency[lib.Name], taskCB); err != nil {
 			return err
 		}
 	}
@@ -89,11 +115,19 @@ func LibraryInstall(ctx context.Context, req *rpc.LibraryInstallRequest,
 	return nil
 }
 
-func installLibrary(lm *librariesmanager.LibrariesManager, libRelease *librariesindex.Release, taskCB commands.TaskProgressCB) error {
+// installLibrary installs the library release, if asDependency is true the
+// library is marked as installed only to satisfy the dependencies of other
+// libraries. Already installed libraries explicitly requested lose the mark.
+func installLibrary(lm *librariesmanager.LibrariesManager, libRelease *librariesindex.Release, asDependency bool, taskCB commands.TaskProgressCB) error {
 	taskCB(&rpc.TaskProgress{Name: tr("Installing %s", libRelease)})
 	logrus.WithField("library", libRelease).Info("Installing library")
 	libPath, libReplaced, err := lm.InstallPrerequisiteCheck(libRelease)
 	if errors.Is(err, librariesmanager.ErrAlreadyInstalled) {
+		if !asDependency {
+			if err := libraries.SetInstalledAsDependency(libPath, false); err != nil {
+				return &arduino.PermissionDeniedError{Message: tr("Error marking %s as explicitly installed", libRelease), Cause: err}
+			}
+		}
 		taskCB(&rpc.TaskProgress{Message: tr("Already installed %s", libRelease), Completed: true})
 		return nil
 	}
@@ -109,6 +143,11 @@ func installLibrary(lm *librariesmanager.LibrariesManager, libRelease *libraries
 	if err := lm.Install(libRelease, libPath); err != nil {
 		return &arduino.FailedLibraryInstallError{Cause: err}
 	}
+	if asDependency {
+		if err := libraries.SetInstalledAsDependency(libPath, true); err != nil {
+			return &arduino.FailedLibraryInstallError{Cause: err}
+		}
+	}
 
 	taskCB(&rpc.TaskProgress{Message: tr("Installed %s", libRelease), Completed: true})
 	return nil
@@ -127,9 +166,20 @@ func ZipLibraryInstall(ctx context.Context, req *rpc.ZipLibraryInstallRequest, t
 //GitLibraryInstall FIXMEDOC
 func GitLibraryInstall(ctx context.Context, req *rpc.GitLibraryInstallRequest, taskCB commands.TaskProgressCB) error {
 	lm := commands.GetLibraryManager(req.GetInstance().GetId())
-	if err := lm.InstallGitLib(req.Url, req.Overwrite); err != nil {
+	if err := lm.InstallGitLib(req.Url, req.Overwrite, gitCredentials()); err != nil {
 		return &arduino.FailedLibraryInstallError{Cause: err}
 	}
 	taskCB(&rpc.TaskProgress{Message: tr("Library installed"), Completed: true})
 	return nil
 }
+
+// gitCredentials returns the credentials for private git repositories set in
+// the configuration
+func gitCredentials() *librariesmanager.GitCredentials {
+	return &librariesmanager.GitCredentials{
+		Username:         configuration.Settings.GetString("library.git.username"),
+		Password:         configuration.Settings.GetString("library.git.password"),
+		SSHKeyPath:       configuration.Settings.GetString("library.git.ssh_key"),
+		SSHKeyPassphrase: configuration.Settings.GetString("library.git.ssh_key_passphrase"),
+	}
+}
@@ -0,0 +1,79 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package lib
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"strings"
+
+	"github.com/arduino/arduino-cli/arduino"
+	"github.com/arduino/arduino-cli/arduino/libraries"
+	"github.com/arduino/arduino-cli/arduino/lint"
+	"github.com/arduino/arduino-cli/commands/compile"
+	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
+	"github.com/arduino/go-paths-helper"
+	"github.com/sirupsen/logrus"
+)
+
+// LibraryLint checks a library against the library specification. If a FQBN
+// is given the examples of the library are compiled for that board too.
+func LibraryLint(ctx context.Context, req *rpc.LibraryLintRequest) (*rpc.LibraryLintResponse, error) {
+	if req.GetLibraryPath() == "" {
+		return nil, &arduino.InvalidArgumentError{Message: tr("Missing library path")}
+	}
+	libDir, err := paths.New(req.GetLibraryPath()).Abs()
+	if err != nil {
+		return nil, &arduino.InvalidArgumentError{Message: tr("Invalid library path"), Cause: err}
+	}
+	findings, err := libraries.Lint(libDir)
+	if err != nil {
+		return nil, &arduino.InvalidLibraryError{Cause: err}
+	}
+
+	if fqbn := req.GetCompileExamplesFqbn(); fqbn != "" {
+		lib, err := libraries.Load(libDir, libraries.Unmanaged)
+		if err != nil {
+			return nil, &arduino.InvalidLibraryError{Cause: err}
+		}
+		for _, example := range lib.Examples {
+			if err := compileExample(ctx, req.GetInstance(), fqbn, libDir, example); err != nil {
+				findings.Add(lint.Error, "example-compile", example, tr("the example doesn't compile for %[1]s: %[2]s"), fqbn, err)
+			}
+		}
+	}
+
+	return &rpc.LibraryLintResponse{Findings: findings.ToRPC()}, nil
+}
+
+func compileExample(ctx context.Context, instance *rpc.Instance, fqbn string, libDir, example *paths.Path) error {
+	logrus.WithField("example", example).WithField("fqbn", fqbn).Info("Compiling library example")
+	compileErr := &bytes.Buffer{}
+	_, err := compile.Compile(ctx, &rpc.CompileRequest{
+		Instance:   instance,
+		Fqbn:       fqbn,
+		SketchPath: example.String(),
+		Library:    []string{libDir.String()},
+	}, ioutil.Discard, compileErr, nil, false)
+	if err != nil {
+		if output := strings.TrimSpace(compileErr.String()); output != "" {
+			return &arduino.CompileFailedError{Message: output}
+		}
+		return err
+	}
+	return nil
+}
@@ -26,11 +26,14 @@ import (
 	"github.com/arduino/arduino-cli/arduino/libraries/librariesmanager"
 	"github.com/arduino/arduino-cli/commands"
 	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
+	"github.com/sirupsen/logrus"
 )
 
 type installedLib struct {
 	Library   *libraries.Library
 	Available *librariesindex.Release
+	// GitUpdate is the URL#REF to install to update a library installed from git
+	GitUpdate string
 }
 
 // LibraryList FIXMEDOC
@@ -49,6 +52,9 @@ func LibraryList(ctx context.Context, req *rpc.LibraryListRequest) (*rpc.Library
 
 	instaledLibs := []*rpc.InstalledLibrary{}
 	res := listLibraries(lm, req.GetUpdatable(), req.GetAll())
+	if req.GetUpdatable() {
+		res = append(res, listGitLibraryUpdates(lm)...)
+	}
 	if f := req.GetFqbn(); f != "" {
 		fqbn, err := cores.ParseFQBN(req.GetFqbn())
 		if err != nil {
@@ -101,6 +107,8 @@ func LibraryList(ctx context.Context, req *rpc.LibraryListRequest) (*rpc.Library
 		var release *rpc.LibraryRelease
 		if lib.Available != nil {
 			release = lib.Available.ToRPCLibraryRelease()
+		} else if lib.GitUpdate != "" {
+			release = &rpc.LibraryRelease{Version: gitUpdateRef(lib.GitUpdate)}
 		}
 		rpcLib, err := lib.Library.ToRPCLibrary()
 		if err != nil {
@@ -126,7 +134,11 @@ func listLibraries(lm *librariesmanager.LibrariesManager, updatable bool, all bo
 					continue
 				}
 			}
-			available := lm.Index.FindLibraryUpdate(lib)
+			var available *librariesindex.Release
+			if lib.GitOrigin == nil {
+				// Libraries installed from git are upgraded from their repository
+				available = lm.Index.FindLibraryUpdate(lib)
+			}
 			if updatable && available == nil {
 				continue
 			}
@@ -138,3 +150,31 @@ func listLibraries(lm *librariesmanager.LibrariesManager, updatable bool, all bo
 	}
 	return res
 }
+
+// listGitLibraryUpdates returns the libraries installed from git that have
+// changes in their repository. The libraries whose repository can't be
+// checked are skipped.
+func listGitLibraryUpdates(lm *librariesmanager.LibrariesManager) []*installedLib {
+	credentials := gitCredentials()
+	res := []*installedLib{}
+	for _, lib := range listGitLibraries(lm) {
+		gitURL, err := librariesmanager.FindGitLibUpdate(lib.GitOrigin, credentials)
+		if err != nil {
+			logrus.WithError(err).WithField("library", lib.Name).Warn("Cannot check updates of git library")
+			continue
+		}
+		if gitURL != "" {
+			res = append(res, &installedLib{Library: lib, GitUpdate: gitURL})
+		}
+	}
+	return res
+}
+
+// gitUpdateRef returns the ref of a git library update, in the URL#REF form,
+// or HEAD for the tip of the default branch
+func gitUpdateRef(gitURL string) string {
+	if i := strings.LastIndex(gitURL, "#"); i != -1 {
+		return gitURL[i+1:]
+	}
+	return "HEAD"
+}
@@ -0,0 +1,246 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package lib
+
+import (
+	"archive/zip"
+	"context"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/arduino/arduino-cli/arduino"
+	"github.com/arduino/arduino-cli/arduino/libraries"
+	"github.com/arduino/arduino-cli/arduino/libraries/librariesindex"
+	"github.com/arduino/arduino-cli/arduino/lint"
+	"github.com/arduino/arduino-cli/arduino/resources"
+	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
+	"github.com/arduino/go-paths-helper"
+	"github.com/sirupsen/logrus"
+)
+
+// packExcludedFiles are the files and folders not included in a library
+// release archive
+var packExcludedFiles = map[string]bool{
+	".git":         true,
+	".svn":         true,
+	".hg":          true,
+	".bzr":         true,
+	".development": true,
+
+	libraries.GitOriginFileName:        true,
+	libraries.DependencyMarkerFileName: true,
+}
+
+// packModTime is the modification time of all the entries of a library
+// release archive, to make the archive reproducible
+var packModTime = time.Date(1980, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+// LibraryPack validates a library and creates a reproducible release archive
+// of it, together with the library index entry describing the release.
+func LibraryPack(ctx context.Context, req *rpc.LibraryPackRequest) (*rpc.LibraryPackResponse, error) {
+	if req.GetLibraryPath() == "" {
+		return nil, &arduino.InvalidArgumentError{Message: tr("Missing library path")}
+	}
+	libDir, err := paths.New(req.GetLibraryPath()).Abs()
+	if err != nil {
+		return nil, &arduino.InvalidArgumentError{Message: tr("Invalid library path"), Cause: err}
+	}
+
+	findings, err := libraries.Lint(libDir)
+	if err != nil {
+		return nil, &arduino.InvalidLibraryError{Cause: err}
+	}
+	if findings.HasErrors() {
+		problems := []string{}
+		for _, f := range findings {
+			if f.Level == lint.Error {
+				problems = append(problems, f.String())
+			}
+		}
+		return nil, &arduino.InvalidLibraryError{Cause: errors.New(strings.Join(problems, "\n"))}
+	}
+	lib, err := libraries.Load(libDir, libraries.Unmanaged)
+	if err != nil {
+		return nil, &arduino.InvalidLibraryError{Cause: err}
+	}
+	if lib.IsLegacy {
+		return nil, &arduino.InvalidLibraryError{Cause: errors.New(tr("library.properties not found"))}
+	}
+
+	outputDir := paths.New(".")
+	if req.GetOutputDir() != "" {
+		outputDir = paths.New(req.GetOutputDir())
+	}
+	if outputDir, err = outputDir.Abs(); err != nil {
+		return nil, &arduino.InvalidArgumentError{Message: tr("Invalid output folder"), Cause: err}
+	}
+	if req.GetOutputDir() == "" {
+		// When packing from inside the library the archive is created
+		// next to the library folder
+		if isInside, err := outputDir.IsInsideDir(libDir); err == nil && (isInside || outputDir.EquivalentTo(libDir)) {
+			outputDir = libDir.Parent()
+		}
+	}
+	if err := outputDir.MkdirAll(); err != nil {
+		return nil, &arduino.PermissionDeniedError{Message: tr("Cannot create output folder"), Cause: err}
+	}
+
+	// Same naming used by the Library Manager indexer
+	rootName := strings.ReplaceAll(lib.RealName, " ", "_") + "-" + lib.Version.String()
+	archivePath := outputDir.Join(rootName + ".zip")
+	if isInside, err := archivePath.IsInsideDir(libDir); err == nil && isInside {
+		return nil, &arduino.InvalidArgumentError{Message: tr("The archive can't be created inside the library folder")}
+	}
+	logrus.WithField("library", lib.RealName).WithField("archive", archivePath).Info("Packing library")
+	if err := createLibraryArchive(libDir, rootName, archivePath); err != nil {
+		return nil, &arduino.PermissionDeniedError{Message: tr("Error creating library archive"), Cause: err}
+	}
+
+	checksum, size, err := archiveChecksum(archivePath)
+	if err != nil {
+		return nil, &arduino.PermissionDeniedError{Message: tr("Error computing archive checksum"), Cause: err}
+	}
+	url := archivePath.Base()
+	if baseURL := req.GetBaseUrl(); baseURL != "" {
+		url = strings.TrimSuffix(baseURL, "/") + "/" + archivePath.Base()
+	}
+	entry, err := librariesindex.ReleaseEntry(lib, &resources.DownloadResource{
+		URL:             url,
+		ArchiveFileName: archivePath.Base(),
+		Checksum:        checksum,
+		Size:            size,
+	})
+	if err != nil {
+		return nil, &arduino.InvalidLibraryError{Cause: err}
+	}
+
+	return &rpc.LibraryPackResponse{
+		ArchivePath: archivePath.String(),
+		Checksum:    checksum,
+		Size:        size,
+		IndexEntry:  string(entry),
+	}, nil
+}
+
+// createLibraryArchive zips the content of libDir inside the rootName folder.
+// Entries are sorted and have a fixed modification time, so that the same
+// library always produces the same archive.
+func createLibraryArchive(libDir *paths.Path, rootName string, archivePath *paths.Path) error {
+	files, err := libDir.ReadDirRecursive()
+	if err != nil {
+		return err
+	}
+	entries := map[string]*paths.Path{}
+	names := []string{}
+	for _, file := range files {
+		rel, err := libDir.RelTo(file)
+		if err != nil {
+			return err
+		}
+		relName := filepath.ToSlash(rel.String())
+		if isExcludedFromPack(relName) {
+			continue
+		}
+		name := rootName + "/" + relName
+		if file.IsDir() {
+			name += "/"
+		}
+		entries[name] = file
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	archive, err := archivePath.Create()
+	if err != nil {
+		return err
+	}
+	defer archive.Close()
+	zipWriter := zip.NewWriter(archive)
+
+	if err := addLibraryArchiveEntry(zipWriter, rootName+"/", nil); err != nil {
+		return err
+	}
+	for _, name := range names {
+		if err := addLibraryArchiveEntry(zipWriter, name, entries[name]); err != nil {
+			return err
+		}
+	}
+	if err := zipWriter.Close(); err != nil {
+		return err
+	}
+	return archive.Close()
+}
+
+func addLibraryArchiveEntry(zipWriter *zip.Writer, name string, file *paths.Path) error {
+	header := &zip.FileHeader{Name: name, Modified: packModTime}
+	if strings.HasSuffix(name, "/") {
+		header.SetMode(0755 | os.ModeDir)
+		_, err := zipWriter.CreateHeader(header)
+		return err
+	}
+
+	info, err := file.Stat()
+	if err != nil {
+		return err
+	}
+	header.Method = zip.Deflate
+	if info.Mode()&0111 != 0 {
+		header.SetMode(0755)
+	} else {
+		header.SetMode(0644)
+	}
+	writer, err := zipWriter.CreateHeader(header)
+	if err != nil {
+		return err
+	}
+	f, err := file.Open()
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	_, err = io.Copy(writer, f)
+	return err
+}
+
+func isExcludedFromPack(relName string) bool {
+	for _, part := range strings.Split(relName, "/") {
+		if packExcludedFiles[part] {
+			return true
+		}
+	}
+	return false
+}
+
+func archiveChecksum(archivePath *paths.Path) (string, int64, error) {
+	f, err := archivePath.Open()
+	if err != nil {
+		return "", 0, err
+	}
+	defer f.Close()
+	hash := sha256.New()
+	size, err := io.Copy(hash, f)
+	if err != nil {
+		return "", 0, err
+	}
+	return fmt.Sprintf("SHA-256:%x", hash.Sum(nil)), size, nil
+}
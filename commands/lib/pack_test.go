@@ -0,0 +1,97 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package lib
+
+import (
+	"archive/zip"
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
+	"github.com/arduino/go-paths-helper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLibraryPack(t *testing.T) {
+	tmp := paths.New(t.TempDir())
+	libDir := tmp.Join("MyLib")
+	files := map[string]string{
+		"library.properties": "name=My Lib\nversion=1.0.0\nauthor=Me\nmaintainer=Me\n" +
+			"sentence=A library\nparagraph=A library\nurl=https://example.com\ncategory=Other\narchitectures=*\n",
+		"src/MyLib.h":        "",
+		"src/MyLib.cpp":      "",
+		"examples/Ex/Ex.ino": "void setup() {}\nvoid loop() {}\n",
+		".git/HEAD":          "",
+		".development":       "",
+	}
+	for name, content := range files {
+		require.NoError(t, libDir.Join(name).Parent().MkdirAll())
+		require.NoError(t, libDir.Join(name).WriteFile([]byte(content)))
+	}
+
+	req := &rpc.LibraryPackRequest{
+		LibraryPath: libDir.String(),
+		OutputDir:   tmp.Join("dist").String(),
+		BaseUrl:     "https://example.com/libs/",
+	}
+	resp, err := LibraryPack(context.Background(), req)
+	require.NoError(t, err)
+	require.Equal(t, tmp.Join("dist", "My_Lib-1.0.0.zip").String(), resp.GetArchivePath())
+	require.Contains(t, resp.GetIndexEntry(), `"url": "https://example.com/libs/My_Lib-1.0.0.zip"`)
+
+	archive, err := zip.OpenReader(resp.GetArchivePath())
+	require.NoError(t, err)
+	names := []string{}
+	for _, f := range archive.File {
+		names = append(names, f.Name)
+	}
+	require.NoError(t, archive.Close())
+	require.Equal(t, []string{
+		"My_Lib-1.0.0/",
+		"My_Lib-1.0.0/examples/",
+		"My_Lib-1.0.0/examples/Ex/",
+		"My_Lib-1.0.0/examples/Ex/Ex.ino",
+		"My_Lib-1.0.0/library.properties",
+		"My_Lib-1.0.0/src/",
+		"My_Lib-1.0.0/src/MyLib.cpp",
+		"My_Lib-1.0.0/src/MyLib.h",
+	}, names)
+
+	// The archive doesn't depend on the modification time of the files
+	later := time.Now().Add(time.Hour)
+	require.NoError(t, libDir.Join("src", "MyLib.h").Chtimes(later, later))
+	resp2, err := LibraryPack(context.Background(), req)
+	require.NoError(t, err)
+	require.Equal(t, resp.GetChecksum(), resp2.GetChecksum())
+	require.Equal(t, resp.GetSize(), resp2.GetSize())
+
+	// Packing from inside the library creates the archive next to it
+	wd, err := os.Getwd()
+	require.NoError(t, err)
+	require.NoError(t, os.Chdir(libDir.String()))
+	defer os.Chdir(wd)
+	resp, err = LibraryPack(context.Background(), &rpc.LibraryPackRequest{LibraryPath: "."})
+	require.NoError(t, err)
+	require.Equal(t, tmp.Join("My_Lib-1.0.0.zip").String(), resp.GetArchivePath())
+	require.Equal(t, resp2.GetChecksum(), resp.GetChecksum())
+
+	// Invalid libraries are not packed
+	require.NoError(t, libDir.Join("library.properties").WriteFile([]byte("name=My Lib\nversion=1.0\n")))
+	_, err = LibraryPack(context.Background(), req)
+	require.Error(t, err)
+}
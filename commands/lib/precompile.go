@@ -0,0 +1,168 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package lib
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"path/filepath"
+	"strings"
+
+	"github.com/arduino/arduino-cli/arduino"
+	"github.com/arduino/arduino-cli/arduino/cores"
+	"github.com/arduino/arduino-cli/arduino/cores/packagemanager"
+	"github.com/arduino/arduino-cli/arduino/libraries"
+	"github.com/arduino/arduino-cli/commands"
+	"github.com/arduino/arduino-cli/configuration"
+	"github.com/arduino/arduino-cli/legacy/builder"
+	"github.com/arduino/arduino-cli/legacy/builder/types"
+	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
+	"github.com/arduino/go-paths-helper"
+	"github.com/sirupsen/logrus"
+)
+
+// LibraryPrecompile compiles a library for each of the requested boards and
+// stores the resulting static libraries in the src/{build.mcu} folders where
+// the builder looks for precompiled libraries. The library.properties of the
+// library is updated to declare the library as precompiled.
+func LibraryPrecompile(ctx context.Context, req *rpc.LibraryPrecompileRequest, outStream, errStream io.Writer, taskCB commands.TaskProgressCB) (*rpc.LibraryPrecompileResponse, error) {
+	pm := commands.GetPackageManager(req.GetInstance().GetId())
+	if pm == nil {
+		return nil, &arduino.InvalidInstanceError{}
+	}
+	if req.GetLibraryPath() == "" {
+		return nil, &arduino.InvalidArgumentError{Message: tr("Missing library path")}
+	}
+	if len(req.GetFqbn()) == 0 {
+		return nil, &arduino.MissingFQBNError{}
+	}
+	libDir, err := paths.New(req.GetLibraryPath()).Abs()
+	if err != nil {
+		return nil, &arduino.InvalidArgumentError{Message: tr("Invalid library path"), Cause: err}
+	}
+	lib, err := libraries.Load(libDir, libraries.Unmanaged)
+	if err != nil {
+		return nil, &arduino.InvalidLibraryError{Cause: err}
+	}
+	if lib.IsLegacy {
+		return nil, &arduino.InvalidLibraryError{Cause: errors.New(tr("library.properties not found"))}
+	}
+	if lib.Layout != libraries.RecursiveLayout {
+		return nil, &arduino.InvalidLibraryError{Cause: errors.New(tr("precompiled libraries must use the recursive layout"))}
+	}
+
+	res := &rpc.LibraryPrecompileResponse{}
+	for _, fqbnIn := range req.GetFqbn() {
+		fqbn, err := cores.ParseFQBN(fqbnIn)
+		if err != nil {
+			return nil, &arduino.InvalidFQBNError{Cause: err}
+		}
+		taskCB(&rpc.TaskProgress{Name: tr("Precompiling %[1]s for %[2]s", lib.Name, fqbn)})
+		archive, err := precompileLibrary(pm, lib, fqbn, req.GetVerbose(), outStream, errStream)
+		if err != nil {
+			return nil, err
+		}
+		relArchive, err := archive.RelFrom(libDir)
+		if err != nil {
+			return nil, &arduino.PermissionDeniedError{Message: tr("Error copying the archive"), Cause: err}
+		}
+		res.Archives = append(res.Archives, &rpc.PrecompiledLibraryArchive{
+			Fqbn: fqbn.String(),
+			Path: filepath.ToSlash(relArchive.String()),
+		})
+		taskCB(&rpc.TaskProgress{Message: tr("Created %s", relArchive), Completed: true})
+	}
+
+	// With "full" the archive replaces the sources for the boards it has been
+	// compiled for, while the sources are still compiled for the other boards
+	if lib.Properties.Get("precompiled") != "full" {
+		if err := setLibraryProperty(libDir.Join("library.properties"), "precompiled", "full"); err != nil {
+			return nil, &arduino.PermissionDeniedError{Message: tr("Error updating library.properties"), Cause: err}
+		}
+	}
+	return res, nil
+}
+
+// precompileLibrary builds the library for the board and copies the resulting
+// archive into the library, it returns the path of the copied archive.
+func precompileLibrary(pm *packagemanager.PackageManager, lib *libraries.Library, fqbn *cores.FQBN, verbose bool, outStream, errStream io.Writer) (*paths.Path, error) {
+	targetPlatform := pm.FindPlatform(&packagemanager.PlatformReference{
+		Package:              fqbn.Package,
+		PlatformArchitecture: fqbn.PlatformArch,
+	})
+	if targetPlatform == nil || pm.GetInstalledPlatformRelease(targetPlatform) == nil {
+		return nil, &arduino.PlatformNotFoundError{
+			Platform: fmt.Sprintf("%s:%s", fqbn.Package, fqbn.PlatformArch),
+			Cause:    fmt.Errorf(tr("platform not installed")),
+		}
+	}
+
+	buildPath, err := paths.MkTempDir("", "arduino-lib-precompile-")
+	if err != nil {
+		return nil, &arduino.TempDirCreationFailedError{Cause: err}
+	}
+	defer buildPath.RemoveAll()
+
+	builderCtx := &types.Context{}
+	builderCtx.PackageManager = pm
+	builderCtx.FQBN = fqbn
+	builderCtx.LibraryToPrecompile = lib
+	builderCtx.HardwareDirs = configuration.HardwareDirectories(configuration.Settings)
+	builderCtx.BuiltInToolsDirs = configuration.BundleToolsDirectories(configuration.Settings)
+	builderCtx.OtherLibrariesDirs = paths.NewPathList(configuration.LibrariesDir(configuration.Settings).String())
+	builderCtx.BuildPath = buildPath
+	builderCtx.Verbose = verbose
+	builderCtx.ArduinoAPIVersion = "10607"
+	builderCtx.Stdout = outStream
+	builderCtx.Stderr = errStream
+	if err := builder.RunPrecompileLibrary(builderCtx); err != nil {
+		return nil, &arduino.CompileFailedError{Message: err.Error()}
+	}
+
+	destDir := lib.SourceDir.JoinPath(builderCtx.PrecompiledLibraryFolder)
+	if err := destDir.MkdirAll(); err != nil {
+		return nil, &arduino.PermissionDeniedError{Message: tr("Cannot create folder %s", destDir), Cause: err}
+	}
+	dest := destDir.Join(builderCtx.PrecompiledLibraryArchive.Base())
+	logrus.WithField("library", lib.Name).WithField("fqbn", fqbn).WithField("archive", dest).Info("Precompiled library")
+	if err := builderCtx.PrecompiledLibraryArchive.CopyTo(dest); err != nil {
+		return nil, &arduino.PermissionDeniedError{Message: tr("Error copying the archive"), Cause: err}
+	}
+	return dest, nil
+}
+
+// setLibraryProperty sets the value of a property in a library.properties
+// file, preserving the other lines of the file as they are.
+func setLibraryProperty(file *paths.Path, key, value string) error {
+	data, err := file.ReadFile()
+	if err != nil {
+		return err
+	}
+	lines := strings.Split(strings.TrimRight(string(data), "\r\n"), "\n")
+	found := false
+	for i, line := range lines {
+		if k := strings.SplitN(line, "=", 2); len(k) == 2 && strings.TrimSpace(k[0]) == key {
+			lines[i] = key + "=" + value
+			found = true
+		}
+	}
+	if !found {
+		lines = append(lines, key+"="+value)
+	}
+	return file.WriteFile([]byte(strings.Join(lines, "\n") + "\n"))
+}
@@ -0,0 +1,38 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package lib
+
+import (
+	"testing"
+
+	"github.com/arduino/go-paths-helper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSetLibraryProperty(t *testing.T) {
+	file := paths.New(t.TempDir()).Join("library.properties")
+	require.NoError(t, file.WriteFile([]byte("name=MyLib\n# a comment\nprecompiled = true\nversion=1.0.0\n")))
+
+	require.NoError(t, setLibraryProperty(file, "precompiled", "full"))
+	data, err := file.ReadFile()
+	require.NoError(t, err)
+	require.Equal(t, "name=MyLib\n# a comment\nprecompiled=full\nversion=1.0.0\n", string(data))
+
+	require.NoError(t, setLibraryProperty(file, "ldflags", "-lm"))
+	data, err = file.ReadFile()
+	require.NoError(t, err)
+	require.Equal(t, "name=MyLib\n# a comment\nprecompiled=full\nversion=1.0.0\nldflags=-lm\n", string(data))
+}
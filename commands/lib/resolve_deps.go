@@ -17,12 +17,14 @@ package lib
 
 import (
 	"context"
-	"errors"
 
 	"github.com/arduino/arduino-cli/arduino"
 	"github.com/arduino/arduino-cli/arduino/libraries"
+	"github.com/arduino/arduino-cli/arduino/libraries/librariesindex"
 	"github.com/arduino/arduino-cli/commands"
 	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
+	"github.com/arduino/go-paths-helper"
+	semver "go.bug.st/relaxed-semver"
 )
 
 // LibraryResolveDependencies FIXMEDOC
@@ -32,47 +34,69 @@ func LibraryResolveDependencies(ctx context.Context, req *rpc.LibraryResolveDepe
 		return nil, &arduino.InvalidInstanceError{}
 	}
 
-	// Search the requested lib
-	reqLibRelease, err := findLibraryIndexRelease(lm, req)
-	if err != nil {
-		return nil, err
+	// Search the requested libs, the version is constrained only if given by
+	// the user, otherwise the resolver chooses it
+	refs := []*librariesindex.Reference{}
+	for _, libRef := range append([]libraryReferencer{req}, toLibraryReferencers(req.GetAdditionalLibraries())...) {
+		ref, err := createLibIndexReference(lm, libRef)
+		if err != nil {
+			return nil, err
+		}
+		if lm.Index.FindRelease(ref) == nil {
+			return nil, &arduino.LibraryNotFoundError{Library: ref.String()}
+		}
+		refs = append(refs, ref)
 	}
 
-	// Extract all installed libraries
-	installedLibs := map[string]*libraries.Library{}
-	for _, lib := range listLibraries(lm, false, false) {
-		installedLibs[lib.Library.Name] = lib.Library
+	pinned := map[string]*semver.Version{}
+	if req.GetLockfile() != "" {
+		lockfile, err := librariesindex.LoadLockfile(paths.New(req.GetLockfile()))
+		if err != nil {
+			return nil, &arduino.InvalidArgumentError{Message: tr("Invalid lockfile"), Cause: err}
+		}
+		pinned = lockfile.Libraries
 	}
 
-	// Resolve all dependencies...
-	deps := lm.Index.ResolveDependencies(reqLibRelease)
-
-	// If no solution has been found
-	if len(deps) == 0 {
-		// Check if there is a problem with the first level deps
-		for _, directDep := range reqLibRelease.GetDependencies() {
-			if _, ok := lm.Index.Libraries[directDep.GetName()]; !ok {
-				err := errors.New(tr("dependency '%s' is not available", directDep.GetName()))
-				return nil, &arduino.LibraryDependenciesResolutionFailedError{Cause: err}
-			}
+	// Extract all installed libraries, including the ones bundled with platforms
+	installedLibs := map[string]*libraries.Library{}
+	allInstalled := libraries.List{}
+	for _, lib := range listLibraries(lm, false, true) {
+		allInstalled.Add(lib.Library)
+		if lib.Library.Location == libraries.User {
+			installedLibs[lib.Library.Name] = lib.Library
+		} else if _, ok := installedLibs[lib.Library.Name]; !ok {
+			installedLibs[lib.Library.Name] = lib.Library
 		}
+	}
 
-		// Otherwise there is no possible solution, the depends field has an invalid formula
-		return nil, &arduino.LibraryDependenciesResolutionFailedError{}
+	// Resolve all dependencies...
+	deps, err := lm.Index.NewDependencyResolver(allInstalled, pinned).Resolve(refs...)
+	if err != nil {
+		return nil, &arduino.LibraryDependenciesResolutionFailedError{Cause: err}
 	}
 
 	res := []*rpc.LibraryDependencyStatus{}
 	for _, dep := range deps {
 		// ...and add information on currently installed versions of the libraries
 		installed := ""
-		if installedLib, has := installedLibs[dep.GetName()]; has {
+		if dep.Installed != nil {
+			installed = dep.Installed.Version.String()
+		} else if installedLib, has := installedLibs[dep.Name]; has && installedLib.Version != nil {
 			installed = installedLib.Version.String()
 		}
 		res = append(res, &rpc.LibraryDependencyStatus{
-			Name:             dep.GetName(),
-			VersionRequired:  dep.GetVersion().String(),
+			Name:             dep.Name,
+			VersionRequired:  dep.Version.String(),
 			VersionInstalled: installed,
 		})
 	}
 	return &rpc.LibraryResolveDependenciesResponse{Dependencies: res}, nil
 }
+
+func toLibraryReferencers(refs []*rpc.LibraryReference) []libraryReferencer {
+	res := []libraryReferencer{}
+	for _, ref := range refs {
+		res = append(res, ref)
+	}
+	return res
+}
@@ -17,11 +17,12 @@ package lib
 
 import (
 	"context"
+	"sort"
 
 	"github.com/arduino/arduino-cli/arduino"
+	"github.com/arduino/arduino-cli/arduino/cores"
 	"github.com/arduino/arduino-cli/arduino/libraries/librariesindex"
 	"github.com/arduino/arduino-cli/arduino/libraries/librariesmanager"
-	"github.com/arduino/arduino-cli/arduino/utils"
 	"github.com/arduino/arduino-cli/commands"
 	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
 	semver "go.bug.st/relaxed-semver"
@@ -33,21 +34,48 @@ func LibrarySearch(ctx context.Context, req *rpc.LibrarySearchRequest) (*rpc.Lib
 	if lm == nil {
 		return nil, &arduino.InvalidInstanceError{}
 	}
-	return searchLibrary(req, lm), nil
-}
 
-func searchLibrary(req *rpc.LibrarySearchRequest, lm *librariesmanager.LibrariesManager) *rpc.LibrarySearchResponse {
-	res := []*rpc.SearchedLibrary{}
-	status := rpc.LibrarySearchStatus_LIBRARY_SEARCH_STATUS_SUCCESS
+	query := parseSearchQuery(req.GetQuery())
+	if req.GetFqbn() != "" {
+		pm := commands.GetPackageManager(req.GetInstance().GetId())
+		if pm == nil {
+			return nil, &arduino.InvalidInstanceError{}
+		}
+		fqbn, err := cores.ParseFQBN(req.GetFqbn())
+		if err != nil {
+			return nil, &arduino.InvalidFQBNError{Cause: err}
+		}
+		if _, _, _, _, _, err := pm.ResolveFQBN(fqbn); err != nil {
+			return nil, &arduino.UnknownFQBNError{Cause: err}
+		}
+		query.add("arch", fqbn.PlatformArch)
+	}
+	return searchLibrary(query, lm), nil
+}
 
+func searchLibrary(query *searchQuery, lm *librariesmanager.LibrariesManager) *rpc.LibrarySearchResponse {
+	type searchResult struct {
+		lib   *librariesindex.Library
+		score int
+	}
+	results := []*searchResult{}
 	for _, lib := range lm.Index.Libraries {
-		toTest := []string{lib.Name, lib.Latest.Paragraph, lib.Latest.Sentence}
-		if !utils.MatchAny(req.GetQuery(), toTest) {
-			continue
+		if score, match := query.score(lib); match {
+			results = append(results, &searchResult{lib: lib, score: score})
 		}
-		res = append(res, indexLibraryToRPCSearchLibrary(lib))
 	}
+	sort.SliceStable(results, func(i, j int) bool {
+		if results[i].score != results[j].score {
+			return results[i].score > results[j].score
+		}
+		return results[i].lib.Name < results[j].lib.Name
+	})
 
+	res := []*rpc.SearchedLibrary{}
+	for _, result := range results {
+		res = append(res, indexLibraryToRPCSearchLibrary(result.lib))
+	}
+	status := rpc.LibrarySearchStatus_LIBRARY_SEARCH_STATUS_SUCCESS
 	return &rpc.LibrarySearchResponse{Libraries: res, Status: status}
 }
 
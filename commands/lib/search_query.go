@@ -0,0 +1,175 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package lib
+
+import (
+	"strings"
+
+	"github.com/arduino/arduino-cli/arduino/libraries/librariesindex"
+	"github.com/arduino/arduino-cli/arduino/utils"
+)
+
+// searchQuery is a parsed library search query: the plain terms are matched
+// against the name, sentence and paragraph of the libraries while the
+// qualifiers, given as key:value, must all be satisfied by the latest release
+type searchQuery struct {
+	terms      []string
+	qualifiers map[string][]string
+}
+
+// searchQualifiers maps each supported qualifier to the function checking
+// if a release satisfies it
+var searchQualifiers = map[string]func(rel *librariesindex.Release, value string) bool{
+	"name": func(rel *librariesindex.Release, value string) bool {
+		return utils.Match(rel.Library.Name, []string{value})
+	},
+	"author": func(rel *librariesindex.Release, value string) bool {
+		return utils.Match(rel.Author, []string{value})
+	},
+	"maintainer": func(rel *librariesindex.Release, value string) bool {
+		return utils.Match(rel.Maintainer, []string{value})
+	},
+	"license": func(rel *librariesindex.Release, value string) bool {
+		return utils.Match(rel.License, []string{value})
+	},
+	"category": func(rel *librariesindex.Release, value string) bool {
+		return strings.EqualFold(rel.Category, value)
+	},
+	"type": func(rel *librariesindex.Release, value string) bool {
+		return containsFold(rel.Types, value)
+	},
+	"arch": func(rel *librariesindex.Release, value string) bool {
+		// Same rules used for installed libraries: no architectures means
+		// that the library is architecture independent
+		return len(rel.Architectures) == 0 || containsFold(rel.Architectures, "*") || containsFold(rel.Architectures, value)
+	},
+	"depends": func(rel *librariesindex.Release, value string) bool {
+		for _, dep := range rel.GetDependencies() {
+			if strings.EqualFold(dep.GetName(), value) {
+				return true
+			}
+		}
+		return false
+	},
+	"includes": func(rel *librariesindex.Release, value string) bool {
+		return containsFold(rel.ProvidesIncludes, value)
+	},
+}
+
+// parseSearchQuery splits the query in plain terms and key:value qualifiers.
+// Words with an unknown key are considered plain terms, so that a query
+// containing a colon keeps matching as it did before qualifiers existed.
+func parseSearchQuery(query string) *searchQuery {
+	q := &searchQuery{qualifiers: map[string][]string{}}
+	for _, token := range splitSearchQuery(query) {
+		if split := strings.SplitN(token, ":", 2); len(split) == 2 && split[1] != "" {
+			if key := strings.ToLower(split[0]); searchQualifiers[key] != nil {
+				q.add(key, split[1])
+				continue
+			}
+		}
+		q.terms = append(q.terms, token)
+	}
+	return q
+}
+
+// splitSearchQuery splits the query on whitespace, keeping together the
+// parts enclosed in double quotes and removing the quotes.
+func splitSearchQuery(query string) []string {
+	tokens := []string{}
+	var token strings.Builder
+	inQuotes := false
+	hasToken := false
+	for _, c := range query {
+		switch {
+		case c == '"':
+			inQuotes = !inQuotes
+			hasToken = true
+		case !inQuotes && (c == ' ' || c == '\t' || c == '\n' || c == '\r'):
+			if hasToken {
+				tokens = append(tokens, token.String())
+				token.Reset()
+				hasToken = false
+			}
+		default:
+			token.WriteRune(c)
+			hasToken = true
+		}
+	}
+	if hasToken {
+		tokens = append(tokens, token.String())
+	}
+	return tokens
+}
+
+// add adds a qualifier to the query
+func (q *searchQuery) add(key, value string) {
+	q.qualifiers[key] = append(q.qualifiers[key], value)
+}
+
+// score returns the relevance of the library for the query and whether the
+// library matches it at all. A match on the name ranks higher than a match
+// on the sentence, that ranks higher than a match on the paragraph; within
+// the same tier recommended libraries and libraries with more releases come
+// first.
+func (q *searchQuery) score(lib *librariesindex.Library) (int, bool) {
+	rel := lib.Latest
+	if rel == nil {
+		return 0, false
+	}
+	for key, values := range q.qualifiers {
+		for _, value := range values {
+			if !searchQualifiers[key](rel, value) {
+				return 0, false
+			}
+		}
+	}
+
+	score := 0
+	if len(q.terms) > 0 {
+		switch {
+		case strings.EqualFold(lib.Name, strings.Join(q.terms, " ")):
+			score = 400
+		case utils.Match(lib.Name, q.terms):
+			score = 300
+		case utils.Match(rel.Sentence, q.terms):
+			score = 200
+		case utils.Match(rel.Paragraph, q.terms):
+			score = 100
+		default:
+			return 0, false
+		}
+	}
+
+	if containsFold(rel.Types, "Recommended") {
+		score += 50
+	}
+	if releases := len(lib.Releases); releases < 40 {
+		score += releases
+	} else {
+		score += 40
+	}
+	return score, true
+}
+
+func containsFold(list []string, value string) bool {
+	for _, item := range list {
+		if strings.EqualFold(item, value) {
+			return true
+		}
+	}
+	return false
+}
@@ -8,6 +8,7 @@ import (
 	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
 	paths "github.com/arduino/go-paths-helper"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 var customIndexPath = paths.New("testdata")
@@ -16,12 +17,7 @@ func TestSearchLibrary(t *testing.T) {
 	lm := librariesmanager.NewLibraryManager(customIndexPath, nil)
 	lm.LoadIndex()
 
-	req := &rpc.LibrarySearchRequest{
-		Instance: &rpc.Instance{Id: 1},
-		Query:    "test",
-	}
-
-	resp := searchLibrary(req, lm)
+	resp := searchLibrary(parseSearchQuery("test"), lm)
 	assert := assert.New(t)
 	assert.Equal(resp.GetStatus(), rpc.LibrarySearchStatus_LIBRARY_SEARCH_STATUS_SUCCESS)
 	assert.Equal(len(resp.GetLibraries()), 2)
@@ -33,12 +29,7 @@ func TestSearchLibrarySimilar(t *testing.T) {
 	lm := librariesmanager.NewLibraryManager(customIndexPath, nil)
 	lm.LoadIndex()
 
-	req := &rpc.LibrarySearchRequest{
-		Instance: &rpc.Instance{Id: 1},
-		Query:    "arduino",
-	}
-
-	resp := searchLibrary(req, lm)
+	resp := searchLibrary(parseSearchQuery("arduino"), lm)
 	assert := assert.New(t)
 	assert.Equal(resp.GetStatus(), rpc.LibrarySearchStatus_LIBRARY_SEARCH_STATUS_SUCCESS)
 	assert.Equal(len(resp.GetLibraries()), 2)
@@ -49,3 +40,42 @@ func TestSearchLibrarySimilar(t *testing.T) {
 	assert.Contains(libs, "ArduinoTestPackage")
 	assert.Contains(libs, "Arduino")
 }
+
+func TestParseSearchQuery(t *testing.T) {
+	q := parseSearchQuery(`  bme280 author:"Adafruit Industries" Arch:samd depends:Wire foo:bar sensor:  `)
+	require.Equal(t, []string{"bme280", "foo:bar", "sensor:"}, q.terms)
+	require.Equal(t, map[string][]string{
+		"author":  {"Adafruit Industries"},
+		"arch":    {"samd"},
+		"depends": {"Wire"},
+	}, q.qualifiers)
+
+	q = parseSearchQuery("")
+	require.Empty(t, q.terms)
+	require.Empty(t, q.qualifiers)
+}
+
+func TestSearchLibraryRanking(t *testing.T) {
+	lm := librariesmanager.NewLibraryManager(customIndexPath.Join("search"), nil)
+	require.NoError(t, lm.LoadIndex())
+
+	search := func(query string) []string {
+		names := []string{}
+		for _, lib := range searchLibrary(parseSearchQuery(query), lm).GetLibraries() {
+			names = append(names, lib.Name)
+		}
+		return names
+	}
+
+	// Exact name first, then names by recommended and releases, then sentence
+	// and paragraph matches
+	require.Equal(t, []string{"BME280", "Adafruit BME280 Library", "SparkFun BME280", "Weather Station"}, search("bme280"))
+	require.Equal(t, []string{"Adafruit BME280 Library", "BME280", "SparkFun BME280"}, search("humidity"))
+
+	require.Equal(t, []string{"Adafruit BME280 Library"}, search("author:adafruit category:Sensors"))
+	require.Equal(t, []string{"Adafruit BME280 Library", "Weather Station"}, search("depends:wire"))
+	require.Equal(t, []string{"Weather Station"}, search("depends:wire arch:samd category:communication"))
+	require.Equal(t, []string{"Adafruit BME280 Library", "SparkFun BME280", "Weather Station"}, search("bme280 arch:samd"))
+	require.Equal(t, []string{"SparkFun BME280"}, search(`author:"SparkFun Electronics" type:contributed`))
+	require.Empty(t, search("bme280 depends:SPI"))
+}
@@ -19,6 +19,7 @@ import (
 	"context"
 
 	"github.com/arduino/arduino-cli/arduino"
+	"github.com/arduino/arduino-cli/arduino/libraries"
 	"github.com/arduino/arduino-cli/commands"
 	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
 )
@@ -26,18 +27,58 @@ import (
 // LibraryUninstall FIXMEDOC
 func LibraryUninstall(ctx context.Context, req *rpc.LibraryUninstallRequest, taskCB commands.TaskProgressCB) error {
 	lm := commands.GetLibraryManager(req.GetInstance().GetId())
-	ref, err := createLibIndexReference(lm, req)
-	if err != nil {
-		return &arduino.InvalidLibraryError{Cause: err}
+	if lm == nil {
+		return &arduino.InvalidInstanceError{}
 	}
 
-	lib := lm.FindByReference(ref)
+	libRefs := toLibraryReferencers(req.GetAdditionalLibraries())
+	if req.GetName() != "" {
+		libRefs = append([]libraryReferencer{req}, libRefs...)
+	}
+	toUninstall := []*libraries.Library{}
+	for _, libRef := range libRefs {
+		ref, err := createLibIndexReference(lm, libRef)
+		if err != nil {
+			return &arduino.InvalidLibraryError{Cause: err}
+		}
+		lib := lm.FindByReference(ref)
+		if lib == nil {
+			taskCB(&rpc.TaskProgress{Message: tr("Library %s is not installed", libRef.GetName()), Completed: true})
+			continue
+		}
+		toUninstall = append(toUninstall, lib)
+	}
+
+	if !req.GetForce() {
+		dependents := lm.Dependents(toUninstall...)
+		for _, lib := range toUninstall {
+			if libDependents, ok := dependents[lib]; ok {
+				names := []string{}
+				for _, dependent := range libDependents {
+					names = append(names, dependent.String())
+				}
+				return &arduino.LibraryRequiredByOthersError{Library: lib.String(), Dependents: names}
+			}
+		}
+	}
 
-	if lib == nil {
-		taskCB(&rpc.TaskProgress{Message: tr("Library %s is not installed", req.Name), Completed: true})
-	} else {
+	orphans := []*libraries.Library{}
+	if req.GetAutoremove() {
+		orphans = lm.Orphans(toUninstall...)
+	}
+
+	for _, lib := range toUninstall {
 		taskCB(&rpc.TaskProgress{Name: tr("Uninstalling %s", lib)})
-		lm.Uninstall(lib)
+		if err := lm.Uninstall(lib); err != nil {
+			return &arduino.FailedUninstallError{Message: tr("Error uninstalling %s", lib), Cause: err}
+		}
+		taskCB(&rpc.TaskProgress{Completed: true})
+	}
+	for _, lib := range orphans {
+		taskCB(&rpc.TaskProgress{Name: tr("Uninstalling %s, not required anymore", lib)})
+		if err := lm.Uninstall(lib); err != nil {
+			return &arduino.FailedUninstallError{Message: tr("Error uninstalling %s", lib), Cause: err}
+		}
 		taskCB(&rpc.TaskProgress{Completed: true})
 	}
 
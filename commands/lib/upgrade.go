@@ -16,10 +16,16 @@
 package lib
 
 import (
+	"errors"
+	"fmt"
+	"strings"
+
 	"github.com/arduino/arduino-cli/arduino"
+	"github.com/arduino/arduino-cli/arduino/libraries"
 	"github.com/arduino/arduino-cli/arduino/libraries/librariesmanager"
 	"github.com/arduino/arduino-cli/commands"
 	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
+	"github.com/sirupsen/logrus"
 )
 
 // LibraryUpgradeAll upgrades all the available libraries
@@ -32,12 +38,13 @@ func LibraryUpgradeAll(instanceID int32, downloadCB commands.DownloadProgressCB,
 	if err := upgrade(lm, listLibraries(lm, true, false), downloadCB, taskCB); err != nil {
 		return err
 	}
+	gitErr := UpgradeGitLibraries(lm, taskCB)
 
 	if err := commands.Init(&rpc.InitRequest{Instance: &rpc.Instance{Id: instanceID}}, nil); err != nil {
 		return err
 	}
 
-	return nil
+	return gitErr
 }
 
 // LibraryUpgrade upgrades only the given libraries
@@ -50,8 +57,20 @@ func LibraryUpgrade(instanceID int32, libraryNames []string, downloadCB commands
 	// get the libs to upgrade
 	libs := filterByName(listLibraries(lm, true, true), libraryNames)
 
+	gitLibs := []*libraries.Library{}
+	for _, lib := range listGitLibraries(lm) {
+		for _, name := range libraryNames {
+			if lib.Name == name {
+				gitLibs = append(gitLibs, lib)
+			}
+		}
+	}
+
 	// do it
-	return upgrade(lm, libs, downloadCB, taskCB)
+	if err := upgrade(lm, libs, downloadCB, taskCB); err != nil {
+		return err
+	}
+	return upgradeGitLibraries(lm, gitLibs, taskCB)
 }
 
 func upgrade(lm *librariesmanager.LibrariesManager, libs []*installedLib, downloadCB commands.DownloadProgressCB, taskCB commands.TaskProgressCB) error {
@@ -64,7 +83,7 @@ func upgrade(lm *librariesmanager.LibrariesManager, libs []*installedLib, downlo
 
 	// Go through the list and install them
 	for _, lib := range libs {
-		if err := installLibrary(lm, lib.Available, taskCB); err != nil {
+		if err := installLibrary(lm, lib.Available, lib.Library.InstalledAsDependency, taskCB); err != nil {
 			return err
 		}
 	}
@@ -72,6 +91,55 @@ func upgrade(lm *librariesmanager.LibrariesManager, libs []*installedLib, downlo
 	return nil
 }
 
+// UpgradeGitLibraries upgrades all the libraries installed from a git
+// repository, without reinitializing the instance
+func UpgradeGitLibraries(lm *librariesmanager.LibrariesManager, taskCB commands.TaskProgressCB) error {
+	return upgradeGitLibraries(lm, listGitLibraries(lm), taskCB)
+}
+
+// listGitLibraries returns the libraries installed from a git repository
+func listGitLibraries(lm *librariesmanager.LibrariesManager) []*libraries.Library {
+	res := []*libraries.Library{}
+	for _, libAlternatives := range lm.Libraries {
+		for _, lib := range libAlternatives.Alternatives {
+			if lib.Location == libraries.User && lib.GitOrigin != nil {
+				res = append(res, lib)
+			}
+		}
+	}
+	return res
+}
+
+// upgradeGitLibraries installs the newer tag, or the tip of the branch, of the
+// repository of the given libraries. A library that can't be upgraded doesn't
+// stop the upgrade of the others, the failures are reported together at the end.
+func upgradeGitLibraries(lm *librariesmanager.LibrariesManager, libs []*libraries.Library, taskCB commands.TaskProgressCB) error {
+	credentials := gitCredentials()
+	failures := []string{}
+	for _, lib := range libs {
+		taskCB(&rpc.TaskProgress{Name: tr("Checking updates for %s", lib.Name)})
+		gitURL, err := librariesmanager.FindGitLibUpdate(lib.GitOrigin, credentials)
+		if err == nil && gitURL != "" {
+			logrus.WithField("library", lib.Name).WithField("url", gitURL).Info("Upgrading git library")
+			err = lm.InstallGitLib(gitURL, true, credentials)
+		}
+		switch {
+		case err != nil:
+			logrus.WithError(err).WithField("library", lib.Name).Warn("Cannot upgrade git library")
+			taskCB(&rpc.TaskProgress{Message: tr("Error upgrading %[1]s: %[2]s", lib.Name, err), Completed: true})
+			failures = append(failures, fmt.Sprintf("%s: %s", lib.Name, err))
+		case gitURL == "":
+			taskCB(&rpc.TaskProgress{Message: tr("Library %s is up to date", lib.Name), Completed: true})
+		default:
+			taskCB(&rpc.TaskProgress{Message: tr("Installed %s", gitURL), Completed: true})
+		}
+	}
+	if len(failures) > 0 {
+		return &arduino.FailedLibraryInstallError{Cause: errors.New(tr("cannot upgrade %s", strings.Join(failures, "; ")))}
+	}
+	return nil
+}
+
 func filterByName(libs []*installedLib, names []string) []*installedLib {
 	// put the names in a map to ease lookup
 	queryMap := make(map[string]struct{})
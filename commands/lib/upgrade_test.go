@@ -0,0 +1,80 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package lib
+
+import (
+	"testing"
+	"time"
+
+	"github.com/arduino/arduino-cli/arduino/libraries"
+	"github.com/arduino/arduino-cli/arduino/libraries/librariesmanager"
+	"github.com/arduino/arduino-cli/configuration"
+	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
+	"github.com/arduino/go-paths-helper"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/src-d/go-git.v4"
+	"gopkg.in/src-d/go-git.v4/plumbing/object"
+)
+
+func TestUpgradeGitLibrariesContinuesOnFailure(t *testing.T) {
+	tmp := paths.New(t.TempDir())
+	settings := configuration.Settings
+	t.Cleanup(func() { configuration.Settings = settings })
+	configuration.Settings = configuration.Init(tmp.Join("arduino-cli.yaml").String())
+
+	// A repository with a release tagged for each version
+	repoDir := tmp.Join("repo", "MyLib")
+	repo, err := git.PlainInit(repoDir.String(), false)
+	require.NoError(t, err)
+	tree, err := repo.Worktree()
+	require.NoError(t, err)
+	for _, version := range []string{"1.0.0", "1.1.0"} {
+		require.NoError(t, repoDir.Join("library.properties").WriteFile([]byte("name=MyLib\nversion="+version+"\n")))
+		require.NoError(t, repoDir.Join("MyLib.h").WriteFile([]byte("// "+version+"\n")))
+		_, err = tree.Add(".")
+		require.NoError(t, err)
+		hash, err := tree.Commit("Release "+version, &git.CommitOptions{
+			Author: &object.Signature{Name: "test", Email: "test@example.com", When: time.Now()},
+		})
+		require.NoError(t, err)
+		_, err = repo.CreateTag("v"+version, hash, nil)
+		require.NoError(t, err)
+	}
+
+	libsDir := tmp.Join("libraries")
+	lm := librariesmanager.NewLibraryManager(nil, nil)
+	lm.AddLibrariesDir(libsDir, libraries.User)
+	require.NoError(t, lm.InstallGitLib(repoDir.String()+"#v1.0.0", false, nil))
+	installed, err := libraries.Load(libsDir.Join("MyLib"), libraries.User)
+	require.NoError(t, err)
+
+	broken := &libraries.Library{
+		Name:      "Broken",
+		GitOrigin: &libraries.GitOrigin{URL: tmp.Join("missing").String(), Ref: "v1.0.0"},
+	}
+	messages := []string{}
+	err = upgradeGitLibraries(lm, []*libraries.Library{broken, installed}, func(p *rpc.TaskProgress) {
+		messages = append(messages, p.GetMessage())
+	})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "Broken")
+
+	// The library after the failed one has been upgraded anyway
+	origin, err := libraries.LoadGitOrigin(libsDir.Join("MyLib"))
+	require.NoError(t, err)
+	require.Equal(t, "v1.1.0", origin.Ref)
+	require.Contains(t, messages, "Installed "+repoDir.String()+"#v1.1.0")
+}
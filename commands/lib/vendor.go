@@ -0,0 +1,108 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package lib
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io/ioutil"
+	"strings"
+
+	"github.com/arduino/arduino-cli/arduino"
+	"github.com/arduino/arduino-cli/arduino/sketch"
+	"github.com/arduino/arduino-cli/commands"
+	"github.com/arduino/arduino-cli/commands/compile"
+	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
+	"github.com/arduino/go-paths-helper"
+	"github.com/sirupsen/logrus"
+)
+
+// LibraryVendor copies the libraries used by a sketch into the sketch
+// libraries folder, so that they take precedence over any other installed
+// library when the sketch is compiled.
+func LibraryVendor(ctx context.Context, req *rpc.LibraryVendorRequest, taskCB commands.TaskProgressCB) error {
+	if req.GetSketchPath() == "" {
+		return &arduino.MissingSketchPathError{}
+	}
+	sk, err := sketch.New(paths.New(req.GetSketchPath()))
+	if err != nil {
+		return &arduino.CantOpenSketchError{Cause: err}
+	}
+
+	// Build the sketch to find out which libraries are actually used
+	taskCB(&rpc.TaskProgress{Name: tr("Resolving libraries used by %s", sk.Name)})
+	compileErr := &bytes.Buffer{}
+	res, err := compile.Compile(ctx, &rpc.CompileRequest{
+		Instance:   req.GetInstance(),
+		Fqbn:       req.GetFqbn(),
+		SketchPath: sk.FullPath.String(),
+	}, ioutil.Discard, compileErr, nil, false)
+	if err != nil {
+		if output := strings.TrimSpace(compileErr.String()); output != "" {
+			return &arduino.CompileFailedError{Message: output}
+		}
+		return err
+	}
+	taskCB(&rpc.TaskProgress{Completed: true})
+
+	librariesDir := sk.LibrariesDir()
+	if err := librariesDir.MkdirAll(); err != nil {
+		return &arduino.PermissionDeniedError{Message: tr("Cannot create libraries folder"), Cause: err}
+	}
+	for _, lib := range res.GetUsedLibraries() {
+		if err := vendorLibrary(lib, librariesDir, req.GetOverwrite(), taskCB); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func vendorLibrary(lib *rpc.Library, librariesDir *paths.Path, overwrite bool, taskCB commands.TaskProgressCB) error {
+	taskCB(&rpc.TaskProgress{Name: tr("Vendoring %s", lib.GetName())})
+	switch lib.GetLocation() {
+	case rpc.LibraryLocation_LIBRARY_LOCATION_PLATFORM_BUILTIN, rpc.LibraryLocation_LIBRARY_LOCATION_REFERENCED_PLATFORM_BUILTIN:
+		// Platform bundled libraries are tied to the platform version and
+		// architecture, they must not be copied into the sketch
+		taskCB(&rpc.TaskProgress{Message: tr("Skipping %s, it's bundled with the platform", lib.GetName()), Completed: true})
+		return nil
+	}
+	installDir := paths.New(lib.GetInstallDir())
+	if isInside, err := installDir.IsInsideDir(librariesDir); err == nil && isInside {
+		taskCB(&rpc.TaskProgress{Message: tr("Already vendored %s", lib.GetName()), Completed: true})
+		return nil
+	}
+
+	vendorDir := librariesDir.Join(installDir.Base())
+	if vendorDir.Exist() {
+		if !overwrite {
+			return &arduino.FailedInstallError{
+				Message: tr("Cannot vendor library %s", lib.GetName()),
+				Cause:   errors.New(tr("destination %s already exists, use --overwrite to replace it", vendorDir))}
+		}
+		taskCB(&rpc.TaskProgress{Message: tr("Replacing %s", vendorDir)})
+		if err := vendorDir.RemoveAll(); err != nil {
+			return &arduino.PermissionDeniedError{Message: tr("Cannot remove %s", vendorDir), Cause: err}
+		}
+	}
+
+	logrus.WithField("library", lib.GetName()).WithField("to", vendorDir).Info("Vendoring library")
+	if err := installDir.CopyDirTo(vendorDir); err != nil {
+		return &arduino.FailedInstallError{Message: tr("Cannot vendor library %s", lib.GetName()), Cause: err}
+	}
+	taskCB(&rpc.TaskProgress{Message: tr("Vendored %[1]s into %[2]s", lib.GetName(), vendorDir), Completed: true})
+	return nil
+}
@@ -0,0 +1,54 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package lib
+
+import (
+	"testing"
+
+	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
+	"github.com/arduino/go-paths-helper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVendorLibrarySkipsPlatformLibraries(t *testing.T) {
+	tmp := paths.New(t.TempDir())
+	librariesDir := tmp.Join("sketch", "libraries")
+	require.NoError(t, librariesDir.MkdirAll())
+	noopCB := func(*rpc.TaskProgress) {}
+
+	userLib := tmp.Join("user", "MyLib")
+	require.NoError(t, userLib.MkdirAll())
+	require.NoError(t, userLib.Join("MyLib.h").WriteFile([]byte{}))
+	platformLib := tmp.Join("platform", "SPI")
+	require.NoError(t, platformLib.MkdirAll())
+	require.NoError(t, platformLib.Join("SPI.h").WriteFile([]byte{}))
+
+	err := vendorLibrary(&rpc.Library{
+		Name:       "MyLib",
+		InstallDir: userLib.String(),
+		Location:   rpc.LibraryLocation_LIBRARY_LOCATION_USER,
+	}, librariesDir, false, noopCB)
+	require.NoError(t, err)
+	require.True(t, librariesDir.Join("MyLib", "MyLib.h").Exist())
+
+	err = vendorLibrary(&rpc.Library{
+		Name:       "SPI",
+		InstallDir: platformLib.String(),
+		Location:   rpc.LibraryLocation_LIBRARY_LOCATION_PLATFORM_BUILTIN,
+	}, librariesDir, false, noopCB)
+	require.NoError(t, err)
+	require.False(t, librariesDir.Join("SPI").Exist())
+}
@@ -23,10 +23,13 @@ import (
 	"github.com/arduino/arduino-cli/arduino"
 	"github.com/arduino/arduino-cli/arduino/cores"
 	"github.com/arduino/arduino-cli/arduino/cores/packagemanager"
+	"github.com/arduino/arduino-cli/arduino/crashdump"
 	pluggableMonitor "github.com/arduino/arduino-cli/arduino/monitor"
+	"github.com/arduino/arduino-cli/arduino/sketch"
 	"github.com/arduino/arduino-cli/commands"
 	"github.com/arduino/arduino-cli/i18n"
 	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
+	"github.com/arduino/go-paths-helper"
 	"github.com/arduino/go-properties-orderedmap"
 	"github.com/sirupsen/logrus"
 )
@@ -66,6 +69,15 @@ func Monitor(ctx context.Context, req *rpc.MonitorRequest) (*PortProxy, *pluggab
 		return nil, nil, &arduino.InvalidInstanceError{}
 	}
 
+	var symbolizer *crashdump.Symbolizer
+	if req.GetDecode() {
+		s, err := loadSymbolizer(req)
+		if err != nil {
+			return nil, nil, err
+		}
+		symbolizer = s
+	}
+
 	m, err := findMonitorForProtocolAndBoard(pm, req.GetPort().GetProtocol(), req.GetFqbn())
 	if err != nil {
 		return nil, nil, err
@@ -94,14 +106,56 @@ func Monitor(ctx context.Context, req *rpc.MonitorRequest) (*PortProxy, *pluggab
 	}
 
 	logrus.Infof("Port %s successfully opened", req.GetPort().GetAddress())
-	return &PortProxy{
+	proxy := &PortProxy{
 		rw:               monIO,
 		changeSettingsCB: m.Configure,
 		closeCB: func() error {
 			m.Close()
 			return m.Quit()
 		},
-	}, descriptor, nil
+	}
+	if symbolizer != nil {
+		proxy.rw, proxy.closeCB = decodeCrashDumps(monIO, symbolizer, proxy.closeCB)
+	}
+	return proxy, descriptor, nil
+}
+
+// loadSymbolizer loads the symbols of the ELF file used to decode the crash
+// dumps, from the request or from the last build of the sketch
+func loadSymbolizer(req *rpc.MonitorRequest) (*crashdump.Symbolizer, error) {
+	elfPath := paths.New(req.GetElfPath())
+	if elfPath == nil {
+		sk, err := sketch.New(paths.New(req.GetSketchPath()))
+		if err != nil {
+			return nil, &arduino.CantOpenSketchError{Cause: err}
+		}
+		if elfPath, err = crashdump.SketchELF(sk); err != nil {
+			return nil, &arduino.InvalidArgumentError{Message: tr("Cannot decode crash dumps"), Cause: err}
+		}
+	}
+	symbolizer, err := crashdump.NewSymbolizer(elfPath)
+	if err != nil {
+		return nil, &arduino.InvalidArgumentError{Message: tr("Error loading symbols"), Cause: err}
+	}
+	return symbolizer, nil
+}
+
+// decodeCrashDumps returns a ReadWriter where the data read from the port
+// is followed by the source locations of the crash dumps it contains, and the
+// close callback stopping the decoding
+func decodeCrashDumps(port io.ReadWriter, symbolizer *crashdump.Symbolizer, closeCB func() error) (io.ReadWriter, func() error) {
+	decoded, decodedWriter := io.Pipe()
+	go func() {
+		decodedWriter.CloseWithError(crashdump.Decode(port, decodedWriter, symbolizer))
+	}()
+	rw := struct {
+		io.Reader
+		io.Writer
+	}{decoded, port}
+	return rw, func() error {
+		decoded.Close()
+		return closeCB()
+	}
 }
 
 func findMonitorForProtocolAndBoard(pm *packagemanager.PackageManager, protocol, fqbn string) (*pluggableMonitor.PluggableMonitor, error) {
@@ -0,0 +1,61 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package monitor
+
+import (
+	"bytes"
+	"io"
+	"io/ioutil"
+	"strings"
+	"testing"
+
+	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
+	"github.com/arduino/go-paths-helper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDecodeCrashDumps(t *testing.T) {
+	symbolizer, err := loadSymbolizer(&rpc.MonitorRequest{ElfPath: "../../arduino/crashdump/testdata/crash.elf"})
+	require.NoError(t, err)
+
+	port := struct {
+		io.Reader
+		io.Writer
+	}{strings.NewReader("boot\nBacktrace: 0x00401044:0x3ffb1f60\n"), &bytes.Buffer{}}
+	closed := false
+	rw, closeCB := decodeCrashDumps(port, symbolizer, func() error {
+		closed = true
+		return nil
+	})
+
+	// Data written goes to the port unchanged
+	_, err = rw.Write([]byte("reset"))
+	require.NoError(t, err)
+	require.Equal(t, "reset", port.Writer.(*bytes.Buffer).String())
+
+	// Data read from the port is decoded
+	out, err := ioutil.ReadAll(rw)
+	require.NoError(t, err)
+	require.Equal(t, "boot\nBacktrace: 0x00401044:0x3ffb1f60\n  0x00401044: loop at /tmp/crash/crash.c:14\n", string(out))
+
+	require.NoError(t, closeCB())
+	require.True(t, closed)
+
+	_, err = loadSymbolizer(&rpc.MonitorRequest{SketchPath: paths.New("testdata", "missing").String()})
+	require.Error(t, err)
+	_, err = loadSymbolizer(&rpc.MonitorRequest{ElfPath: "../../arduino/crashdump/testdata/crash.c"})
+	require.Error(t, err)
+}
@@ -0,0 +1,102 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package upgrade
+
+import (
+	"context"
+	"errors"
+
+	"github.com/arduino/arduino-cli/arduino"
+	"github.com/arduino/arduino-cli/arduino/libraries"
+	"github.com/arduino/arduino-cli/arduino/libraries/librariesmanager"
+	"github.com/arduino/arduino-cli/commands"
+	"github.com/arduino/arduino-cli/commands/core"
+	"github.com/arduino/arduino-cli/commands/lib"
+	"github.com/arduino/arduino-cli/i18n"
+	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
+)
+
+var tr = i18n.Tr
+
+// Upgrade downloads and installs outdated Cores and Libraries
+func Upgrade(ctx context.Context, req *rpc.UpgradeRequest, downloadCB commands.DownloadProgressCB, taskCB commands.TaskProgressCB) error {
+	downloaderConfig, err := commands.GetDownloaderConfig()
+	if err != nil {
+		return err
+	}
+
+	lm := commands.GetLibraryManager(req.Instance.Id)
+	if lm == nil {
+		return &arduino.InvalidInstanceError{}
+	}
+
+	for _, libAlternatives := range lm.Libraries {
+		for _, library := range libAlternatives.Alternatives {
+			if library.Location != libraries.User || library.GitOrigin != nil {
+				// Libraries installed from git are upgraded from their repository
+				continue
+			}
+			available := lm.Index.FindLibraryUpdate(library)
+			if available == nil {
+				continue
+			}
+
+			// Downloads latest library release
+			taskCB(&rpc.TaskProgress{Name: tr("Downloading %s", available)})
+			if d, err := available.Resource.Download(lm.DownloadsDir, downloaderConfig); err != nil {
+				return &arduino.FailedDownloadError{Message: tr("Error downloading library"), Cause: err}
+			} else if err := commands.Download(d, available.String(), downloadCB); err != nil {
+				return &arduino.FailedDownloadError{Message: tr("Error downloading library"), Cause: err}
+			}
+
+			// Installs downloaded library
+			taskCB(&rpc.TaskProgress{Name: tr("Installing %s", available)})
+			libPath, libReplaced, err := lm.InstallPrerequisiteCheck(available)
+			if errors.Is(err, librariesmanager.ErrAlreadyInstalled) {
+				taskCB(&rpc.TaskProgress{Message: tr("Already installed %s", available), Completed: true})
+				continue
+			} else if err != nil {
+				return &arduino.FailedLibraryInstallError{Cause: err}
+			}
+
+			if libReplaced != nil {
+				taskCB(&rpc.TaskProgress{Message: tr("Replacing %[1]s with %[2]s", libReplaced, available)})
+			}
+
+			if err := lm.Install(available, libPath); err != nil {
+				return &arduino.FailedLibraryInstallError{Cause: err}
+			}
+
+			taskCB(&rpc.TaskProgress{Message: tr("Installed %s", available), Completed: true})
+		}
+	}
+
+	// A library that can't be upgraded from git doesn't stop the upgrade
+	// of the platforms, the failure is reported at the end
+	gitErr := lib.UpgradeGitLibraries(lm, taskCB)
+
+	pm := commands.GetPackageManager(req.Instance.Id)
+	if pm == nil {
+		return &arduino.InvalidInstanceError{}
+	}
+
+	// Platforms are upgraded like `core upgrade` does, so that tools and
+	// required platforms are installed and any failure is rolled back
+	if err := core.UpgradeAllPlatforms(pm, downloadCB, taskCB, req.GetSkipPostInstall()); err != nil {
+		return err
+	}
+	return gitErr
+}
@@ -45,6 +45,8 @@ func BurnBootloader(ctx context.Context, req *rpc.BurnBootloaderRequest, outStre
 		req.GetVerbose(),
 		req.GetVerify(),
 		true, // burnBootloader
+		"",   // readRegion
+		"",   // readOutput
 		outStream,
 		errStream,
 		req.GetDryRun(),
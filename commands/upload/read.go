@@ -0,0 +1,69 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package upload
+
+import (
+	"context"
+	"io"
+
+	"github.com/arduino/arduino-cli/arduino"
+	"github.com/arduino/arduino-cli/commands"
+	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
+	"github.com/sirupsen/logrus"
+)
+
+// Read saves the content of a memory region of the board in a file, using the
+// read.pattern recipe of the tool selected by the read.tool property.
+func Read(ctx context.Context, req *rpc.BoardReadRequest, outStream io.Writer, errStream io.Writer) (*rpc.BoardReadResponse, error) {
+	logrus.
+		WithField("fqbn", req.GetFqbn()).
+		WithField("port", req.GetPort()).
+		WithField("programmer", req.GetProgrammer()).
+		WithField("region", req.GetRegion()).
+		Trace("Read started")
+
+	if req.GetRegion() == "" {
+		return nil, &arduino.InvalidArgumentError{Message: tr("Missing memory region")}
+	}
+
+	pm := commands.GetPackageManager(req.GetInstance().GetId())
+	if pm == nil {
+		return nil, &arduino.InvalidInstanceError{}
+	}
+
+	err := runProgramAction(
+		pm,
+		nil, // sketch
+		"",  // importFile
+		"",  // importDir
+		req.GetFqbn(),
+		req.GetPort(),
+		req.GetProgrammer(),
+		req.GetVerbose(),
+		false, // verify
+		false, // burnBootloader
+		req.GetRegion(),
+		req.GetOutputPath(),
+		outStream,
+		errStream,
+		req.GetDryRun(),
+		req.GetUserFields(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &rpc.BoardReadResponse{}, nil
+}
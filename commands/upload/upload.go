@@ -224,6 +224,8 @@ func Upload(ctx context.Context, req *rpc.UploadRequest, outStream io.Writer, er
 		req.GetVerbose(),
 		req.GetVerify(),
 		false, // burnBootloader
+		"",    // readRegion
+		"",    // readOutput
 		outStream,
 		errStream,
 		req.GetDryRun(),
@@ -262,12 +264,17 @@ func runProgramAction(pm *packagemanager.PackageManager,
 	importFile, importDir, fqbnIn string, port *rpc.Port,
 	programmerID string,
 	verbose, verify, burnBootloader bool,
+	readRegion, readOutput string,
 	outStream, errStream io.Writer,
 	dryRun bool, userFields map[string]string) error {
 
 	if burnBootloader && programmerID == "" {
 		return &arduino.MissingProgrammerError{}
 	}
+	read := readRegion != ""
+	if read && readOutput == "" {
+		return &arduino.InvalidArgumentError{Message: tr("Missing output file for the memory dump")}
+	}
 
 	logrus.WithField("port", port).Tracef("Upload port")
 
@@ -330,6 +337,8 @@ func runProgramAction(pm *packagemanager.PackageManager,
 	action := "upload"
 	if burnBootloader {
 		action = "bootloader"
+	} else if read {
+		action = "read"
 	} else if programmer != nil {
 		action = "program"
 	}
@@ -417,6 +426,9 @@ func runProgramAction(pm *packagemanager.PackageManager,
 		if v, ok := uploadProperties.GetOk("bootloader.params.verbose"); ok {
 			uploadProperties.Set("bootloader.verbose", v)
 		}
+		if v, ok := uploadProperties.GetOk("read.params.verbose"); ok {
+			uploadProperties.Set("read.verbose", v)
+		}
 	} else {
 		if v, ok := uploadProperties.GetOk("upload.params.quiet"); ok {
 			uploadProperties.Set("upload.verbose", v)
@@ -430,6 +442,9 @@ func runProgramAction(pm *packagemanager.PackageManager,
 		if v, ok := uploadProperties.GetOk("bootloader.params.quiet"); ok {
 			uploadProperties.Set("bootloader.verbose", v)
 		}
+		if v, ok := uploadProperties.GetOk("read.params.quiet"); ok {
+			uploadProperties.Set("read.verbose", v)
+		}
 	}
 
 	// Set properties for verify
@@ -445,7 +460,14 @@ func runProgramAction(pm *packagemanager.PackageManager,
 		uploadProperties.Set("bootloader.verify", uploadProperties.Get("bootloader.params.noverify"))
 	}
 
-	if !burnBootloader {
+	if read {
+		outputPath, err := paths.New(readOutput).Abs()
+		if err != nil {
+			return &arduino.InvalidArgumentError{Message: tr("Invalid output file for the memory dump"), Cause: err}
+		}
+		uploadProperties.Set("read.region", readRegion)
+		uploadProperties.SetPath("read.output.path", outputPath)
+	} else if !burnBootloader {
 		importPath, sketchName, err := determineBuildPathAndSketchName(importFile, importDir, sk, fqbn)
 		if err != nil {
 			return &arduino.NotFoundError{Message: tr("Error finding build artifacts"), Cause: err}
@@ -549,6 +571,15 @@ func runProgramAction(pm *packagemanager.PackageManager,
 		if err := runTool("bootloader.pattern", uploadProperties, outStream, errStream, verbose, dryRun, toolEnv); err != nil {
 			return &arduino.FailedUploadError{Message: tr("Failed to burn bootloader"), Cause: err}
 		}
+	} else if read {
+		// A region may need a specific recipe, otherwise the generic one is used
+		recipeID := "read.pattern"
+		if uploadProperties.ContainsKey(fmt.Sprintf("read.%s.pattern", readRegion)) {
+			recipeID = fmt.Sprintf("read.%s.pattern", readRegion)
+		}
+		if err := runTool(recipeID, uploadProperties, outStream, errStream, verbose, dryRun, toolEnv); err != nil {
+			return &arduino.FailedUploadError{Message: tr("Failed reading %s memory", readRegion), Cause: err}
+		}
 	} else if programmer != nil {
 		if err := runTool("program.pattern", uploadProperties, outStream, errStream, verbose, dryRun, toolEnv); err != nil {
 			return &arduino.FailedUploadError{Message: tr("Failed programming"), Cause: err}
@@ -191,6 +191,8 @@ func TestUploadPropertiesComposition(t *testing.T) {
 			verboseVerify,       // verbose
 			verboseVerify,       // verify
 			test.burnBootloader, // burnBootloader
+			"",                  // readRegion
+			"",                  // readOutput
 			outStream,
 			errStream,
 			false,
@@ -221,6 +223,67 @@ func TestUploadPropertiesComposition(t *testing.T) {
 	}
 }
 
+func TestReadPropertiesComposition(t *testing.T) {
+	pm := packagemanager.NewPackageManager(nil, nil, nil, nil, "test")
+	errs := pm.LoadHardwareFromDirectory(paths.New("testdata", "hardware"))
+	require.Len(t, errs, 0)
+
+	cwdPath, err := paths.Getwd()
+	require.NoError(t, err)
+	cwd := strings.ReplaceAll(cwdPath.String(), "\\", "/")
+
+	tests := []struct {
+		fqbn           string
+		port           string
+		region         string
+		output         string
+		expectedOutput string
+	}{
+		// 0: generic read recipe
+		{"alice:avr:board1", "port", "flash", "dump.bin", "READ conf-board1 conf-general quiet protocol port -bspeed flash " + cwd + "/dump.bin\n"},
+		// 1: region specific recipe
+		{"alice:avr:board1", "port", "eeprom", "dump.bin", "READ-EEPROM conf-board1 conf-general quiet protocol port " + cwd + "/dump.bin\n"},
+		// 2: requires port
+		{"alice:avr:board1", "", "flash", "dump.bin", "FAIL"},
+		// 3: requires output file
+		{"alice:avr:board1", "port", "flash", "", "FAIL"},
+		// 4: board without read tool
+		{"alice:avr:board2", "port", "flash", "dump.bin", "FAIL"},
+	}
+	for i, test := range tests {
+		t.Run(fmt.Sprintf("SubTest%02d", i), func(t *testing.T) {
+			outStream := &bytes.Buffer{}
+			errStream := &bytes.Buffer{}
+			err := runProgramAction(
+				pm,
+				nil, // sketch
+				"",  // importFile
+				"",  // importDir
+				test.fqbn,
+				&rpc.Port{Address: test.port, Protocol: "serial"},
+				"",    // programmer
+				false, // verbose
+				false, // verify
+				false, // burnBootloader
+				test.region,
+				test.output,
+				outStream,
+				errStream,
+				false,
+				map[string]string{},
+			)
+			if test.expectedOutput == "FAIL" {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			outFiltered := strings.ReplaceAll(outStream.String(), "\r", "")
+			outFiltered = strings.ReplaceAll(outFiltered, "\\", "/")
+			require.Contains(t, outFiltered, test.expectedOutput)
+		})
+	}
+}
+
 func TestGetToolId(t *testing.T) {
 	props, err := properties.LoadFromBytes([]byte(`
 bootloader.tool=avrdude
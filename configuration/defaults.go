@@ -30,9 +30,14 @@ func SetDefaults(settings *viper.Viper) {
 
 	// Libraries
 	settings.SetDefault("library.enable_unsafe_install", false)
+	settings.SetDefault("library.git.username", "")
+	settings.SetDefault("library.git.password", "")
+	settings.SetDefault("library.git.ssh_key", "")
+	settings.SetDefault("library.git.ssh_key_passphrase", "")
 
 	// Boards Manager
 	settings.SetDefault("board_manager.additional_urls", []string{})
+	settings.SetDefault("board_manager.require_signed_indexes", false)
 
 	// arduino directories
 	settings.SetDefault("directories.Data", getDefaultArduinoDataDir())
@@ -49,6 +54,9 @@ func SetDefaults(settings *viper.Viper) {
 	settings.SetDefault("metrics.enabled", true)
 	settings.SetDefault("metrics.addr", ":9090")
 
+	// network settings
+	settings.SetDefault("network.parallel_downloads", 4)
+
 	// output settings
 	settings.SetDefault("output.no_color", false)
 
@@ -33,7 +33,7 @@ func HardwareDirectories(settings *viper.Viper) paths.PathList {
 	}
 
 	if settings.IsSet("directories.Data") {
-		packagesDir := PackagesDir(Settings)
+		packagesDir := PackagesDir(settings)
 		if packagesDir.IsDir() {
 			res.Add(packagesDir)
 		}
@@ -90,3 +90,9 @@ func LibrariesDir(settings *viper.Viper) *paths.Path {
 func PackagesDir(settings *viper.Viper) *paths.Path {
 	return paths.New(settings.GetString("directories.Data")).Join("packages")
 }
+
+// KeyringDir returns the full path to the folder containing the keys trusted
+// to sign the package indexes
+func KeyringDir(settings *viper.Viper) *paths.Path {
+	return paths.New(settings.GetString("directories.Data")).Join("keys")
+}
@@ -178,6 +178,36 @@ func (s *ParseHardwareAndDumpBuildProperties) Run(ctx *types.Context) error {
 	return runCommands(ctx, commands)
 }
 
+// PrecompileLibrary builds ctx.LibraryToPrecompile, instead of a sketch, for
+// the selected board
+type PrecompileLibrary struct{}
+
+func (s *PrecompileLibrary) Run(ctx *types.Context) error {
+	if err := ctx.BuildPath.MkdirAll(); err != nil {
+		return err
+	}
+
+	commands := []types.Command{
+		&ContainerSetupHardwareToolsLibsSketchAndProps{},
+
+		utils.LogIfVerbose(false, tr("Detecting libraries used...")),
+		&ContainerFindIncludes{},
+
+		&WarnAboutArchIncompatibleLibraries{},
+
+		utils.LogIfVerbose(false, tr("Compiling library...")),
+		&phases.LibraryPrecompiler{},
+	}
+
+	mainErr := runCommands(ctx, commands)
+
+	if ctx.CompilationDatabase != nil {
+		ctx.CompilationDatabase.SaveToFile()
+	}
+
+	return mainErr
+}
+
 func runCommands(ctx *types.Context, commands []types.Command) error {
 	ctx.Progress.AddSubSteps(len(commands))
 	defer ctx.Progress.RemoveSubSteps()
@@ -208,6 +238,11 @@ func RunParseHardwareAndDumpBuildProperties(ctx *types.Context) error {
 	return command.Run(ctx)
 }
 
+func RunPrecompileLibrary(ctx *types.Context) error {
+	command := PrecompileLibrary{}
+	return command.Run(ctx)
+}
+
 func RunPreprocess(ctx *types.Context) error {
 	command := Preprocess{}
 	return command.Run(ctx)
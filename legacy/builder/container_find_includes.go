@@ -130,18 +130,26 @@ func (s *ContainerFindIncludes) findIncludes(ctx *types.Context) error {
 		appendIncludeFolder(ctx, cache, nil, "", ctx.BuildProperties.GetPath("build.variant.path"))
 	}
 
-	sketch := ctx.Sketch
-	mergedfile, err := types.MakeSourceFile(ctx, sketch, paths.New(sketch.MainFile.Base()+".cpp"))
-	if err != nil {
-		return errors.WithStack(err)
-	}
-	ctx.CollectedSourceFiles.Push(mergedfile)
-
 	sourceFilePaths := ctx.CollectedSourceFiles
-	queueSourceFilesFromFolder(ctx, sourceFilePaths, sketch, ctx.SketchBuildPath, false /* recurse */)
-	srcSubfolderPath := ctx.SketchBuildPath.Join("src")
-	if srcSubfolderPath.IsDir() {
-		queueSourceFilesFromFolder(ctx, sourceFilePaths, sketch, srcSubfolderPath, true /* recurse */)
+	if library := ctx.LibraryToPrecompile; library != nil {
+		// The headers of the library being precompiled are always reachable
+		appendIncludeFolder(ctx, cache, nil, "", library.SourceDir)
+		for _, sourceDir := range library.SourceDirs() {
+			queueSourceFilesFromFolder(ctx, sourceFilePaths, library, sourceDir.Dir, sourceDir.Recurse)
+		}
+	} else {
+		sketch := ctx.Sketch
+		mergedfile, err := types.MakeSourceFile(ctx, sketch, paths.New(sketch.MainFile.Base()+".cpp"))
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		sourceFilePaths.Push(mergedfile)
+
+		queueSourceFilesFromFolder(ctx, sourceFilePaths, sketch, ctx.SketchBuildPath, false /* recurse */)
+		srcSubfolderPath := ctx.SketchBuildPath.Join("src")
+		if srcSubfolderPath.IsDir() {
+			queueSourceFilesFromFolder(ctx, sourceFilePaths, sketch, srcSubfolderPath, true /* recurse */)
+		}
 	}
 
 	for !sourceFilePaths.Empty() {
@@ -154,13 +162,11 @@ func (s *ContainerFindIncludes) findIncludes(ctx *types.Context) error {
 
 	// Finalize the cache
 	cache.ExpectEnd()
-	err = writeCache(cache, cachePath)
-	if err != nil {
+	if err := writeCache(cache, cachePath); err != nil {
 		return errors.WithStack(err)
 	}
 
-	err = runCommand(ctx, &FailIfImportedLibraryIsWrong{})
-	if err != nil {
+	if err := runCommand(ctx, &FailIfImportedLibraryIsWrong{}); err != nil {
 		return errors.WithStack(err)
 	}
 
@@ -20,6 +20,7 @@ import (
 	"github.com/arduino/arduino-cli/arduino/libraries/librariesmanager"
 	"github.com/arduino/arduino-cli/arduino/libraries/librariesresolver"
 	"github.com/arduino/arduino-cli/legacy/builder/types"
+	"github.com/arduino/go-paths-helper"
 	"github.com/pkg/errors"
 )
 
@@ -52,6 +53,10 @@ func (s *LibrariesLoader) Run(ctx *types.Context) error {
 		lm.AddLibrariesDir(folder, libraries.User)
 	}
 
+	if ctx.SketchLibrariesDir != nil {
+		lm.AddLibrariesDir(ctx.SketchLibrariesDir, libraries.Sketch)
+	}
+
 	if errs := lm.RescanLibraries(); len(errs) > 0 {
 		// With the refactoring of the initialization step of the CLI we changed how
 		// errors are returned when loading platforms and libraries, that meant returning a list of
@@ -69,11 +74,45 @@ func (s *LibrariesLoader) Run(ctx *types.Context) error {
 		}
 	}
 
+	// Relative paths in the overrides are relative to the sketch folder
+	var sketchDir *paths.Path
+	if ctx.SketchLocation != nil {
+		sketchDir = ctx.SketchLocation.Parent()
+	}
+	overrides := map[string]*librariesresolver.LibraryOverride{}
+	for header, value := range ctx.LibraryOverrides {
+		override, err := librariesresolver.ParseLibraryOverride(value, sketchDir)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		if override.Path != nil && !isLibraryLoaded(lm, override.Path) {
+			// Libraries forced by path may be outside of the libraries folders
+			if err := lm.LoadLibraryFromDir(override.Path, libraries.Unmanaged); err != nil {
+				return err
+			}
+		}
+		overrides[header] = override
+	}
+
 	resolver := librariesresolver.NewCppResolver()
 	if err := resolver.ScanFromLibrariesManager(lm); err != nil {
 		return errors.WithStack(err)
 	}
+	for header, override := range overrides {
+		resolver.SetOverride(header, override)
+	}
 	ctx.LibrariesResolver = resolver
 
 	return nil
 }
+
+func isLibraryLoaded(lm *librariesmanager.LibrariesManager, libDir *paths.Path) bool {
+	for _, alternatives := range lm.Libraries {
+		for _, lib := range alternatives.Alternatives {
+			if lib.InstallDir != nil && lib.InstallDir.EquivalentTo(libDir) {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -60,7 +60,9 @@ func directoryContainsFile(folder *paths.Path) bool {
 	return false
 }
 
-func findExpectedPrecompiledLibFolder(ctx *types.Context, library *libraries.Library) *paths.Path {
+// precompiledLibSpecs returns the build.mcu of the board and the floating
+// point ABI specs, in the fpu-float_abi format, found in the compile recipe
+func precompiledLibSpecs(ctx *types.Context) (string, string) {
 	mcu := ctx.BuildProperties.Get(constants.BUILD_PROPERTIES_BUILD_MCU)
 	// Add fpu specifications if they exist
 	// To do so, resolve recipe.cpp.o.pattern,
@@ -85,12 +87,16 @@ func findExpectedPrecompiledLibFolder(ctx *types.Context, library *libraries.Lib
 			}
 		}
 	}
+	return mcu, strings.TrimRight(fpuSpecs, "-")
+}
+
+func findExpectedPrecompiledLibFolder(ctx *types.Context, library *libraries.Library) *paths.Path {
+	mcu, fpuSpecs := precompiledLibSpecs(ctx)
 
 	ctx.Info(tr("Library %[1]s has been declared precompiled:", library.Name))
 
 	// Try directory with full fpuSpecs first, if available
 	if len(fpuSpecs) > 0 {
-		fpuSpecs = strings.TrimRight(fpuSpecs, "-")
 		fullPrecompDir := library.SourceDir.Join(mcu).Join(fpuSpecs)
 		if fullPrecompDir.Exist() && directoryContainsFile(fullPrecompDir) {
 			ctx.Info(tr("Using precompiled library in %[1]s", fullPrecompDir))
@@ -0,0 +1,83 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package phases
+
+import (
+	"regexp"
+
+	"github.com/arduino/arduino-cli/arduino/libraries"
+	"github.com/arduino/arduino-cli/legacy/builder/builder_utils"
+	"github.com/arduino/arduino-cli/legacy/builder/types"
+	"github.com/arduino/arduino-cli/legacy/builder/utils"
+	"github.com/arduino/go-paths-helper"
+	"github.com/pkg/errors"
+)
+
+var invalidArchiveNameChars = regexp.MustCompile(`[^a-zA-Z0-9_]`)
+
+// LibraryPrecompiler compiles the sources of ctx.LibraryToPrecompile and
+// archives the object files in the static library that the LibrariesBuilder
+// expects to find in a precompiled library for the current board.
+type LibraryPrecompiler struct{}
+
+func (s *LibraryPrecompiler) Run(ctx *types.Context) error {
+	library := ctx.LibraryToPrecompile
+	if library.Layout != libraries.RecursiveLayout {
+		return errors.New(tr("precompiled libraries must use the recursive layout"))
+	}
+	mcu, fpuSpecs := precompiledLibSpecs(ctx)
+	if mcu == "" {
+		return errors.Errorf(tr("the board doesn't define %s, precompiled libraries are not supported"), "build.mcu")
+	}
+
+	// The sources are always compiled, even if the library already contains
+	// an archive for this board, and the object files are archived below
+	toCompile := *library
+	toCompile.Precompiled = false
+	toCompile.PrecompiledWithSources = false
+	toCompile.DotALinkage = false
+
+	if err := ctx.LibrariesBuildPath.MkdirAll(); err != nil {
+		return errors.WithStack(err)
+	}
+	includes := utils.Map(ctx.IncludeFolders.AsStrings(), utils.WrapWithHyphenI)
+	objectFiles, err := compileLibrary(ctx, &toCompile, ctx.LibrariesBuildPath, ctx.BuildProperties, includes)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	if len(objectFiles) == 0 {
+		return errors.Errorf(tr("no source files to compile found in %s"), library.SourceDir)
+	}
+
+	archive, err := builder_utils.ArchiveCompiledFiles(ctx, ctx.LibrariesBuildPath.Join(library.Name), paths.New(PrecompiledArchiveName(library)), objectFiles, ctx.BuildProperties)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	folder := paths.New(mcu)
+	if fpuSpecs != "" {
+		folder = folder.Join(fpuSpecs)
+	}
+	ctx.PrecompiledLibraryArchive = archive
+	ctx.PrecompiledLibraryFolder = folder
+	return nil
+}
+
+// PrecompiledArchiveName returns the file name of the static library of a
+// precompiled library, it starts with "lib" so that it's linked with -l
+func PrecompiledArchiveName(library *libraries.Library) string {
+	return "lib" + invalidArchiveNameChars.ReplaceAllString(library.Name, "_") + ".a"
+}
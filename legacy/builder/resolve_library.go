@@ -44,7 +44,11 @@ func ResolveLibrary(ctx *types.Context, header string) *libraries.Library {
 		}
 	}
 
-	selected := resolver.ResolveFor(header, ctx.TargetPlatform.Platform.Architecture)
+	resolution := resolver.Explain(header, ctx.TargetPlatform.Platform.Architecture)
+	selected := resolution.Selected
+	if override := resolution.UnmatchedOverride; override != nil {
+		ctx.Warn(tr("No library matching the override %[1]s found for %[2]s, using %[3]s", override, header, selected.InstallDir))
+	}
 	if alreadyImported := importedLibraries.FindByName(selected.Name); alreadyImported != nil {
 		// Certain libraries might have the same name but be different.
 		// This usually happens when the user includes two or more custom libraries that have
@@ -61,6 +65,7 @@ func ResolveLibrary(ctx *types.Context, header string) *libraries.Library {
 	ctx.LibrariesResolutionResults[header] = types.LibraryResolutionResult{
 		Library:          selected,
 		NotUsedLibraries: filterOutLibraryFrom(candidates, selected),
+		Resolution:       resolution,
 	}
 
 	return selected
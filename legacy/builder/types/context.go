@@ -71,8 +71,11 @@ type Context struct {
 	BuiltInToolsDirs     paths.PathList
 	BuiltInLibrariesDirs paths.PathList
 	OtherLibrariesDirs   paths.PathList
-	LibraryDirs          paths.PathList // List of paths pointing to individual library root folders
-	SketchLocation       *paths.Path    // SketchLocation points to the main Sketch file
+	LibraryDirs          paths.PathList     // List of paths pointing to individual library root folders
+	SketchLibrariesDir   *paths.Path        // SketchLibrariesDir points to the libraries vendored in the sketch
+	LibraryOverrides     map[string]string  // LibraryOverrides maps headers to the library forced to resolve them
+	SketchLocation       *paths.Path        // SketchLocation points to the main Sketch file
+	LibraryToPrecompile  *libraries.Library // LibraryToPrecompile is the library built instead of a sketch by PrecompileLibrary
 	WatchedLocations     paths.PathList
 	ArduinoAPIVersion    string
 	FQBN                 *cores.FQBN
@@ -107,6 +110,8 @@ type Context struct {
 	CoreObjectsFiles             paths.PathList
 	LibrariesBuildPath           *paths.Path
 	LibrariesObjectFiles         paths.PathList
+	PrecompiledLibraryArchive    *paths.Path
+	PrecompiledLibraryFolder     *paths.Path
 	PreprocPath                  *paths.Path
 	SketchObjectFiles            paths.PathList
 	IgnoreSketchFolderNameErrors bool
@@ -20,6 +20,7 @@ import (
 	"strconv"
 
 	"github.com/arduino/arduino-cli/arduino/libraries"
+	"github.com/arduino/arduino-cli/arduino/libraries/librariesresolver"
 	"github.com/arduino/arduino-cli/arduino/sketch"
 	paths "github.com/arduino/go-paths-helper"
 )
@@ -132,6 +133,7 @@ func (proto *Prototype) String() string {
 type LibraryResolutionResult struct {
 	Library          *libraries.Library
 	NotUsedLibraries []*libraries.Library
+	Resolution       *librariesresolver.Resolution
 }
 
 type CTag struct {
@@ -66,8 +66,24 @@ type ArduinoCoreServiceClient interface {
 	// Uninstall a platform as well as its tool dependencies that are not used by
 	// other installed platforms.
 	PlatformUninstall(ctx context.Context, in *PlatformUninstallRequest, opts ...grpc.CallOption) (ArduinoCoreService_PlatformUninstallClient, error)
+	// Verify the files of installed platforms against the ones recorded at
+	// install time, optionally reinstalling the damaged platforms.
+	PlatformVerify(ctx context.Context, in *PlatformVerifyRequest, opts ...grpc.CallOption) (ArduinoCoreService_PlatformVerifyClient, error)
+	// Verify the files of installed tools against the ones recorded at install
+	// time, optionally reinstalling the damaged tools.
+	ToolVerify(ctx context.Context, in *ToolVerifyRequest, opts ...grpc.CallOption) (ArduinoCoreService_ToolVerifyClient, error)
+	// Remove the installed tools that are not required by any of the installed
+	// platforms.
+	ToolPrune(ctx context.Context, in *ToolPruneRequest, opts ...grpc.CallOption) (ArduinoCoreService_ToolPruneClient, error)
 	// Upgrade an installed platform to the latest version.
 	PlatformUpgrade(ctx context.Context, in *PlatformUpgradeRequest, opts ...grpc.CallOption) (ArduinoCoreService_PlatformUpgradeClient, error)
+	// Reinstall the platform release replaced by the last upgrade of an
+	// installed platform.
+	PlatformRollback(ctx context.Context, in *PlatformRollbackRequest, opts ...grpc.CallOption) (ArduinoCoreService_PlatformRollbackClient, error)
+	// Check a package index against the package index specification.
+	PackageIndexLint(ctx context.Context, in *PackageIndexLintRequest, opts ...grpc.CallOption) (ArduinoCoreService_PackageIndexLintClient, error)
+	// Check a platform against the platform specification.
+	PlatformLint(ctx context.Context, in *PlatformLintRequest, opts ...grpc.CallOption) (*PlatformLintResponse, error)
 	// Upload a compiled sketch to a board.
 	Upload(ctx context.Context, in *UploadRequest, opts ...grpc.CallOption) (ArduinoCoreService_UploadClient, error)
 	// Upload a compiled sketch to a board using a programmer.
@@ -79,6 +95,8 @@ type ArduinoCoreServiceClient interface {
 	ListProgrammersAvailableForUpload(ctx context.Context, in *ListProgrammersAvailableForUploadRequest, opts ...grpc.CallOption) (*ListProgrammersAvailableForUploadResponse, error)
 	// Burn bootloader to a board.
 	BurnBootloader(ctx context.Context, in *BurnBootloaderRequest, opts ...grpc.CallOption) (ArduinoCoreService_BurnBootloaderClient, error)
+	// Read back the content of a memory region of a board.
+	BoardRead(ctx context.Context, in *BoardReadRequest, opts ...grpc.CallOption) (ArduinoCoreService_BoardReadClient, error)
 	// Search for a platform in the platforms indexes.
 	PlatformSearch(ctx context.Context, in *PlatformSearchRequest, opts ...grpc.CallOption) (*PlatformSearchResponse, error)
 	// List all installed platforms.
@@ -92,6 +110,15 @@ type ArduinoCoreServiceClient interface {
 	ZipLibraryInstall(ctx context.Context, in *ZipLibraryInstallRequest, opts ...grpc.CallOption) (ArduinoCoreService_ZipLibraryInstallClient, error)
 	// Download and install a library from a git url
 	GitLibraryInstall(ctx context.Context, in *GitLibraryInstallRequest, opts ...grpc.CallOption) (ArduinoCoreService_GitLibraryInstallClient, error)
+	// Check a library against the library specification.
+	LibraryLint(ctx context.Context, in *LibraryLintRequest, opts ...grpc.CallOption) (*LibraryLintResponse, error)
+	// Create a release archive of a library and its library index entry.
+	LibraryPack(ctx context.Context, in *LibraryPackRequest, opts ...grpc.CallOption) (*LibraryPackResponse, error)
+	// Compile a library for a set of boards and store the archives in the
+	// folders used by precompiled libraries.
+	LibraryPrecompile(ctx context.Context, in *LibraryPrecompileRequest, opts ...grpc.CallOption) (ArduinoCoreService_LibraryPrecompileClient, error)
+	// Copy the libraries used by a sketch into the sketch's libraries folder.
+	LibraryVendor(ctx context.Context, in *LibraryVendorRequest, opts ...grpc.CallOption) (ArduinoCoreService_LibraryVendorClient, error)
 	// Uninstall an Arduino library.
 	LibraryUninstall(ctx context.Context, in *LibraryUninstallRequest, opts ...grpc.CallOption) (ArduinoCoreService_LibraryUninstallClient, error)
 	// Upgrade all installed Arduino libraries to the newest version available.
@@ -103,6 +130,9 @@ type ArduinoCoreServiceClient interface {
 	LibrarySearch(ctx context.Context, in *LibrarySearchRequest, opts ...grpc.CallOption) (*LibrarySearchResponse, error)
 	// List the installed libraries.
 	LibraryList(ctx context.Context, in *LibraryListRequest, opts ...grpc.CallOption) (*LibraryListResponse, error)
+	// Remove from the downloads cache the archives not used by the installed
+	// platforms, tools and libraries, and deduplicate identical archives.
+	CacheGC(ctx context.Context, in *CacheGCRequest, opts ...grpc.CallOption) (*CacheGCResponse, error)
 	// Open a monitor connection to a board port
 	Monitor(ctx context.Context, opts ...grpc.CallOption) (ArduinoCoreService_MonitorClient, error)
 	// Returns the parameters that can be set in the MonitorRequest calls
@@ -567,8 +597,104 @@ func (x *arduinoCoreServicePlatformUninstallClient) Recv() (*PlatformUninstallRe
 	return m, nil
 }
 
+func (c *arduinoCoreServiceClient) PlatformVerify(ctx context.Context, in *PlatformVerifyRequest, opts ...grpc.CallOption) (ArduinoCoreService_PlatformVerifyClient, error) {
+	stream, err := c.cc.NewStream(ctx, &ArduinoCoreService_ServiceDesc.Streams[11], "/cc.arduino.cli.commands.v1.ArduinoCoreService/PlatformVerify", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &arduinoCoreServicePlatformVerifyClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ArduinoCoreService_PlatformVerifyClient interface {
+	Recv() (*PlatformVerifyResponse, error)
+	grpc.ClientStream
+}
+
+type arduinoCoreServicePlatformVerifyClient struct {
+	grpc.ClientStream
+}
+
+func (x *arduinoCoreServicePlatformVerifyClient) Recv() (*PlatformVerifyResponse, error) {
+	m := new(PlatformVerifyResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *arduinoCoreServiceClient) ToolVerify(ctx context.Context, in *ToolVerifyRequest, opts ...grpc.CallOption) (ArduinoCoreService_ToolVerifyClient, error) {
+	stream, err := c.cc.NewStream(ctx, &ArduinoCoreService_ServiceDesc.Streams[12], "/cc.arduino.cli.commands.v1.ArduinoCoreService/ToolVerify", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &arduinoCoreServiceToolVerifyClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ArduinoCoreService_ToolVerifyClient interface {
+	Recv() (*ToolVerifyResponse, error)
+	grpc.ClientStream
+}
+
+type arduinoCoreServiceToolVerifyClient struct {
+	grpc.ClientStream
+}
+
+func (x *arduinoCoreServiceToolVerifyClient) Recv() (*ToolVerifyResponse, error) {
+	m := new(ToolVerifyResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *arduinoCoreServiceClient) ToolPrune(ctx context.Context, in *ToolPruneRequest, opts ...grpc.CallOption) (ArduinoCoreService_ToolPruneClient, error) {
+	stream, err := c.cc.NewStream(ctx, &ArduinoCoreService_ServiceDesc.Streams[13], "/cc.arduino.cli.commands.v1.ArduinoCoreService/ToolPrune", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &arduinoCoreServiceToolPruneClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ArduinoCoreService_ToolPruneClient interface {
+	Recv() (*ToolPruneResponse, error)
+	grpc.ClientStream
+}
+
+type arduinoCoreServiceToolPruneClient struct {
+	grpc.ClientStream
+}
+
+func (x *arduinoCoreServiceToolPruneClient) Recv() (*ToolPruneResponse, error) {
+	m := new(ToolPruneResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *arduinoCoreServiceClient) PlatformUpgrade(ctx context.Context, in *PlatformUpgradeRequest, opts ...grpc.CallOption) (ArduinoCoreService_PlatformUpgradeClient, error) {
-	stream, err := c.cc.NewStream(ctx, &ArduinoCoreService_ServiceDesc.Streams[11], "/cc.arduino.cli.commands.v1.ArduinoCoreService/PlatformUpgrade", opts...)
+	stream, err := c.cc.NewStream(ctx, &ArduinoCoreService_ServiceDesc.Streams[14], "/cc.arduino.cli.commands.v1.ArduinoCoreService/PlatformUpgrade", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -599,8 +725,81 @@ func (x *arduinoCoreServicePlatformUpgradeClient) Recv() (*PlatformUpgradeRespon
 	return m, nil
 }
 
+func (c *arduinoCoreServiceClient) PlatformRollback(ctx context.Context, in *PlatformRollbackRequest, opts ...grpc.CallOption) (ArduinoCoreService_PlatformRollbackClient, error) {
+	stream, err := c.cc.NewStream(ctx, &ArduinoCoreService_ServiceDesc.Streams[15], "/cc.arduino.cli.commands.v1.ArduinoCoreService/PlatformRollback", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &arduinoCoreServicePlatformRollbackClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ArduinoCoreService_PlatformRollbackClient interface {
+	Recv() (*PlatformRollbackResponse, error)
+	grpc.ClientStream
+}
+
+type arduinoCoreServicePlatformRollbackClient struct {
+	grpc.ClientStream
+}
+
+func (x *arduinoCoreServicePlatformRollbackClient) Recv() (*PlatformRollbackResponse, error) {
+	m := new(PlatformRollbackResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *arduinoCoreServiceClient) PackageIndexLint(ctx context.Context, in *PackageIndexLintRequest, opts ...grpc.CallOption) (ArduinoCoreService_PackageIndexLintClient, error) {
+	stream, err := c.cc.NewStream(ctx, &ArduinoCoreService_ServiceDesc.Streams[16], "/cc.arduino.cli.commands.v1.ArduinoCoreService/PackageIndexLint", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &arduinoCoreServicePackageIndexLintClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ArduinoCoreService_PackageIndexLintClient interface {
+	Recv() (*PackageIndexLintResponse, error)
+	grpc.ClientStream
+}
+
+type arduinoCoreServicePackageIndexLintClient struct {
+	grpc.ClientStream
+}
+
+func (x *arduinoCoreServicePackageIndexLintClient) Recv() (*PackageIndexLintResponse, error) {
+	m := new(PackageIndexLintResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *arduinoCoreServiceClient) PlatformLint(ctx context.Context, in *PlatformLintRequest, opts ...grpc.CallOption) (*PlatformLintResponse, error) {
+	out := new(PlatformLintResponse)
+	err := c.cc.Invoke(ctx, "/cc.arduino.cli.commands.v1.ArduinoCoreService/PlatformLint", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *arduinoCoreServiceClient) Upload(ctx context.Context, in *UploadRequest, opts ...grpc.CallOption) (ArduinoCoreService_UploadClient, error) {
-	stream, err := c.cc.NewStream(ctx, &ArduinoCoreService_ServiceDesc.Streams[12], "/cc.arduino.cli.commands.v1.ArduinoCoreService/Upload", opts...)
+	stream, err := c.cc.NewStream(ctx, &ArduinoCoreService_ServiceDesc.Streams[17], "/cc.arduino.cli.commands.v1.ArduinoCoreService/Upload", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -632,7 +831,7 @@ func (x *arduinoCoreServiceUploadClient) Recv() (*UploadResponse, error) {
 }
 
 func (c *arduinoCoreServiceClient) UploadUsingProgrammer(ctx context.Context, in *UploadUsingProgrammerRequest, opts ...grpc.CallOption) (ArduinoCoreService_UploadUsingProgrammerClient, error) {
-	stream, err := c.cc.NewStream(ctx, &ArduinoCoreService_ServiceDesc.Streams[13], "/cc.arduino.cli.commands.v1.ArduinoCoreService/UploadUsingProgrammer", opts...)
+	stream, err := c.cc.NewStream(ctx, &ArduinoCoreService_ServiceDesc.Streams[18], "/cc.arduino.cli.commands.v1.ArduinoCoreService/UploadUsingProgrammer", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -682,7 +881,7 @@ func (c *arduinoCoreServiceClient) ListProgrammersAvailableForUpload(ctx context
 }
 
 func (c *arduinoCoreServiceClient) BurnBootloader(ctx context.Context, in *BurnBootloaderRequest, opts ...grpc.CallOption) (ArduinoCoreService_BurnBootloaderClient, error) {
-	stream, err := c.cc.NewStream(ctx, &ArduinoCoreService_ServiceDesc.Streams[14], "/cc.arduino.cli.commands.v1.ArduinoCoreService/BurnBootloader", opts...)
+	stream, err := c.cc.NewStream(ctx, &ArduinoCoreService_ServiceDesc.Streams[19], "/cc.arduino.cli.commands.v1.ArduinoCoreService/BurnBootloader", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -713,6 +912,38 @@ func (x *arduinoCoreServiceBurnBootloaderClient) Recv() (*BurnBootloaderResponse
 	return m, nil
 }
 
+func (c *arduinoCoreServiceClient) BoardRead(ctx context.Context, in *BoardReadRequest, opts ...grpc.CallOption) (ArduinoCoreService_BoardReadClient, error) {
+	stream, err := c.cc.NewStream(ctx, &ArduinoCoreService_ServiceDesc.Streams[20], "/cc.arduino.cli.commands.v1.ArduinoCoreService/BoardRead", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &arduinoCoreServiceBoardReadClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ArduinoCoreService_BoardReadClient interface {
+	Recv() (*BoardReadResponse, error)
+	grpc.ClientStream
+}
+
+type arduinoCoreServiceBoardReadClient struct {
+	grpc.ClientStream
+}
+
+func (x *arduinoCoreServiceBoardReadClient) Recv() (*BoardReadResponse, error) {
+	m := new(BoardReadResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *arduinoCoreServiceClient) PlatformSearch(ctx context.Context, in *PlatformSearchRequest, opts ...grpc.CallOption) (*PlatformSearchResponse, error) {
 	out := new(PlatformSearchResponse)
 	err := c.cc.Invoke(ctx, "/cc.arduino.cli.commands.v1.ArduinoCoreService/PlatformSearch", in, out, opts...)
@@ -732,7 +963,7 @@ func (c *arduinoCoreServiceClient) PlatformList(ctx context.Context, in *Platfor
 }
 
 func (c *arduinoCoreServiceClient) LibraryDownload(ctx context.Context, in *LibraryDownloadRequest, opts ...grpc.CallOption) (ArduinoCoreService_LibraryDownloadClient, error) {
-	stream, err := c.cc.NewStream(ctx, &ArduinoCoreService_ServiceDesc.Streams[15], "/cc.arduino.cli.commands.v1.ArduinoCoreService/LibraryDownload", opts...)
+	stream, err := c.cc.NewStream(ctx, &ArduinoCoreService_ServiceDesc.Streams[21], "/cc.arduino.cli.commands.v1.ArduinoCoreService/LibraryDownload", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -764,7 +995,7 @@ func (x *arduinoCoreServiceLibraryDownloadClient) Recv() (*LibraryDownloadRespon
 }
 
 func (c *arduinoCoreServiceClient) LibraryInstall(ctx context.Context, in *LibraryInstallRequest, opts ...grpc.CallOption) (ArduinoCoreService_LibraryInstallClient, error) {
-	stream, err := c.cc.NewStream(ctx, &ArduinoCoreService_ServiceDesc.Streams[16], "/cc.arduino.cli.commands.v1.ArduinoCoreService/LibraryInstall", opts...)
+	stream, err := c.cc.NewStream(ctx, &ArduinoCoreService_ServiceDesc.Streams[22], "/cc.arduino.cli.commands.v1.ArduinoCoreService/LibraryInstall", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -796,7 +1027,7 @@ func (x *arduinoCoreServiceLibraryInstallClient) Recv() (*LibraryInstallResponse
 }
 
 func (c *arduinoCoreServiceClient) ZipLibraryInstall(ctx context.Context, in *ZipLibraryInstallRequest, opts ...grpc.CallOption) (ArduinoCoreService_ZipLibraryInstallClient, error) {
-	stream, err := c.cc.NewStream(ctx, &ArduinoCoreService_ServiceDesc.Streams[17], "/cc.arduino.cli.commands.v1.ArduinoCoreService/ZipLibraryInstall", opts...)
+	stream, err := c.cc.NewStream(ctx, &ArduinoCoreService_ServiceDesc.Streams[23], "/cc.arduino.cli.commands.v1.ArduinoCoreService/ZipLibraryInstall", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -828,7 +1059,7 @@ func (x *arduinoCoreServiceZipLibraryInstallClient) Recv() (*ZipLibraryInstallRe
 }
 
 func (c *arduinoCoreServiceClient) GitLibraryInstall(ctx context.Context, in *GitLibraryInstallRequest, opts ...grpc.CallOption) (ArduinoCoreService_GitLibraryInstallClient, error) {
-	stream, err := c.cc.NewStream(ctx, &ArduinoCoreService_ServiceDesc.Streams[18], "/cc.arduino.cli.commands.v1.ArduinoCoreService/GitLibraryInstall", opts...)
+	stream, err := c.cc.NewStream(ctx, &ArduinoCoreService_ServiceDesc.Streams[24], "/cc.arduino.cli.commands.v1.ArduinoCoreService/GitLibraryInstall", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -859,8 +1090,90 @@ func (x *arduinoCoreServiceGitLibraryInstallClient) Recv() (*GitLibraryInstallRe
 	return m, nil
 }
 
+func (c *arduinoCoreServiceClient) LibraryLint(ctx context.Context, in *LibraryLintRequest, opts ...grpc.CallOption) (*LibraryLintResponse, error) {
+	out := new(LibraryLintResponse)
+	err := c.cc.Invoke(ctx, "/cc.arduino.cli.commands.v1.ArduinoCoreService/LibraryLint", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *arduinoCoreServiceClient) LibraryPack(ctx context.Context, in *LibraryPackRequest, opts ...grpc.CallOption) (*LibraryPackResponse, error) {
+	out := new(LibraryPackResponse)
+	err := c.cc.Invoke(ctx, "/cc.arduino.cli.commands.v1.ArduinoCoreService/LibraryPack", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *arduinoCoreServiceClient) LibraryPrecompile(ctx context.Context, in *LibraryPrecompileRequest, opts ...grpc.CallOption) (ArduinoCoreService_LibraryPrecompileClient, error) {
+	stream, err := c.cc.NewStream(ctx, &ArduinoCoreService_ServiceDesc.Streams[25], "/cc.arduino.cli.commands.v1.ArduinoCoreService/LibraryPrecompile", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &arduinoCoreServiceLibraryPrecompileClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ArduinoCoreService_LibraryPrecompileClient interface {
+	Recv() (*LibraryPrecompileResponse, error)
+	grpc.ClientStream
+}
+
+type arduinoCoreServiceLibraryPrecompileClient struct {
+	grpc.ClientStream
+}
+
+func (x *arduinoCoreServiceLibraryPrecompileClient) Recv() (*LibraryPrecompileResponse, error) {
+	m := new(LibraryPrecompileResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *arduinoCoreServiceClient) LibraryVendor(ctx context.Context, in *LibraryVendorRequest, opts ...grpc.CallOption) (ArduinoCoreService_LibraryVendorClient, error) {
+	stream, err := c.cc.NewStream(ctx, &ArduinoCoreService_ServiceDesc.Streams[26], "/cc.arduino.cli.commands.v1.ArduinoCoreService/LibraryVendor", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &arduinoCoreServiceLibraryVendorClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ArduinoCoreService_LibraryVendorClient interface {
+	Recv() (*LibraryVendorResponse, error)
+	grpc.ClientStream
+}
+
+type arduinoCoreServiceLibraryVendorClient struct {
+	grpc.ClientStream
+}
+
+func (x *arduinoCoreServiceLibraryVendorClient) Recv() (*LibraryVendorResponse, error) {
+	m := new(LibraryVendorResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *arduinoCoreServiceClient) LibraryUninstall(ctx context.Context, in *LibraryUninstallRequest, opts ...grpc.CallOption) (ArduinoCoreService_LibraryUninstallClient, error) {
-	stream, err := c.cc.NewStream(ctx, &ArduinoCoreService_ServiceDesc.Streams[19], "/cc.arduino.cli.commands.v1.ArduinoCoreService/LibraryUninstall", opts...)
+	stream, err := c.cc.NewStream(ctx, &ArduinoCoreService_ServiceDesc.Streams[27], "/cc.arduino.cli.commands.v1.ArduinoCoreService/LibraryUninstall", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -892,7 +1205,7 @@ func (x *arduinoCoreServiceLibraryUninstallClient) Recv() (*LibraryUninstallResp
 }
 
 func (c *arduinoCoreServiceClient) LibraryUpgradeAll(ctx context.Context, in *LibraryUpgradeAllRequest, opts ...grpc.CallOption) (ArduinoCoreService_LibraryUpgradeAllClient, error) {
-	stream, err := c.cc.NewStream(ctx, &ArduinoCoreService_ServiceDesc.Streams[20], "/cc.arduino.cli.commands.v1.ArduinoCoreService/LibraryUpgradeAll", opts...)
+	stream, err := c.cc.NewStream(ctx, &ArduinoCoreService_ServiceDesc.Streams[28], "/cc.arduino.cli.commands.v1.ArduinoCoreService/LibraryUpgradeAll", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -950,8 +1263,17 @@ func (c *arduinoCoreServiceClient) LibraryList(ctx context.Context, in *LibraryL
 	return out, nil
 }
 
+func (c *arduinoCoreServiceClient) CacheGC(ctx context.Context, in *CacheGCRequest, opts ...grpc.CallOption) (*CacheGCResponse, error) {
+	out := new(CacheGCResponse)
+	err := c.cc.Invoke(ctx, "/cc.arduino.cli.commands.v1.ArduinoCoreService/CacheGC", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *arduinoCoreServiceClient) Monitor(ctx context.Context, opts ...grpc.CallOption) (ArduinoCoreService_MonitorClient, error) {
-	stream, err := c.cc.NewStream(ctx, &ArduinoCoreService_ServiceDesc.Streams[21], "/cc.arduino.cli.commands.v1.ArduinoCoreService/Monitor", opts...)
+	stream, err := c.cc.NewStream(ctx, &ArduinoCoreService_ServiceDesc.Streams[29], "/cc.arduino.cli.commands.v1.ArduinoCoreService/Monitor", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1042,8 +1364,24 @@ type ArduinoCoreServiceServer interface {
 	// Uninstall a platform as well as its tool dependencies that are not used by
 	// other installed platforms.
 	PlatformUninstall(*PlatformUninstallRequest, ArduinoCoreService_PlatformUninstallServer) error
+	// Verify the files of installed platforms against the ones recorded at
+	// install time, optionally reinstalling the damaged platforms.
+	PlatformVerify(*PlatformVerifyRequest, ArduinoCoreService_PlatformVerifyServer) error
+	// Verify the files of installed tools against the ones recorded at install
+	// time, optionally reinstalling the damaged tools.
+	ToolVerify(*ToolVerifyRequest, ArduinoCoreService_ToolVerifyServer) error
+	// Remove the installed tools that are not required by any of the installed
+	// platforms.
+	ToolPrune(*ToolPruneRequest, ArduinoCoreService_ToolPruneServer) error
 	// Upgrade an installed platform to the latest version.
 	PlatformUpgrade(*PlatformUpgradeRequest, ArduinoCoreService_PlatformUpgradeServer) error
+	// Reinstall the platform release replaced by the last upgrade of an
+	// installed platform.
+	PlatformRollback(*PlatformRollbackRequest, ArduinoCoreService_PlatformRollbackServer) error
+	// Check a package index against the package index specification.
+	PackageIndexLint(*PackageIndexLintRequest, ArduinoCoreService_PackageIndexLintServer) error
+	// Check a platform against the platform specification.
+	PlatformLint(context.Context, *PlatformLintRequest) (*PlatformLintResponse, error)
 	// Upload a compiled sketch to a board.
 	Upload(*UploadRequest, ArduinoCoreService_UploadServer) error
 	// Upload a compiled sketch to a board using a programmer.
@@ -1055,6 +1393,8 @@ type ArduinoCoreServiceServer interface {
 	ListProgrammersAvailableForUpload(context.Context, *ListProgrammersAvailableForUploadRequest) (*ListProgrammersAvailableForUploadResponse, error)
 	// Burn bootloader to a board.
 	BurnBootloader(*BurnBootloaderRequest, ArduinoCoreService_BurnBootloaderServer) error
+	// Read back the content of a memory region of a board.
+	BoardRead(*BoardReadRequest, ArduinoCoreService_BoardReadServer) error
 	// Search for a platform in the platforms indexes.
 	PlatformSearch(context.Context, *PlatformSearchRequest) (*PlatformSearchResponse, error)
 	// List all installed platforms.
@@ -1068,6 +1408,15 @@ type ArduinoCoreServiceServer interface {
 	ZipLibraryInstall(*ZipLibraryInstallRequest, ArduinoCoreService_ZipLibraryInstallServer) error
 	// Download and install a library from a git url
 	GitLibraryInstall(*GitLibraryInstallRequest, ArduinoCoreService_GitLibraryInstallServer) error
+	// Check a library against the library specification.
+	LibraryLint(context.Context, *LibraryLintRequest) (*LibraryLintResponse, error)
+	// Create a release archive of a library and its library index entry.
+	LibraryPack(context.Context, *LibraryPackRequest) (*LibraryPackResponse, error)
+	// Compile a library for a set of boards and store the archives in the
+	// folders used by precompiled libraries.
+	LibraryPrecompile(*LibraryPrecompileRequest, ArduinoCoreService_LibraryPrecompileServer) error
+	// Copy the libraries used by a sketch into the sketch's libraries folder.
+	LibraryVendor(*LibraryVendorRequest, ArduinoCoreService_LibraryVendorServer) error
 	// Uninstall an Arduino library.
 	LibraryUninstall(*LibraryUninstallRequest, ArduinoCoreService_LibraryUninstallServer) error
 	// Upgrade all installed Arduino libraries to the newest version available.
@@ -1079,6 +1428,9 @@ type ArduinoCoreServiceServer interface {
 	LibrarySearch(context.Context, *LibrarySearchRequest) (*LibrarySearchResponse, error)
 	// List the installed libraries.
 	LibraryList(context.Context, *LibraryListRequest) (*LibraryListResponse, error)
+	// Remove from the downloads cache the archives not used by the installed
+	// platforms, tools and libraries, and deduplicate identical archives.
+	CacheGC(context.Context, *CacheGCRequest) (*CacheGCResponse, error)
 	// Open a monitor connection to a board port
 	Monitor(ArduinoCoreService_MonitorServer) error
 	// Returns the parameters that can be set in the MonitorRequest calls
@@ -1156,9 +1508,27 @@ func (UnimplementedArduinoCoreServiceServer) PlatformDownload(*PlatformDownloadR
 func (UnimplementedArduinoCoreServiceServer) PlatformUninstall(*PlatformUninstallRequest, ArduinoCoreService_PlatformUninstallServer) error {
 	return status.Errorf(codes.Unimplemented, "method PlatformUninstall not implemented")
 }
+func (UnimplementedArduinoCoreServiceServer) PlatformVerify(*PlatformVerifyRequest, ArduinoCoreService_PlatformVerifyServer) error {
+	return status.Errorf(codes.Unimplemented, "method PlatformVerify not implemented")
+}
+func (UnimplementedArduinoCoreServiceServer) ToolVerify(*ToolVerifyRequest, ArduinoCoreService_ToolVerifyServer) error {
+	return status.Errorf(codes.Unimplemented, "method ToolVerify not implemented")
+}
+func (UnimplementedArduinoCoreServiceServer) ToolPrune(*ToolPruneRequest, ArduinoCoreService_ToolPruneServer) error {
+	return status.Errorf(codes.Unimplemented, "method ToolPrune not implemented")
+}
 func (UnimplementedArduinoCoreServiceServer) PlatformUpgrade(*PlatformUpgradeRequest, ArduinoCoreService_PlatformUpgradeServer) error {
 	return status.Errorf(codes.Unimplemented, "method PlatformUpgrade not implemented")
 }
+func (UnimplementedArduinoCoreServiceServer) PlatformRollback(*PlatformRollbackRequest, ArduinoCoreService_PlatformRollbackServer) error {
+	return status.Errorf(codes.Unimplemented, "method PlatformRollback not implemented")
+}
+func (UnimplementedArduinoCoreServiceServer) PackageIndexLint(*PackageIndexLintRequest, ArduinoCoreService_PackageIndexLintServer) error {
+	return status.Errorf(codes.Unimplemented, "method PackageIndexLint not implemented")
+}
+func (UnimplementedArduinoCoreServiceServer) PlatformLint(context.Context, *PlatformLintRequest) (*PlatformLintResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PlatformLint not implemented")
+}
 func (UnimplementedArduinoCoreServiceServer) Upload(*UploadRequest, ArduinoCoreService_UploadServer) error {
 	return status.Errorf(codes.Unimplemented, "method Upload not implemented")
 }
@@ -1174,6 +1544,9 @@ func (UnimplementedArduinoCoreServiceServer) ListProgrammersAvailableForUpload(c
 func (UnimplementedArduinoCoreServiceServer) BurnBootloader(*BurnBootloaderRequest, ArduinoCoreService_BurnBootloaderServer) error {
 	return status.Errorf(codes.Unimplemented, "method BurnBootloader not implemented")
 }
+func (UnimplementedArduinoCoreServiceServer) BoardRead(*BoardReadRequest, ArduinoCoreService_BoardReadServer) error {
+	return status.Errorf(codes.Unimplemented, "method BoardRead not implemented")
+}
 func (UnimplementedArduinoCoreServiceServer) PlatformSearch(context.Context, *PlatformSearchRequest) (*PlatformSearchResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PlatformSearch not implemented")
 }
@@ -1192,6 +1565,18 @@ func (UnimplementedArduinoCoreServiceServer) ZipLibraryInstall(*ZipLibraryInstal
 func (UnimplementedArduinoCoreServiceServer) GitLibraryInstall(*GitLibraryInstallRequest, ArduinoCoreService_GitLibraryInstallServer) error {
 	return status.Errorf(codes.Unimplemented, "method GitLibraryInstall not implemented")
 }
+func (UnimplementedArduinoCoreServiceServer) LibraryLint(context.Context, *LibraryLintRequest) (*LibraryLintResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LibraryLint not implemented")
+}
+func (UnimplementedArduinoCoreServiceServer) LibraryPack(context.Context, *LibraryPackRequest) (*LibraryPackResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LibraryPack not implemented")
+}
+func (UnimplementedArduinoCoreServiceServer) LibraryPrecompile(*LibraryPrecompileRequest, ArduinoCoreService_LibraryPrecompileServer) error {
+	return status.Errorf(codes.Unimplemented, "method LibraryPrecompile not implemented")
+}
+func (UnimplementedArduinoCoreServiceServer) LibraryVendor(*LibraryVendorRequest, ArduinoCoreService_LibraryVendorServer) error {
+	return status.Errorf(codes.Unimplemented, "method LibraryVendor not implemented")
+}
 func (UnimplementedArduinoCoreServiceServer) LibraryUninstall(*LibraryUninstallRequest, ArduinoCoreService_LibraryUninstallServer) error {
 	return status.Errorf(codes.Unimplemented, "method LibraryUninstall not implemented")
 }
@@ -1207,6 +1592,9 @@ func (UnimplementedArduinoCoreServiceServer) LibrarySearch(context.Context, *Lib
 func (UnimplementedArduinoCoreServiceServer) LibraryList(context.Context, *LibraryListRequest) (*LibraryListResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method LibraryList not implemented")
 }
+func (UnimplementedArduinoCoreServiceServer) CacheGC(context.Context, *CacheGCRequest) (*CacheGCResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CacheGC not implemented")
+}
 func (UnimplementedArduinoCoreServiceServer) Monitor(ArduinoCoreService_MonitorServer) error {
 	return status.Errorf(codes.Unimplemented, "method Monitor not implemented")
 }
@@ -1660,6 +2048,69 @@ func (x *arduinoCoreServicePlatformUninstallServer) Send(m *PlatformUninstallRes
 	return x.ServerStream.SendMsg(m)
 }
 
+func _ArduinoCoreService_PlatformVerify_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(PlatformVerifyRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ArduinoCoreServiceServer).PlatformVerify(m, &arduinoCoreServicePlatformVerifyServer{stream})
+}
+
+type ArduinoCoreService_PlatformVerifyServer interface {
+	Send(*PlatformVerifyResponse) error
+	grpc.ServerStream
+}
+
+type arduinoCoreServicePlatformVerifyServer struct {
+	grpc.ServerStream
+}
+
+func (x *arduinoCoreServicePlatformVerifyServer) Send(m *PlatformVerifyResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _ArduinoCoreService_ToolVerify_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ToolVerifyRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ArduinoCoreServiceServer).ToolVerify(m, &arduinoCoreServiceToolVerifyServer{stream})
+}
+
+type ArduinoCoreService_ToolVerifyServer interface {
+	Send(*ToolVerifyResponse) error
+	grpc.ServerStream
+}
+
+type arduinoCoreServiceToolVerifyServer struct {
+	grpc.ServerStream
+}
+
+func (x *arduinoCoreServiceToolVerifyServer) Send(m *ToolVerifyResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _ArduinoCoreService_ToolPrune_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ToolPruneRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ArduinoCoreServiceServer).ToolPrune(m, &arduinoCoreServiceToolPruneServer{stream})
+}
+
+type ArduinoCoreService_ToolPruneServer interface {
+	Send(*ToolPruneResponse) error
+	grpc.ServerStream
+}
+
+type arduinoCoreServiceToolPruneServer struct {
+	grpc.ServerStream
+}
+
+func (x *arduinoCoreServiceToolPruneServer) Send(m *ToolPruneResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _ArduinoCoreService_PlatformUpgrade_Handler(srv interface{}, stream grpc.ServerStream) error {
 	m := new(PlatformUpgradeRequest)
 	if err := stream.RecvMsg(m); err != nil {
@@ -1681,6 +2132,66 @@ func (x *arduinoCoreServicePlatformUpgradeServer) Send(m *PlatformUpgradeRespons
 	return x.ServerStream.SendMsg(m)
 }
 
+func _ArduinoCoreService_PlatformRollback_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(PlatformRollbackRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ArduinoCoreServiceServer).PlatformRollback(m, &arduinoCoreServicePlatformRollbackServer{stream})
+}
+
+type ArduinoCoreService_PlatformRollbackServer interface {
+	Send(*PlatformRollbackResponse) error
+	grpc.ServerStream
+}
+
+type arduinoCoreServicePlatformRollbackServer struct {
+	grpc.ServerStream
+}
+
+func (x *arduinoCoreServicePlatformRollbackServer) Send(m *PlatformRollbackResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _ArduinoCoreService_PackageIndexLint_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(PackageIndexLintRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ArduinoCoreServiceServer).PackageIndexLint(m, &arduinoCoreServicePackageIndexLintServer{stream})
+}
+
+type ArduinoCoreService_PackageIndexLintServer interface {
+	Send(*PackageIndexLintResponse) error
+	grpc.ServerStream
+}
+
+type arduinoCoreServicePackageIndexLintServer struct {
+	grpc.ServerStream
+}
+
+func (x *arduinoCoreServicePackageIndexLintServer) Send(m *PackageIndexLintResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _ArduinoCoreService_PlatformLint_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PlatformLintRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArduinoCoreServiceServer).PlatformLint(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cc.arduino.cli.commands.v1.ArduinoCoreService/PlatformLint",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArduinoCoreServiceServer).PlatformLint(ctx, req.(*PlatformLintRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ArduinoCoreService_Upload_Handler(srv interface{}, stream grpc.ServerStream) error {
 	m := new(UploadRequest)
 	if err := stream.RecvMsg(m); err != nil {
@@ -1780,6 +2291,27 @@ func (x *arduinoCoreServiceBurnBootloaderServer) Send(m *BurnBootloaderResponse)
 	return x.ServerStream.SendMsg(m)
 }
 
+func _ArduinoCoreService_BoardRead_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(BoardReadRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ArduinoCoreServiceServer).BoardRead(m, &arduinoCoreServiceBoardReadServer{stream})
+}
+
+type ArduinoCoreService_BoardReadServer interface {
+	Send(*BoardReadResponse) error
+	grpc.ServerStream
+}
+
+type arduinoCoreServiceBoardReadServer struct {
+	grpc.ServerStream
+}
+
+func (x *arduinoCoreServiceBoardReadServer) Send(m *BoardReadResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _ArduinoCoreService_PlatformSearch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(PlatformSearchRequest)
 	if err := dec(in); err != nil {
@@ -1900,6 +2432,84 @@ func (x *arduinoCoreServiceGitLibraryInstallServer) Send(m *GitLibraryInstallRes
 	return x.ServerStream.SendMsg(m)
 }
 
+func _ArduinoCoreService_LibraryLint_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LibraryLintRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArduinoCoreServiceServer).LibraryLint(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cc.arduino.cli.commands.v1.ArduinoCoreService/LibraryLint",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArduinoCoreServiceServer).LibraryLint(ctx, req.(*LibraryLintRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ArduinoCoreService_LibraryPack_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LibraryPackRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArduinoCoreServiceServer).LibraryPack(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cc.arduino.cli.commands.v1.ArduinoCoreService/LibraryPack",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArduinoCoreServiceServer).LibraryPack(ctx, req.(*LibraryPackRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ArduinoCoreService_LibraryPrecompile_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(LibraryPrecompileRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ArduinoCoreServiceServer).LibraryPrecompile(m, &arduinoCoreServiceLibraryPrecompileServer{stream})
+}
+
+type ArduinoCoreService_LibraryPrecompileServer interface {
+	Send(*LibraryPrecompileResponse) error
+	grpc.ServerStream
+}
+
+type arduinoCoreServiceLibraryPrecompileServer struct {
+	grpc.ServerStream
+}
+
+func (x *arduinoCoreServiceLibraryPrecompileServer) Send(m *LibraryPrecompileResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _ArduinoCoreService_LibraryVendor_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(LibraryVendorRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ArduinoCoreServiceServer).LibraryVendor(m, &arduinoCoreServiceLibraryVendorServer{stream})
+}
+
+type ArduinoCoreService_LibraryVendorServer interface {
+	Send(*LibraryVendorResponse) error
+	grpc.ServerStream
+}
+
+type arduinoCoreServiceLibraryVendorServer struct {
+	grpc.ServerStream
+}
+
+func (x *arduinoCoreServiceLibraryVendorServer) Send(m *LibraryVendorResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _ArduinoCoreService_LibraryUninstall_Handler(srv interface{}, stream grpc.ServerStream) error {
 	m := new(LibraryUninstallRequest)
 	if err := stream.RecvMsg(m); err != nil {
@@ -1996,6 +2606,24 @@ func _ArduinoCoreService_LibraryList_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ArduinoCoreService_CacheGC_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CacheGCRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArduinoCoreServiceServer).CacheGC(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cc.arduino.cli.commands.v1.ArduinoCoreService/CacheGC",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArduinoCoreServiceServer).CacheGC(ctx, req.(*CacheGCRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ArduinoCoreService_Monitor_Handler(srv interface{}, stream grpc.ServerStream) error {
 	return srv.(ArduinoCoreServiceServer).Monitor(&arduinoCoreServiceMonitorServer{stream})
 }
@@ -2091,6 +2719,10 @@ var ArduinoCoreService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "BoardSearch",
 			Handler:    _ArduinoCoreService_BoardSearch_Handler,
 		},
+		{
+			MethodName: "PlatformLint",
+			Handler:    _ArduinoCoreService_PlatformLint_Handler,
+		},
 		{
 			MethodName: "SupportedUserFields",
 			Handler:    _ArduinoCoreService_SupportedUserFields_Handler,
@@ -2107,6 +2739,14 @@ var ArduinoCoreService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "PlatformList",
 			Handler:    _ArduinoCoreService_PlatformList_Handler,
 		},
+		{
+			MethodName: "LibraryLint",
+			Handler:    _ArduinoCoreService_LibraryLint_Handler,
+		},
+		{
+			MethodName: "LibraryPack",
+			Handler:    _ArduinoCoreService_LibraryPack_Handler,
+		},
 		{
 			MethodName: "LibraryResolveDependencies",
 			Handler:    _ArduinoCoreService_LibraryResolveDependencies_Handler,
@@ -2119,6 +2759,10 @@ var ArduinoCoreService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "LibraryList",
 			Handler:    _ArduinoCoreService_LibraryList_Handler,
 		},
+		{
+			MethodName: "CacheGC",
+			Handler:    _ArduinoCoreService_CacheGC_Handler,
+		},
 		{
 			MethodName: "EnumerateMonitorPortSettings",
 			Handler:    _ArduinoCoreService_EnumerateMonitorPortSettings_Handler,
@@ -2181,11 +2825,36 @@ var ArduinoCoreService_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _ArduinoCoreService_PlatformUninstall_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "PlatformVerify",
+			Handler:       _ArduinoCoreService_PlatformVerify_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ToolVerify",
+			Handler:       _ArduinoCoreService_ToolVerify_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ToolPrune",
+			Handler:       _ArduinoCoreService_ToolPrune_Handler,
+			ServerStreams: true,
+		},
 		{
 			StreamName:    "PlatformUpgrade",
 			Handler:       _ArduinoCoreService_PlatformUpgrade_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "PlatformRollback",
+			Handler:       _ArduinoCoreService_PlatformRollback_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "PackageIndexLint",
+			Handler:       _ArduinoCoreService_PackageIndexLint_Handler,
+			ServerStreams: true,
+		},
 		{
 			StreamName:    "Upload",
 			Handler:       _ArduinoCoreService_Upload_Handler,
@@ -2201,6 +2870,11 @@ var ArduinoCoreService_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _ArduinoCoreService_BurnBootloader_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "BoardRead",
+			Handler:       _ArduinoCoreService_BoardRead_Handler,
+			ServerStreams: true,
+		},
 		{
 			StreamName:    "LibraryDownload",
 			Handler:       _ArduinoCoreService_LibraryDownload_Handler,
@@ -2221,6 +2895,16 @@ var ArduinoCoreService_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _ArduinoCoreService_GitLibraryInstall_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "LibraryPrecompile",
+			Handler:       _ArduinoCoreService_LibraryPrecompile_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "LibraryVendor",
+			Handler:       _ArduinoCoreService_LibraryVendor_Handler,
+			ServerStreams: true,
+		},
 		{
 			StreamName:    "LibraryUninstall",
 			Handler:       _ArduinoCoreService_LibraryUninstall_Handler,
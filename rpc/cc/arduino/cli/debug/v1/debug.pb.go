@@ -25,6 +25,7 @@ import (
 	v1 "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	structpb "google.golang.org/protobuf/types/known/structpb"
 	reflect "reflect"
 	sync "sync"
 )
@@ -36,6 +37,61 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type DebugStreamType int32
+
+const (
+	DebugStreamType_DEBUG_STREAM_TYPE_UNSPECIFIED DebugStreamType = 0
+	// Output that should be displayed as is in the console.
+	DebugStreamType_DEBUG_STREAM_TYPE_CONSOLE DebugStreamType = 1
+	// Output produced by the target program.
+	DebugStreamType_DEBUG_STREAM_TYPE_TARGET DebugStreamType = 2
+	// Output produced by GDB internals (for example error messages).
+	DebugStreamType_DEBUG_STREAM_TYPE_LOG DebugStreamType = 3
+)
+
+// Enum value maps for DebugStreamType.
+var (
+	DebugStreamType_name = map[int32]string{
+		0: "DEBUG_STREAM_TYPE_UNSPECIFIED",
+		1: "DEBUG_STREAM_TYPE_CONSOLE",
+		2: "DEBUG_STREAM_TYPE_TARGET",
+		3: "DEBUG_STREAM_TYPE_LOG",
+	}
+	DebugStreamType_value = map[string]int32{
+		"DEBUG_STREAM_TYPE_UNSPECIFIED": 0,
+		"DEBUG_STREAM_TYPE_CONSOLE":     1,
+		"DEBUG_STREAM_TYPE_TARGET":      2,
+		"DEBUG_STREAM_TYPE_LOG":         3,
+	}
+)
+
+func (x DebugStreamType) Enum() *DebugStreamType {
+	p := new(DebugStreamType)
+	*p = x
+	return p
+}
+
+func (x DebugStreamType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DebugStreamType) Descriptor() protoreflect.EnumDescriptor {
+	return file_cc_arduino_cli_debug_v1_debug_proto_enumTypes[0].Descriptor()
+}
+
+func (DebugStreamType) Type() protoreflect.EnumType {
+	return &file_cc_arduino_cli_debug_v1_debug_proto_enumTypes[0]
+}
+
+func (x DebugStreamType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DebugStreamType.Descriptor instead.
+func (DebugStreamType) EnumDescriptor() ([]byte, []int) {
+	return file_cc_arduino_cli_debug_v1_debug_proto_rawDescGZIP(), []int{0}
+}
+
 // The top-level message sent by the client for the `Debug` method.
 // Multiple `DebugReq` messages can be sent but the first message
 // must contain a `DebugConfigReq` message to initialize the debug session.
@@ -46,33 +102,799 @@ type DebugRequest struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Provides information to the debug that specifies which is the target.
-	// The first `StreamingOpenReq` message must contain a `DebugReq`
-	// message.
-	DebugRequest *DebugConfigRequest `protobuf:"bytes,1,opt,name=debug_request,json=debugRequest,proto3" json:"debug_request,omitempty"`
-	// The data to be sent to the target being monitored.
-	Data []byte `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
-	// Set this to true to send and Interrupt signal to the debugger process
-	SendInterrupt bool `protobuf:"varint,3,opt,name=send_interrupt,json=sendInterrupt,proto3" json:"send_interrupt,omitempty"`
+	// Provides information to the debug that specifies which is the target.
+	// The first `StreamingOpenReq` message must contain a `DebugReq`
+	// message.
+	DebugRequest *DebugConfigRequest `protobuf:"bytes,1,opt,name=debug_request,json=debugRequest,proto3" json:"debug_request,omitempty"`
+	// The data to be sent to the target being monitored.
+	Data []byte `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	// Set this to true to send and Interrupt signal to the debugger process
+	SendInterrupt bool `protobuf:"varint,3,opt,name=send_interrupt,json=sendInterrupt,proto3" json:"send_interrupt,omitempty"`
+	// Set this to true in the first message to receive the debugger output as
+	// structured `DebugEvent`s instead of raw data. The debugger is started
+	// with the GDB/MI interpreter (`mi2` if the requested interpreter is not
+	// an MI interpreter).
+	StructuredEvents bool `protobuf:"varint,4,opt,name=structured_events,json=structuredEvents,proto3" json:"structured_events,omitempty"`
+	// A structured command to send to the debugger. It can be used only in a
+	// session started with `structured_events` set.
+	Command *DebugCommand `protobuf:"bytes,5,opt,name=command,proto3" json:"command,omitempty"`
+}
+
+func (x *DebugRequest) Reset() {
+	*x = DebugRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cc_arduino_cli_debug_v1_debug_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DebugRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DebugRequest) ProtoMessage() {}
+
+func (x *DebugRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cc_arduino_cli_debug_v1_debug_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DebugRequest.ProtoReflect.Descriptor instead.
+func (*DebugRequest) Descriptor() ([]byte, []int) {
+	return file_cc_arduino_cli_debug_v1_debug_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *DebugRequest) GetDebugRequest() *DebugConfigRequest {
+	if x != nil {
+		return x.DebugRequest
+	}
+	return nil
+}
+
+func (x *DebugRequest) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *DebugRequest) GetSendInterrupt() bool {
+	if x != nil {
+		return x.SendInterrupt
+	}
+	return false
+}
+
+func (x *DebugRequest) GetStructuredEvents() bool {
+	if x != nil {
+		return x.StructuredEvents
+	}
+	return false
+}
+
+func (x *DebugRequest) GetCommand() *DebugCommand {
+	if x != nil {
+		return x.Command
+	}
+	return nil
+}
+
+// A structured command for the debugger. Each command is translated to the
+// corresponding GDB/MI command, the result is sent back as a `DebugEvent`
+// containing a result record with the same `command_id`.
+type DebugCommand struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// An identifier chosen by the client, it's reported in the result record
+	// of the command.
+	CommandId uint32 `protobuf:"varint,1,opt,name=command_id,json=commandId,proto3" json:"command_id,omitempty"`
+	// Types that are assignable to Command:
+	//	*DebugCommand_InsertBreakpoint
+	//	*DebugCommand_Continue
+	//	*DebugCommand_ReadMemory
+	//	*DebugCommand_MiCommand
+	Command isDebugCommand_Command `protobuf_oneof:"command"`
+}
+
+func (x *DebugCommand) Reset() {
+	*x = DebugCommand{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cc_arduino_cli_debug_v1_debug_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DebugCommand) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DebugCommand) ProtoMessage() {}
+
+func (x *DebugCommand) ProtoReflect() protoreflect.Message {
+	mi := &file_cc_arduino_cli_debug_v1_debug_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DebugCommand.ProtoReflect.Descriptor instead.
+func (*DebugCommand) Descriptor() ([]byte, []int) {
+	return file_cc_arduino_cli_debug_v1_debug_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *DebugCommand) GetCommandId() uint32 {
+	if x != nil {
+		return x.CommandId
+	}
+	return 0
+}
+
+func (m *DebugCommand) GetCommand() isDebugCommand_Command {
+	if m != nil {
+		return m.Command
+	}
+	return nil
+}
+
+func (x *DebugCommand) GetInsertBreakpoint() *InsertBreakpoint {
+	if x, ok := x.GetCommand().(*DebugCommand_InsertBreakpoint); ok {
+		return x.InsertBreakpoint
+	}
+	return nil
+}
+
+func (x *DebugCommand) GetContinue() *Continue {
+	if x, ok := x.GetCommand().(*DebugCommand_Continue); ok {
+		return x.Continue
+	}
+	return nil
+}
+
+func (x *DebugCommand) GetReadMemory() *ReadMemory {
+	if x, ok := x.GetCommand().(*DebugCommand_ReadMemory); ok {
+		return x.ReadMemory
+	}
+	return nil
+}
+
+func (x *DebugCommand) GetMiCommand() *MICommand {
+	if x, ok := x.GetCommand().(*DebugCommand_MiCommand); ok {
+		return x.MiCommand
+	}
+	return nil
+}
+
+type isDebugCommand_Command interface {
+	isDebugCommand_Command()
+}
+
+type DebugCommand_InsertBreakpoint struct {
+	// Insert a breakpoint (`-break-insert`).
+	InsertBreakpoint *InsertBreakpoint `protobuf:"bytes,2,opt,name=insert_breakpoint,json=insertBreakpoint,proto3,oneof"`
+}
+
+type DebugCommand_Continue struct {
+	// Continue the execution of the target (`-exec-continue`).
+	Continue *Continue `protobuf:"bytes,3,opt,name=continue,proto3,oneof"`
+}
+
+type DebugCommand_ReadMemory struct {
+	// Read a block of target memory (`-data-read-memory-bytes`).
+	ReadMemory *ReadMemory `protobuf:"bytes,4,opt,name=read_memory,json=readMemory,proto3,oneof"`
+}
+
+type DebugCommand_MiCommand struct {
+	// Any other GDB/MI command.
+	MiCommand *MICommand `protobuf:"bytes,5,opt,name=mi_command,json=miCommand,proto3,oneof"`
+}
+
+func (*DebugCommand_InsertBreakpoint) isDebugCommand_Command() {}
+
+func (*DebugCommand_Continue) isDebugCommand_Command() {}
+
+func (*DebugCommand_ReadMemory) isDebugCommand_Command() {}
+
+func (*DebugCommand_MiCommand) isDebugCommand_Command() {}
+
+type InsertBreakpoint struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The breakpoint location, for example `Blink.ino:12` or `loop`.
+	Location string `protobuf:"bytes,1,opt,name=location,proto3" json:"location,omitempty"`
+	// If set, the breakpoint stops the target only if the condition is true.
+	Condition string `protobuf:"bytes,2,opt,name=condition,proto3" json:"condition,omitempty"`
+	// Set to true to insert a temporary breakpoint.
+	Temporary bool `protobuf:"varint,3,opt,name=temporary,proto3" json:"temporary,omitempty"`
+	// Set to true to create a hardware breakpoint.
+	Hardware bool `protobuf:"varint,4,opt,name=hardware,proto3" json:"hardware,omitempty"`
+}
+
+func (x *InsertBreakpoint) Reset() {
+	*x = InsertBreakpoint{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cc_arduino_cli_debug_v1_debug_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InsertBreakpoint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InsertBreakpoint) ProtoMessage() {}
+
+func (x *InsertBreakpoint) ProtoReflect() protoreflect.Message {
+	mi := &file_cc_arduino_cli_debug_v1_debug_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InsertBreakpoint.ProtoReflect.Descriptor instead.
+func (*InsertBreakpoint) Descriptor() ([]byte, []int) {
+	return file_cc_arduino_cli_debug_v1_debug_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *InsertBreakpoint) GetLocation() string {
+	if x != nil {
+		return x.Location
+	}
+	return ""
+}
+
+func (x *InsertBreakpoint) GetCondition() string {
+	if x != nil {
+		return x.Condition
+	}
+	return ""
+}
+
+func (x *InsertBreakpoint) GetTemporary() bool {
+	if x != nil {
+		return x.Temporary
+	}
+	return false
+}
+
+func (x *InsertBreakpoint) GetHardware() bool {
+	if x != nil {
+		return x.Hardware
+	}
+	return false
+}
+
+type Continue struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Set to true to resume all the threads of the target.
+	AllThreads bool `protobuf:"varint,1,opt,name=all_threads,json=allThreads,proto3" json:"all_threads,omitempty"`
+}
+
+func (x *Continue) Reset() {
+	*x = Continue{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cc_arduino_cli_debug_v1_debug_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Continue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Continue) ProtoMessage() {}
+
+func (x *Continue) ProtoReflect() protoreflect.Message {
+	mi := &file_cc_arduino_cli_debug_v1_debug_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Continue.ProtoReflect.Descriptor instead.
+func (*Continue) Descriptor() ([]byte, []int) {
+	return file_cc_arduino_cli_debug_v1_debug_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Continue) GetAllThreads() bool {
+	if x != nil {
+		return x.AllThreads
+	}
+	return false
+}
+
+type ReadMemory struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// An expression evaluating to the start address, for example `0x20000000`
+	// or `&buffer`.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// The number of bytes to read.
+	Count uint64 `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	// An offset relative to the start address.
+	Offset int64 `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
+}
+
+func (x *ReadMemory) Reset() {
+	*x = ReadMemory{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cc_arduino_cli_debug_v1_debug_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReadMemory) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadMemory) ProtoMessage() {}
+
+func (x *ReadMemory) ProtoReflect() protoreflect.Message {
+	mi := &file_cc_arduino_cli_debug_v1_debug_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReadMemory.ProtoReflect.Descriptor instead.
+func (*ReadMemory) Descriptor() ([]byte, []int) {
+	return file_cc_arduino_cli_debug_v1_debug_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ReadMemory) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *ReadMemory) GetCount() uint64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *ReadMemory) GetOffset() int64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+type MICommand struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The GDB/MI operation without the leading dash, for example `exec-next`.
+	Operation string `protobuf:"bytes,1,opt,name=operation,proto3" json:"operation,omitempty"`
+	// The command options and parameters. They are quoted if needed.
+	Arguments []string `protobuf:"bytes,2,rep,name=arguments,proto3" json:"arguments,omitempty"`
+}
+
+func (x *MICommand) Reset() {
+	*x = MICommand{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cc_arduino_cli_debug_v1_debug_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MICommand) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MICommand) ProtoMessage() {}
+
+func (x *MICommand) ProtoReflect() protoreflect.Message {
+	mi := &file_cc_arduino_cli_debug_v1_debug_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MICommand.ProtoReflect.Descriptor instead.
+func (*MICommand) Descriptor() ([]byte, []int) {
+	return file_cc_arduino_cli_debug_v1_debug_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *MICommand) GetOperation() string {
+	if x != nil {
+		return x.Operation
+	}
+	return ""
+}
+
+func (x *MICommand) GetArguments() []string {
+	if x != nil {
+		return x.Arguments
+	}
+	return nil
+}
+
+type DebugConfigRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Arduino Core Service instance from the `Init` response.
+	Instance *v1.Instance `protobuf:"bytes,1,opt,name=instance,proto3" json:"instance,omitempty"`
+	// Fully qualified board name of the board in use
+	// (e.g., `arduino:samd:mkr1000`). If this is omitted, the FQBN attached to
+	// the sketch will be used.
+	Fqbn string `protobuf:"bytes,2,opt,name=fqbn,proto3" json:"fqbn,omitempty"`
+	// Path to the sketch that is running on the board. The compiled executable
+	// is expected to be located under this path.
+	SketchPath string `protobuf:"bytes,3,opt,name=sketch_path,json=sketchPath,proto3" json:"sketch_path,omitempty"`
+	// Port of the debugger (optional).
+	Port *v1.Port `protobuf:"bytes,4,opt,name=port,proto3" json:"port,omitempty"`
+	// Which GDB command interpreter to use.
+	Interpreter string `protobuf:"bytes,5,opt,name=interpreter,proto3" json:"interpreter,omitempty"`
+	// Directory containing the compiled executable. If `import_dir` is not
+	// specified, the executable is assumed to be in
+	// `{sketch_path}/build/{fqbn}/`.
+	ImportDir string `protobuf:"bytes,8,opt,name=import_dir,json=importDir,proto3" json:"import_dir,omitempty"`
+	// The programmer to use for debugging.
+	Programmer string `protobuf:"bytes,9,opt,name=programmer,proto3" json:"programmer,omitempty"`
+}
+
+func (x *DebugConfigRequest) Reset() {
+	*x = DebugConfigRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cc_arduino_cli_debug_v1_debug_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DebugConfigRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DebugConfigRequest) ProtoMessage() {}
+
+func (x *DebugConfigRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cc_arduino_cli_debug_v1_debug_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DebugConfigRequest.ProtoReflect.Descriptor instead.
+func (*DebugConfigRequest) Descriptor() ([]byte, []int) {
+	return file_cc_arduino_cli_debug_v1_debug_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *DebugConfigRequest) GetInstance() *v1.Instance {
+	if x != nil {
+		return x.Instance
+	}
+	return nil
+}
+
+func (x *DebugConfigRequest) GetFqbn() string {
+	if x != nil {
+		return x.Fqbn
+	}
+	return ""
+}
+
+func (x *DebugConfigRequest) GetSketchPath() string {
+	if x != nil {
+		return x.SketchPath
+	}
+	return ""
+}
+
+func (x *DebugConfigRequest) GetPort() *v1.Port {
+	if x != nil {
+		return x.Port
+	}
+	return nil
+}
+
+func (x *DebugConfigRequest) GetInterpreter() string {
+	if x != nil {
+		return x.Interpreter
+	}
+	return ""
+}
+
+func (x *DebugConfigRequest) GetImportDir() string {
+	if x != nil {
+		return x.ImportDir
+	}
+	return ""
+}
+
+func (x *DebugConfigRequest) GetProgrammer() string {
+	if x != nil {
+		return x.Programmer
+	}
+	return ""
+}
+
+type DebugResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Incoming data from the debugger tool.
+	Data []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	// Incoming error output from the debugger tool.
+	Error string `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	// A structured record from the debugger, only in sessions started with
+	// `structured_events` set.
+	Event *DebugEvent `protobuf:"bytes,3,opt,name=event,proto3" json:"event,omitempty"`
+}
+
+func (x *DebugResponse) Reset() {
+	*x = DebugResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cc_arduino_cli_debug_v1_debug_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DebugResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DebugResponse) ProtoMessage() {}
+
+func (x *DebugResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cc_arduino_cli_debug_v1_debug_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DebugResponse.ProtoReflect.Descriptor instead.
+func (*DebugResponse) Descriptor() ([]byte, []int) {
+	return file_cc_arduino_cli_debug_v1_debug_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *DebugResponse) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *DebugResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *DebugResponse) GetEvent() *DebugEvent {
+	if x != nil {
+		return x.Event
+	}
+	return nil
+}
+
+// An output record of the GDB/MI interpreter.
+type DebugEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Event:
+	//	*DebugEvent_Result
+	//	*DebugEvent_ExecAsync
+	//	*DebugEvent_StatusAsync
+	//	*DebugEvent_NotifyAsync
+	//	*DebugEvent_Stream
+	Event isDebugEvent_Event `protobuf_oneof:"event"`
+}
+
+func (x *DebugEvent) Reset() {
+	*x = DebugEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cc_arduino_cli_debug_v1_debug_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DebugEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DebugEvent) ProtoMessage() {}
+
+func (x *DebugEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_cc_arduino_cli_debug_v1_debug_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DebugEvent.ProtoReflect.Descriptor instead.
+func (*DebugEvent) Descriptor() ([]byte, []int) {
+	return file_cc_arduino_cli_debug_v1_debug_proto_rawDescGZIP(), []int{8}
+}
+
+func (m *DebugEvent) GetEvent() isDebugEvent_Event {
+	if m != nil {
+		return m.Event
+	}
+	return nil
+}
+
+func (x *DebugEvent) GetResult() *DebugResultRecord {
+	if x, ok := x.GetEvent().(*DebugEvent_Result); ok {
+		return x.Result
+	}
+	return nil
+}
+
+func (x *DebugEvent) GetExecAsync() *DebugAsyncRecord {
+	if x, ok := x.GetEvent().(*DebugEvent_ExecAsync); ok {
+		return x.ExecAsync
+	}
+	return nil
+}
+
+func (x *DebugEvent) GetStatusAsync() *DebugAsyncRecord {
+	if x, ok := x.GetEvent().(*DebugEvent_StatusAsync); ok {
+		return x.StatusAsync
+	}
+	return nil
+}
+
+func (x *DebugEvent) GetNotifyAsync() *DebugAsyncRecord {
+	if x, ok := x.GetEvent().(*DebugEvent_NotifyAsync); ok {
+		return x.NotifyAsync
+	}
+	return nil
+}
+
+func (x *DebugEvent) GetStream() *DebugStreamRecord {
+	if x, ok := x.GetEvent().(*DebugEvent_Stream); ok {
+		return x.Stream
+	}
+	return nil
+}
+
+type isDebugEvent_Event interface {
+	isDebugEvent_Event()
+}
+
+type DebugEvent_Result struct {
+	// The result of a command.
+	Result *DebugResultRecord `protobuf:"bytes,1,opt,name=result,proto3,oneof"`
+}
+
+type DebugEvent_ExecAsync struct {
+	// A change of the execution state of the target (`*stopped`,
+	// `*running`, ...).
+	ExecAsync *DebugAsyncRecord `protobuf:"bytes,2,opt,name=exec_async,json=execAsync,proto3,oneof"`
+}
+
+type DebugEvent_StatusAsync struct {
+	// A progress information of a slow operation (`+download`).
+	StatusAsync *DebugAsyncRecord `protobuf:"bytes,3,opt,name=status_async,json=statusAsync,proto3,oneof"`
+}
+
+type DebugEvent_NotifyAsync struct {
+	// A supplementary information the client should handle
+	// (`=breakpoint-modified`, `=thread-created`, ...).
+	NotifyAsync *DebugAsyncRecord `protobuf:"bytes,4,opt,name=notify_async,json=notifyAsync,proto3,oneof"`
+}
+
+type DebugEvent_Stream struct {
+	// A text output of the debugger or of the target.
+	Stream *DebugStreamRecord `protobuf:"bytes,5,opt,name=stream,proto3,oneof"`
+}
+
+func (*DebugEvent_Result) isDebugEvent_Event() {}
+
+func (*DebugEvent_ExecAsync) isDebugEvent_Event() {}
+
+func (*DebugEvent_StatusAsync) isDebugEvent_Event() {}
+
+func (*DebugEvent_NotifyAsync) isDebugEvent_Event() {}
+
+func (*DebugEvent_Stream) isDebugEvent_Event() {}
+
+type DebugResultRecord struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The `command_id` of the `DebugCommand` that generated this result, or 0
+	// if the command was sent as raw data.
+	CommandId uint32 `protobuf:"varint,1,opt,name=command_id,json=commandId,proto3" json:"command_id,omitempty"`
+	// The result class: `done`, `running`, `connected`, `error` or `exit`.
+	ResultClass string `protobuf:"bytes,2,opt,name=result_class,json=resultClass,proto3" json:"result_class,omitempty"`
+	// The results of the command. MI tuples are converted to objects, MI lists
+	// to arrays and MI constants to strings.
+	Results *structpb.Struct `protobuf:"bytes,3,opt,name=results,proto3" json:"results,omitempty"`
 }
 
-func (x *DebugRequest) Reset() {
-	*x = DebugRequest{}
+func (x *DebugResultRecord) Reset() {
+	*x = DebugResultRecord{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cc_arduino_cli_debug_v1_debug_proto_msgTypes[0]
+		mi := &file_cc_arduino_cli_debug_v1_debug_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *DebugRequest) String() string {
+func (x *DebugResultRecord) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DebugRequest) ProtoMessage() {}
+func (*DebugResultRecord) ProtoMessage() {}
 
-func (x *DebugRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_cc_arduino_cli_debug_v1_debug_proto_msgTypes[0]
+func (x *DebugResultRecord) ProtoReflect() protoreflect.Message {
+	mi := &file_cc_arduino_cli_debug_v1_debug_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -83,75 +905,60 @@ func (x *DebugRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DebugRequest.ProtoReflect.Descriptor instead.
-func (*DebugRequest) Descriptor() ([]byte, []int) {
-	return file_cc_arduino_cli_debug_v1_debug_proto_rawDescGZIP(), []int{0}
+// Deprecated: Use DebugResultRecord.ProtoReflect.Descriptor instead.
+func (*DebugResultRecord) Descriptor() ([]byte, []int) {
+	return file_cc_arduino_cli_debug_v1_debug_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *DebugRequest) GetDebugRequest() *DebugConfigRequest {
+func (x *DebugResultRecord) GetCommandId() uint32 {
 	if x != nil {
-		return x.DebugRequest
+		return x.CommandId
 	}
-	return nil
+	return 0
 }
 
-func (x *DebugRequest) GetData() []byte {
+func (x *DebugResultRecord) GetResultClass() string {
 	if x != nil {
-		return x.Data
+		return x.ResultClass
 	}
-	return nil
+	return ""
 }
 
-func (x *DebugRequest) GetSendInterrupt() bool {
+func (x *DebugResultRecord) GetResults() *structpb.Struct {
 	if x != nil {
-		return x.SendInterrupt
+		return x.Results
 	}
-	return false
+	return nil
 }
 
-type DebugConfigRequest struct {
+type DebugAsyncRecord struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Arduino Core Service instance from the `Init` response.
-	Instance *v1.Instance `protobuf:"bytes,1,opt,name=instance,proto3" json:"instance,omitempty"`
-	// Fully qualified board name of the board in use
-	// (e.g., `arduino:samd:mkr1000`). If this is omitted, the FQBN attached to
-	// the sketch will be used.
-	Fqbn string `protobuf:"bytes,2,opt,name=fqbn,proto3" json:"fqbn,omitempty"`
-	// Path to the sketch that is running on the board. The compiled executable
-	// is expected to be located under this path.
-	SketchPath string `protobuf:"bytes,3,opt,name=sketch_path,json=sketchPath,proto3" json:"sketch_path,omitempty"`
-	// Port of the debugger (optional).
-	Port *v1.Port `protobuf:"bytes,4,opt,name=port,proto3" json:"port,omitempty"`
-	// Which GDB command interpreter to use.
-	Interpreter string `protobuf:"bytes,5,opt,name=interpreter,proto3" json:"interpreter,omitempty"`
-	// Directory containing the compiled executable. If `import_dir` is not
-	// specified, the executable is assumed to be in
-	// `{sketch_path}/build/{fqbn}/`.
-	ImportDir string `protobuf:"bytes,8,opt,name=import_dir,json=importDir,proto3" json:"import_dir,omitempty"`
-	// The programmer to use for debugging.
-	Programmer string `protobuf:"bytes,9,opt,name=programmer,proto3" json:"programmer,omitempty"`
+	// The async class, for example `stopped`.
+	AsyncClass string `protobuf:"bytes,1,opt,name=async_class,json=asyncClass,proto3" json:"async_class,omitempty"`
+	// The results attached to the record.
+	Results *structpb.Struct `protobuf:"bytes,2,opt,name=results,proto3" json:"results,omitempty"`
 }
 
-func (x *DebugConfigRequest) Reset() {
-	*x = DebugConfigRequest{}
+func (x *DebugAsyncRecord) Reset() {
+	*x = DebugAsyncRecord{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cc_arduino_cli_debug_v1_debug_proto_msgTypes[1]
+		mi := &file_cc_arduino_cli_debug_v1_debug_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *DebugConfigRequest) String() string {
+func (x *DebugAsyncRecord) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DebugConfigRequest) ProtoMessage() {}
+func (*DebugAsyncRecord) ProtoMessage() {}
 
-func (x *DebugConfigRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_cc_arduino_cli_debug_v1_debug_proto_msgTypes[1]
+func (x *DebugAsyncRecord) ProtoReflect() protoreflect.Message {
+	mi := &file_cc_arduino_cli_debug_v1_debug_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -162,89 +969,53 @@ func (x *DebugConfigRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DebugConfigRequest.ProtoReflect.Descriptor instead.
-func (*DebugConfigRequest) Descriptor() ([]byte, []int) {
-	return file_cc_arduino_cli_debug_v1_debug_proto_rawDescGZIP(), []int{1}
-}
-
-func (x *DebugConfigRequest) GetInstance() *v1.Instance {
-	if x != nil {
-		return x.Instance
-	}
-	return nil
-}
-
-func (x *DebugConfigRequest) GetFqbn() string {
-	if x != nil {
-		return x.Fqbn
-	}
-	return ""
+// Deprecated: Use DebugAsyncRecord.ProtoReflect.Descriptor instead.
+func (*DebugAsyncRecord) Descriptor() ([]byte, []int) {
+	return file_cc_arduino_cli_debug_v1_debug_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *DebugConfigRequest) GetSketchPath() string {
+func (x *DebugAsyncRecord) GetAsyncClass() string {
 	if x != nil {
-		return x.SketchPath
+		return x.AsyncClass
 	}
 	return ""
 }
 
-func (x *DebugConfigRequest) GetPort() *v1.Port {
+func (x *DebugAsyncRecord) GetResults() *structpb.Struct {
 	if x != nil {
-		return x.Port
+		return x.Results
 	}
 	return nil
 }
 
-func (x *DebugConfigRequest) GetInterpreter() string {
-	if x != nil {
-		return x.Interpreter
-	}
-	return ""
-}
-
-func (x *DebugConfigRequest) GetImportDir() string {
-	if x != nil {
-		return x.ImportDir
-	}
-	return ""
-}
-
-func (x *DebugConfigRequest) GetProgrammer() string {
-	if x != nil {
-		return x.Programmer
-	}
-	return ""
-}
-
-//
-type DebugResponse struct {
+type DebugStreamRecord struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Incoming data from the debugger tool.
-	Data []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
-	// Incoming error output from the debugger tool.
-	Error string `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	// The source of the output.
+	Type DebugStreamType `protobuf:"varint,1,opt,name=type,proto3,enum=cc.arduino.cli.debug.v1.DebugStreamType" json:"type,omitempty"`
+	// The output text.
+	Text string `protobuf:"bytes,2,opt,name=text,proto3" json:"text,omitempty"`
 }
 
-func (x *DebugResponse) Reset() {
-	*x = DebugResponse{}
+func (x *DebugStreamRecord) Reset() {
+	*x = DebugStreamRecord{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cc_arduino_cli_debug_v1_debug_proto_msgTypes[2]
+		mi := &file_cc_arduino_cli_debug_v1_debug_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *DebugResponse) String() string {
+func (x *DebugStreamRecord) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DebugResponse) ProtoMessage() {}
+func (*DebugStreamRecord) ProtoMessage() {}
 
-func (x *DebugResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_cc_arduino_cli_debug_v1_debug_proto_msgTypes[2]
+func (x *DebugStreamRecord) ProtoReflect() protoreflect.Message {
+	mi := &file_cc_arduino_cli_debug_v1_debug_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -255,21 +1026,21 @@ func (x *DebugResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DebugResponse.ProtoReflect.Descriptor instead.
-func (*DebugResponse) Descriptor() ([]byte, []int) {
-	return file_cc_arduino_cli_debug_v1_debug_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use DebugStreamRecord.ProtoReflect.Descriptor instead.
+func (*DebugStreamRecord) Descriptor() ([]byte, []int) {
+	return file_cc_arduino_cli_debug_v1_debug_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *DebugResponse) GetData() []byte {
+func (x *DebugStreamRecord) GetType() DebugStreamType {
 	if x != nil {
-		return x.Data
+		return x.Type
 	}
-	return nil
+	return DebugStreamType_DEBUG_STREAM_TYPE_UNSPECIFIED
 }
 
-func (x *DebugResponse) GetError() string {
+func (x *DebugStreamRecord) GetText() string {
 	if x != nil {
-		return x.Error
+		return x.Text
 	}
 	return ""
 }
@@ -296,12 +1067,21 @@ type GetDebugConfigResponse struct {
 	ToolchainConfiguration map[string]string `protobuf:"bytes,7,rep,name=toolchain_configuration,json=toolchainConfiguration,proto3" json:"toolchain_configuration,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 	// Extra configuration parameters wrt GDB server
 	ServerConfiguration map[string]string `protobuf:"bytes,8,rep,name=server_configuration,json=serverConfiguration,proto3" json:"server_configuration,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// The command line to start the GDB server. It's empty if the server is
+	// started by the debugger client itself (for example through a pipe).
+	ServerCommandLine []string `protobuf:"bytes,9,rep,name=server_command_line,json=serverCommandLine,proto3" json:"server_command_line,omitempty"`
+	// The address where the GDB server listens for the debugger client (for
+	// example "localhost:3333"), empty if the server is started by the client.
+	ServerAddress string `protobuf:"bytes,10,opt,name=server_address,json=serverAddress,proto3" json:"server_address,omitempty"`
+	// The command line to start the debugger client with the requested
+	// interpreter.
+	ClientCommandLine []string `protobuf:"bytes,11,rep,name=client_command_line,json=clientCommandLine,proto3" json:"client_command_line,omitempty"`
 }
 
 func (x *GetDebugConfigResponse) Reset() {
 	*x = GetDebugConfigResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cc_arduino_cli_debug_v1_debug_proto_msgTypes[3]
+		mi := &file_cc_arduino_cli_debug_v1_debug_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -314,7 +1094,7 @@ func (x *GetDebugConfigResponse) String() string {
 func (*GetDebugConfigResponse) ProtoMessage() {}
 
 func (x *GetDebugConfigResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_cc_arduino_cli_debug_v1_debug_proto_msgTypes[3]
+	mi := &file_cc_arduino_cli_debug_v1_debug_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -327,7 +1107,7 @@ func (x *GetDebugConfigResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetDebugConfigResponse.ProtoReflect.Descriptor instead.
 func (*GetDebugConfigResponse) Descriptor() ([]byte, []int) {
-	return file_cc_arduino_cli_debug_v1_debug_proto_rawDescGZIP(), []int{3}
+	return file_cc_arduino_cli_debug_v1_debug_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *GetDebugConfigResponse) GetExecutable() string {
@@ -386,6 +1166,27 @@ func (x *GetDebugConfigResponse) GetServerConfiguration() map[string]string {
 	return nil
 }
 
+func (x *GetDebugConfigResponse) GetServerCommandLine() []string {
+	if x != nil {
+		return x.ServerCommandLine
+	}
+	return nil
+}
+
+func (x *GetDebugConfigResponse) GetServerAddress() string {
+	if x != nil {
+		return x.ServerAddress
+	}
+	return ""
+}
+
+func (x *GetDebugConfigResponse) GetClientCommandLine() []string {
+	if x != nil {
+		return x.ClientCommandLine
+	}
+	return nil
+}
+
 var File_cc_arduino_cli_debug_v1_debug_proto protoreflect.FileDescriptor
 
 var file_cc_arduino_cli_debug_v1_debug_proto_rawDesc = []byte{
@@ -397,70 +1198,181 @@ var file_cc_arduino_cli_debug_v1_debug_proto_rawDesc = []byte{
 	0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f,
 	0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x25, 0x63, 0x63, 0x2f, 0x61, 0x72, 0x64, 0x75,
 	0x69, 0x6e, 0x6f, 0x2f, 0x63, 0x6c, 0x69, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73,
-	0x2f, 0x76, 0x31, 0x2f, 0x70, 0x6f, 0x72, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x9b,
-	0x01, 0x0a, 0x0c, 0x44, 0x65, 0x62, 0x75, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x50, 0x0a, 0x0d, 0x64, 0x65, 0x62, 0x75, 0x67, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75,
-	0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x64, 0x65, 0x62, 0x75, 0x67, 0x2e, 0x76, 0x31,
-	0x2e, 0x44, 0x65, 0x62, 0x75, 0x67, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x52, 0x0c, 0x64, 0x65, 0x62, 0x75, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52,
-	0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x65, 0x6e, 0x64, 0x5f, 0x69, 0x6e,
-	0x74, 0x65, 0x72, 0x72, 0x75, 0x70, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x73,
-	0x65, 0x6e, 0x64, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x72, 0x75, 0x70, 0x74, 0x22, 0xa2, 0x02, 0x0a,
-	0x12, 0x44, 0x65, 0x62, 0x75, 0x67, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x40, 0x0a, 0x08, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69,
-	0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2e,
-	0x76, 0x31, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x08, 0x69, 0x6e, 0x73,
-	0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x71, 0x62, 0x6e, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x04, 0x66, 0x71, 0x62, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x6b, 0x65,
-	0x74, 0x63, 0x68, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
-	0x73, 0x6b, 0x65, 0x74, 0x63, 0x68, 0x50, 0x61, 0x74, 0x68, 0x12, 0x34, 0x0a, 0x04, 0x70, 0x6f,
-	0x72, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72,
-	0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e,
-	0x64, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6f, 0x72, 0x74, 0x52, 0x04, 0x70, 0x6f, 0x72, 0x74,
-	0x12, 0x20, 0x0a, 0x0b, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x70, 0x72, 0x65, 0x74, 0x65, 0x72, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x70, 0x72, 0x65, 0x74,
-	0x65, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x64, 0x69, 0x72,
-	0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x44, 0x69,
-	0x72, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x61, 0x6d, 0x6d, 0x65, 0x72, 0x18,
-	0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x61, 0x6d, 0x6d, 0x65,
-	0x72, 0x22, 0x39, 0x0a, 0x0d, 0x44, 0x65, 0x62, 0x75, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c,
-	0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0xf8, 0x04, 0x0a,
-	0x16, 0x47, 0x65, 0x74, 0x44, 0x65, 0x62, 0x75, 0x67, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x78, 0x65, 0x63, 0x75,
-	0x74, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x78, 0x65,
-	0x63, 0x75, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x6f, 0x6f, 0x6c, 0x63,
-	0x68, 0x61, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x6f, 0x6f, 0x6c,
-	0x63, 0x68, 0x61, 0x69, 0x6e, 0x12, 0x25, 0x0a, 0x0e, 0x74, 0x6f, 0x6f, 0x6c, 0x63, 0x68, 0x61,
-	0x69, 0x6e, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x74,
-	0x6f, 0x6f, 0x6c, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x50, 0x61, 0x74, 0x68, 0x12, 0x29, 0x0a, 0x10,
-	0x74, 0x6f, 0x6f, 0x6c, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x74, 0x6f, 0x6f, 0x6c, 0x63, 0x68, 0x61, 0x69,
-	0x6e, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x72, 0x76, 0x65,
-	0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x12,
-	0x1f, 0x0a, 0x0b, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x06,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x50, 0x61, 0x74, 0x68,
-	0x12, 0x84, 0x01, 0x0a, 0x17, 0x74, 0x6f, 0x6f, 0x6c, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x63,
-	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x4b, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e,
-	0x63, 0x6c, 0x69, 0x2e, 0x64, 0x65, 0x62, 0x75, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74,
-	0x44, 0x65, 0x62, 0x75, 0x67, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x2e, 0x54, 0x6f, 0x6f, 0x6c, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x43, 0x6f, 0x6e,
-	0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
-	0x16, 0x74, 0x6f, 0x6f, 0x6c, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x7b, 0x0a, 0x14, 0x73, 0x65, 0x72, 0x76, 0x65,
-	0x72, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
-	0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x48, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69,
-	0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x64, 0x65, 0x62, 0x75, 0x67, 0x2e, 0x76, 0x31, 0x2e,
-	0x47, 0x65, 0x74, 0x44, 0x65, 0x62, 0x75, 0x67, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x43, 0x6f, 0x6e,
-	0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
-	0x13, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x49, 0x0a, 0x1b, 0x54, 0x6f, 0x6f, 0x6c, 0x63, 0x68, 0x61, 0x69,
+	0x2f, 0x76, 0x31, 0x2f, 0x70, 0x6f, 0x72, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1c,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f,
+	0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x89, 0x02, 0x0a,
+	0x0c, 0x44, 0x65, 0x62, 0x75, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x50, 0x0a,
+	0x0d, 0x64, 0x65, 0x62, 0x75, 0x67, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e,
+	0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x64, 0x65, 0x62, 0x75, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x44,
+	0x65, 0x62, 0x75, 0x67, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x52, 0x0c, 0x64, 0x65, 0x62, 0x75, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64,
+	0x61, 0x74, 0x61, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x65, 0x6e, 0x64, 0x5f, 0x69, 0x6e, 0x74, 0x65,
+	0x72, 0x72, 0x75, 0x70, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x73, 0x65, 0x6e,
+	0x64, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x72, 0x75, 0x70, 0x74, 0x12, 0x2b, 0x0a, 0x11, 0x73, 0x74,
+	0x72, 0x75, 0x63, 0x74, 0x75, 0x72, 0x65, 0x64, 0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x75, 0x72, 0x65,
+	0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x3f, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x61,
+	0x6e, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72,
+	0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x64, 0x65, 0x62, 0x75, 0x67, 0x2e,
+	0x76, 0x31, 0x2e, 0x44, 0x65, 0x62, 0x75, 0x67, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x52,
+	0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x22, 0xe0, 0x02, 0x0a, 0x0c, 0x44, 0x65, 0x62,
+	0x75, 0x67, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6f, 0x6d,
+	0x6d, 0x61, 0x6e, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x63,
+	0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x49, 0x64, 0x12, 0x58, 0x0a, 0x11, 0x69, 0x6e, 0x73, 0x65,
+	0x72, 0x74, 0x5f, 0x62, 0x72, 0x65, 0x61, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f,
+	0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x64, 0x65, 0x62, 0x75, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e,
+	0x73, 0x65, 0x72, 0x74, 0x42, 0x72, 0x65, 0x61, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x48, 0x00,
+	0x52, 0x10, 0x69, 0x6e, 0x73, 0x65, 0x72, 0x74, 0x42, 0x72, 0x65, 0x61, 0x6b, 0x70, 0x6f, 0x69,
+	0x6e, 0x74, 0x12, 0x3f, 0x0a, 0x08, 0x63, 0x6f, 0x6e, 0x74, 0x69, 0x6e, 0x75, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e,
+	0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x64, 0x65, 0x62, 0x75, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x43,
+	0x6f, 0x6e, 0x74, 0x69, 0x6e, 0x75, 0x65, 0x48, 0x00, 0x52, 0x08, 0x63, 0x6f, 0x6e, 0x74, 0x69,
+	0x6e, 0x75, 0x65, 0x12, 0x46, 0x0a, 0x0b, 0x72, 0x65, 0x61, 0x64, 0x5f, 0x6d, 0x65, 0x6d, 0x6f,
+	0x72, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72,
+	0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x64, 0x65, 0x62, 0x75, 0x67, 0x2e,
+	0x76, 0x31, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x48, 0x00, 0x52,
+	0x0a, 0x72, 0x65, 0x61, 0x64, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x12, 0x43, 0x0a, 0x0a, 0x6d,
+	0x69, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x22, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69,
+	0x2e, 0x64, 0x65, 0x62, 0x75, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x49, 0x43, 0x6f, 0x6d, 0x6d,
+	0x61, 0x6e, 0x64, 0x48, 0x00, 0x52, 0x09, 0x6d, 0x69, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64,
+	0x42, 0x09, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x22, 0x86, 0x01, 0x0a, 0x10,
+	0x49, 0x6e, 0x73, 0x65, 0x72, 0x74, 0x42, 0x72, 0x65, 0x61, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74,
+	0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09,
+	0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x65,
+	0x6d, 0x70, 0x6f, 0x72, 0x61, 0x72, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x74,
+	0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61, 0x72, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x68, 0x61, 0x72, 0x64,
+	0x77, 0x61, 0x72, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x68, 0x61, 0x72, 0x64,
+	0x77, 0x61, 0x72, 0x65, 0x22, 0x2b, 0x0a, 0x08, 0x43, 0x6f, 0x6e, 0x74, 0x69, 0x6e, 0x75, 0x65,
+	0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x6c, 0x6c, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x61, 0x6c, 0x6c, 0x54, 0x68, 0x72, 0x65, 0x61, 0x64,
+	0x73, 0x22, 0x54, 0x0a, 0x0a, 0x52, 0x65, 0x61, 0x64, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x12,
+	0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x22, 0x47, 0x0a, 0x09, 0x4d, 0x49, 0x43, 0x6f, 0x6d,
+	0x6d, 0x61, 0x6e, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x72, 0x67, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x61, 0x72, 0x67, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x73,
+	0x22, 0xa2, 0x02, 0x0a, 0x12, 0x44, 0x65, 0x62, 0x75, 0x67, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x40, 0x0a, 0x08, 0x69, 0x6e, 0x73, 0x74, 0x61,
+	0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x63, 0x63, 0x2e, 0x61,
+	0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x61,
+	0x6e, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52,
+	0x08, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x71, 0x62,
+	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x66, 0x71, 0x62, 0x6e, 0x12, 0x1f, 0x0a,
+	0x0b, 0x73, 0x6b, 0x65, 0x74, 0x63, 0x68, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x73, 0x6b, 0x65, 0x74, 0x63, 0x68, 0x50, 0x61, 0x74, 0x68, 0x12, 0x34,
+	0x0a, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x63,
+	0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x63, 0x6f,
+	0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6f, 0x72, 0x74, 0x52, 0x04,
+	0x70, 0x6f, 0x72, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x70, 0x72, 0x65,
+	0x74, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x69, 0x6e, 0x74, 0x65, 0x72,
+	0x70, 0x72, 0x65, 0x74, 0x65, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74,
+	0x5f, 0x64, 0x69, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x69, 0x6d, 0x70, 0x6f,
+	0x72, 0x74, 0x44, 0x69, 0x72, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x61, 0x6d,
+	0x6d, 0x65, 0x72, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x67, 0x72,
+	0x61, 0x6d, 0x6d, 0x65, 0x72, 0x22, 0x74, 0x0a, 0x0d, 0x44, 0x65, 0x62, 0x75, 0x67, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x12, 0x39, 0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x23, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69,
+	0x2e, 0x64, 0x65, 0x62, 0x75, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x62, 0x75, 0x67, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x52, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x22, 0x8d, 0x03, 0x0a, 0x0a,
+	0x44, 0x65, 0x62, 0x75, 0x67, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x44, 0x0a, 0x06, 0x72, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x63, 0x63, 0x2e,
+	0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x64, 0x65, 0x62, 0x75,
+	0x67, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x62, 0x75, 0x67, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x48, 0x00, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x12, 0x4a, 0x0a, 0x0a, 0x65, 0x78, 0x65, 0x63, 0x5f, 0x61, 0x73, 0x79, 0x6e, 0x63, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e,
+	0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x64, 0x65, 0x62, 0x75, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x44,
+	0x65, 0x62, 0x75, 0x67, 0x41, 0x73, 0x79, 0x6e, 0x63, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x48,
+	0x00, 0x52, 0x09, 0x65, 0x78, 0x65, 0x63, 0x41, 0x73, 0x79, 0x6e, 0x63, 0x12, 0x4e, 0x0a, 0x0c,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x61, 0x73, 0x79, 0x6e, 0x63, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x29, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e,
+	0x63, 0x6c, 0x69, 0x2e, 0x64, 0x65, 0x62, 0x75, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x62,
+	0x75, 0x67, 0x41, 0x73, 0x79, 0x6e, 0x63, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x48, 0x00, 0x52,
+	0x0b, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x41, 0x73, 0x79, 0x6e, 0x63, 0x12, 0x4e, 0x0a, 0x0c,
+	0x6e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x5f, 0x61, 0x73, 0x79, 0x6e, 0x63, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x29, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e,
+	0x63, 0x6c, 0x69, 0x2e, 0x64, 0x65, 0x62, 0x75, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x62,
+	0x75, 0x67, 0x41, 0x73, 0x79, 0x6e, 0x63, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x48, 0x00, 0x52,
+	0x0b, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x41, 0x73, 0x79, 0x6e, 0x63, 0x12, 0x44, 0x0a, 0x06,
+	0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x63,
+	0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x64, 0x65,
+	0x62, 0x75, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x62, 0x75, 0x67, 0x53, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x48, 0x00, 0x52, 0x06, 0x73, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x42, 0x07, 0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x22, 0x88, 0x01, 0x0a, 0x11,
+	0x44, 0x65, 0x62, 0x75, 0x67, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x72,
+	0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x49, 0x64,
+	0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x5f, 0x63, 0x6c, 0x61, 0x73, 0x73,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x43, 0x6c,
+	0x61, 0x73, 0x73, 0x12, 0x31, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x07, 0x72,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x22, 0x66, 0x0a, 0x10, 0x44, 0x65, 0x62, 0x75, 0x67, 0x41,
+	0x73, 0x79, 0x6e, 0x63, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x73,
+	0x79, 0x6e, 0x63, 0x5f, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x61, 0x73, 0x79, 0x6e, 0x63, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x12, 0x31, 0x0a, 0x07, 0x72,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53,
+	0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x22, 0x65,
+	0x0a, 0x11, 0x44, 0x65, 0x62, 0x75, 0x67, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x63,
+	0x6f, 0x72, 0x64, 0x12, 0x3c, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x28, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63,
+	0x6c, 0x69, 0x2e, 0x64, 0x65, 0x62, 0x75, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x62, 0x75,
+	0x67, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70,
+	0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x78, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x74, 0x65, 0x78, 0x74, 0x22, 0xff, 0x05, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x44, 0x65, 0x62,
+	0x75, 0x67, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x61, 0x62, 0x6c, 0x65,
+	0x12, 0x1c, 0x0a, 0x09, 0x74, 0x6f, 0x6f, 0x6c, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x6f, 0x6f, 0x6c, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x12, 0x25,
+	0x0a, 0x0e, 0x74, 0x6f, 0x6f, 0x6c, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x70, 0x61, 0x74, 0x68,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x74, 0x6f, 0x6f, 0x6c, 0x63, 0x68, 0x61, 0x69,
+	0x6e, 0x50, 0x61, 0x74, 0x68, 0x12, 0x29, 0x0a, 0x10, 0x74, 0x6f, 0x6f, 0x6c, 0x63, 0x68, 0x61,
+	0x69, 0x6e, 0x5f, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0f, 0x74, 0x6f, 0x6f, 0x6c, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78,
+	0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x50, 0x61, 0x74, 0x68, 0x12, 0x84, 0x01, 0x0a, 0x17, 0x74, 0x6f,
+	0x6f, 0x6c, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x4b, 0x2e, 0x63, 0x63,
+	0x2e, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x64, 0x65, 0x62,
+	0x75, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x65, 0x62, 0x75, 0x67, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x54, 0x6f, 0x6f,
+	0x6c, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x16, 0x74, 0x6f, 0x6f, 0x6c, 0x63, 0x68,
+	0x61, 0x69, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x7b, 0x0a, 0x14, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x48,
+	0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e,
+	0x64, 0x65, 0x62, 0x75, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x65, 0x62, 0x75,
+	0x67, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e,
+	0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x13, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2e, 0x0a,
+	0x13, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x5f,
+	0x6c, 0x69, 0x6e, 0x65, 0x18, 0x09, 0x20, 0x03, 0x28, 0x09, 0x52, 0x11, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x4c, 0x69, 0x6e, 0x65, 0x12, 0x25, 0x0a,
+	0x0e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x41, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x12, 0x2e, 0x0a, 0x13, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x63,
+	0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x5f, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x0b, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x11, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64,
+	0x4c, 0x69, 0x6e, 0x65, 0x1a, 0x49, 0x0a, 0x1b, 0x54, 0x6f, 0x6f, 0x6c, 0x63, 0x68, 0x61, 0x69,
 	0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x6e,
 	0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
 	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
@@ -469,26 +1381,35 @@ var file_cc_arduino_cli_debug_v1_debug_proto_rawDesc = []byte{
 	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b,
 	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a,
 	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61,
-	0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x32, 0xde, 0x01, 0x0a, 0x0c, 0x44, 0x65, 0x62, 0x75,
-	0x67, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x5c, 0x0a, 0x05, 0x44, 0x65, 0x62, 0x75,
-	0x67, 0x12, 0x25, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63,
-	0x6c, 0x69, 0x2e, 0x64, 0x65, 0x62, 0x75, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x62, 0x75,
-	0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72,
-	0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x64, 0x65, 0x62, 0x75, 0x67, 0x2e,
-	0x76, 0x31, 0x2e, 0x44, 0x65, 0x62, 0x75, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x00, 0x28, 0x01, 0x30, 0x01, 0x12, 0x70, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x44, 0x65, 0x62,
-	0x75, 0x67, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x2b, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72,
-	0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x64, 0x65, 0x62, 0x75, 0x67, 0x2e,
-	0x76, 0x31, 0x2e, 0x44, 0x65, 0x62, 0x75, 0x67, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69,
-	0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x64, 0x65, 0x62, 0x75, 0x67, 0x2e, 0x76, 0x31, 0x2e,
-	0x47, 0x65, 0x74, 0x44, 0x65, 0x62, 0x75, 0x67, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x42, 0x5a, 0x40, 0x67, 0x69, 0x74, 0x68,
-	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2f, 0x61,
-	0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2d, 0x63, 0x6c, 0x69, 0x2f, 0x72, 0x70, 0x63, 0x2f, 0x63,
-	0x63, 0x2f, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2f, 0x63, 0x6c, 0x69, 0x2f, 0x64, 0x65,
-	0x62, 0x75, 0x67, 0x2f, 0x76, 0x31, 0x3b, 0x64, 0x65, 0x62, 0x75, 0x67, 0x62, 0x06, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x33,
+	0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x2a, 0x8c, 0x01, 0x0a, 0x0f, 0x44, 0x65, 0x62, 0x75,
+	0x67, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x54, 0x79, 0x70, 0x65, 0x12, 0x21, 0x0a, 0x1d, 0x44,
+	0x45, 0x42, 0x55, 0x47, 0x5f, 0x53, 0x54, 0x52, 0x45, 0x41, 0x4d, 0x5f, 0x54, 0x59, 0x50, 0x45,
+	0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1d,
+	0x0a, 0x19, 0x44, 0x45, 0x42, 0x55, 0x47, 0x5f, 0x53, 0x54, 0x52, 0x45, 0x41, 0x4d, 0x5f, 0x54,
+	0x59, 0x50, 0x45, 0x5f, 0x43, 0x4f, 0x4e, 0x53, 0x4f, 0x4c, 0x45, 0x10, 0x01, 0x12, 0x1c, 0x0a,
+	0x18, 0x44, 0x45, 0x42, 0x55, 0x47, 0x5f, 0x53, 0x54, 0x52, 0x45, 0x41, 0x4d, 0x5f, 0x54, 0x59,
+	0x50, 0x45, 0x5f, 0x54, 0x41, 0x52, 0x47, 0x45, 0x54, 0x10, 0x02, 0x12, 0x19, 0x0a, 0x15, 0x44,
+	0x45, 0x42, 0x55, 0x47, 0x5f, 0x53, 0x54, 0x52, 0x45, 0x41, 0x4d, 0x5f, 0x54, 0x59, 0x50, 0x45,
+	0x5f, 0x4c, 0x4f, 0x47, 0x10, 0x03, 0x32, 0xde, 0x01, 0x0a, 0x0c, 0x44, 0x65, 0x62, 0x75, 0x67,
+	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x5c, 0x0a, 0x05, 0x44, 0x65, 0x62, 0x75, 0x67,
+	0x12, 0x25, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c,
+	0x69, 0x2e, 0x64, 0x65, 0x62, 0x75, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x62, 0x75, 0x67,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64,
+	0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x64, 0x65, 0x62, 0x75, 0x67, 0x2e, 0x76,
+	0x31, 0x2e, 0x44, 0x65, 0x62, 0x75, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x28, 0x01, 0x30, 0x01, 0x12, 0x70, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x44, 0x65, 0x62, 0x75,
+	0x67, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x2b, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64,
+	0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x64, 0x65, 0x62, 0x75, 0x67, 0x2e, 0x76,
+	0x31, 0x2e, 0x44, 0x65, 0x62, 0x75, 0x67, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e,
+	0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x64, 0x65, 0x62, 0x75, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x47,
+	0x65, 0x74, 0x44, 0x65, 0x62, 0x75, 0x67, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x42, 0x5a, 0x40, 0x67, 0x69, 0x74, 0x68, 0x75,
+	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2f, 0x61, 0x72,
+	0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2d, 0x63, 0x6c, 0x69, 0x2f, 0x72, 0x70, 0x63, 0x2f, 0x63, 0x63,
+	0x2f, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2f, 0x63, 0x6c, 0x69, 0x2f, 0x64, 0x65, 0x62,
+	0x75, 0x67, 0x2f, 0x76, 0x31, 0x3b, 0x64, 0x65, 0x62, 0x75, 0x67, 0x62, 0x06, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x33,
 }
 
 var (
@@ -503,32 +1424,58 @@ func file_cc_arduino_cli_debug_v1_debug_proto_rawDescGZIP() []byte {
 	return file_cc_arduino_cli_debug_v1_debug_proto_rawDescData
 }
 
-var file_cc_arduino_cli_debug_v1_debug_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_cc_arduino_cli_debug_v1_debug_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_cc_arduino_cli_debug_v1_debug_proto_msgTypes = make([]protoimpl.MessageInfo, 15)
 var file_cc_arduino_cli_debug_v1_debug_proto_goTypes = []interface{}{
-	(*DebugRequest)(nil),           // 0: cc.arduino.cli.debug.v1.DebugRequest
-	(*DebugConfigRequest)(nil),     // 1: cc.arduino.cli.debug.v1.DebugConfigRequest
-	(*DebugResponse)(nil),          // 2: cc.arduino.cli.debug.v1.DebugResponse
-	(*GetDebugConfigResponse)(nil), // 3: cc.arduino.cli.debug.v1.GetDebugConfigResponse
-	nil,                            // 4: cc.arduino.cli.debug.v1.GetDebugConfigResponse.ToolchainConfigurationEntry
-	nil,                            // 5: cc.arduino.cli.debug.v1.GetDebugConfigResponse.ServerConfigurationEntry
-	(*v1.Instance)(nil),            // 6: cc.arduino.cli.commands.v1.Instance
-	(*v1.Port)(nil),                // 7: cc.arduino.cli.commands.v1.Port
+	(DebugStreamType)(0),           // 0: cc.arduino.cli.debug.v1.DebugStreamType
+	(*DebugRequest)(nil),           // 1: cc.arduino.cli.debug.v1.DebugRequest
+	(*DebugCommand)(nil),           // 2: cc.arduino.cli.debug.v1.DebugCommand
+	(*InsertBreakpoint)(nil),       // 3: cc.arduino.cli.debug.v1.InsertBreakpoint
+	(*Continue)(nil),               // 4: cc.arduino.cli.debug.v1.Continue
+	(*ReadMemory)(nil),             // 5: cc.arduino.cli.debug.v1.ReadMemory
+	(*MICommand)(nil),              // 6: cc.arduino.cli.debug.v1.MICommand
+	(*DebugConfigRequest)(nil),     // 7: cc.arduino.cli.debug.v1.DebugConfigRequest
+	(*DebugResponse)(nil),          // 8: cc.arduino.cli.debug.v1.DebugResponse
+	(*DebugEvent)(nil),             // 9: cc.arduino.cli.debug.v1.DebugEvent
+	(*DebugResultRecord)(nil),      // 10: cc.arduino.cli.debug.v1.DebugResultRecord
+	(*DebugAsyncRecord)(nil),       // 11: cc.arduino.cli.debug.v1.DebugAsyncRecord
+	(*DebugStreamRecord)(nil),      // 12: cc.arduino.cli.debug.v1.DebugStreamRecord
+	(*GetDebugConfigResponse)(nil), // 13: cc.arduino.cli.debug.v1.GetDebugConfigResponse
+	nil,                            // 14: cc.arduino.cli.debug.v1.GetDebugConfigResponse.ToolchainConfigurationEntry
+	nil,                            // 15: cc.arduino.cli.debug.v1.GetDebugConfigResponse.ServerConfigurationEntry
+	(*v1.Instance)(nil),            // 16: cc.arduino.cli.commands.v1.Instance
+	(*v1.Port)(nil),                // 17: cc.arduino.cli.commands.v1.Port
+	(*structpb.Struct)(nil),        // 18: google.protobuf.Struct
 }
 var file_cc_arduino_cli_debug_v1_debug_proto_depIdxs = []int32{
-	1, // 0: cc.arduino.cli.debug.v1.DebugRequest.debug_request:type_name -> cc.arduino.cli.debug.v1.DebugConfigRequest
-	6, // 1: cc.arduino.cli.debug.v1.DebugConfigRequest.instance:type_name -> cc.arduino.cli.commands.v1.Instance
-	7, // 2: cc.arduino.cli.debug.v1.DebugConfigRequest.port:type_name -> cc.arduino.cli.commands.v1.Port
-	4, // 3: cc.arduino.cli.debug.v1.GetDebugConfigResponse.toolchain_configuration:type_name -> cc.arduino.cli.debug.v1.GetDebugConfigResponse.ToolchainConfigurationEntry
-	5, // 4: cc.arduino.cli.debug.v1.GetDebugConfigResponse.server_configuration:type_name -> cc.arduino.cli.debug.v1.GetDebugConfigResponse.ServerConfigurationEntry
-	0, // 5: cc.arduino.cli.debug.v1.DebugService.Debug:input_type -> cc.arduino.cli.debug.v1.DebugRequest
-	1, // 6: cc.arduino.cli.debug.v1.DebugService.GetDebugConfig:input_type -> cc.arduino.cli.debug.v1.DebugConfigRequest
-	2, // 7: cc.arduino.cli.debug.v1.DebugService.Debug:output_type -> cc.arduino.cli.debug.v1.DebugResponse
-	3, // 8: cc.arduino.cli.debug.v1.DebugService.GetDebugConfig:output_type -> cc.arduino.cli.debug.v1.GetDebugConfigResponse
-	7, // [7:9] is the sub-list for method output_type
-	5, // [5:7] is the sub-list for method input_type
-	5, // [5:5] is the sub-list for extension type_name
-	5, // [5:5] is the sub-list for extension extendee
-	0, // [0:5] is the sub-list for field type_name
+	7,  // 0: cc.arduino.cli.debug.v1.DebugRequest.debug_request:type_name -> cc.arduino.cli.debug.v1.DebugConfigRequest
+	2,  // 1: cc.arduino.cli.debug.v1.DebugRequest.command:type_name -> cc.arduino.cli.debug.v1.DebugCommand
+	3,  // 2: cc.arduino.cli.debug.v1.DebugCommand.insert_breakpoint:type_name -> cc.arduino.cli.debug.v1.InsertBreakpoint
+	4,  // 3: cc.arduino.cli.debug.v1.DebugCommand.continue:type_name -> cc.arduino.cli.debug.v1.Continue
+	5,  // 4: cc.arduino.cli.debug.v1.DebugCommand.read_memory:type_name -> cc.arduino.cli.debug.v1.ReadMemory
+	6,  // 5: cc.arduino.cli.debug.v1.DebugCommand.mi_command:type_name -> cc.arduino.cli.debug.v1.MICommand
+	16, // 6: cc.arduino.cli.debug.v1.DebugConfigRequest.instance:type_name -> cc.arduino.cli.commands.v1.Instance
+	17, // 7: cc.arduino.cli.debug.v1.DebugConfigRequest.port:type_name -> cc.arduino.cli.commands.v1.Port
+	9,  // 8: cc.arduino.cli.debug.v1.DebugResponse.event:type_name -> cc.arduino.cli.debug.v1.DebugEvent
+	10, // 9: cc.arduino.cli.debug.v1.DebugEvent.result:type_name -> cc.arduino.cli.debug.v1.DebugResultRecord
+	11, // 10: cc.arduino.cli.debug.v1.DebugEvent.exec_async:type_name -> cc.arduino.cli.debug.v1.DebugAsyncRecord
+	11, // 11: cc.arduino.cli.debug.v1.DebugEvent.status_async:type_name -> cc.arduino.cli.debug.v1.DebugAsyncRecord
+	11, // 12: cc.arduino.cli.debug.v1.DebugEvent.notify_async:type_name -> cc.arduino.cli.debug.v1.DebugAsyncRecord
+	12, // 13: cc.arduino.cli.debug.v1.DebugEvent.stream:type_name -> cc.arduino.cli.debug.v1.DebugStreamRecord
+	18, // 14: cc.arduino.cli.debug.v1.DebugResultRecord.results:type_name -> google.protobuf.Struct
+	18, // 15: cc.arduino.cli.debug.v1.DebugAsyncRecord.results:type_name -> google.protobuf.Struct
+	0,  // 16: cc.arduino.cli.debug.v1.DebugStreamRecord.type:type_name -> cc.arduino.cli.debug.v1.DebugStreamType
+	14, // 17: cc.arduino.cli.debug.v1.GetDebugConfigResponse.toolchain_configuration:type_name -> cc.arduino.cli.debug.v1.GetDebugConfigResponse.ToolchainConfigurationEntry
+	15, // 18: cc.arduino.cli.debug.v1.GetDebugConfigResponse.server_configuration:type_name -> cc.arduino.cli.debug.v1.GetDebugConfigResponse.ServerConfigurationEntry
+	1,  // 19: cc.arduino.cli.debug.v1.DebugService.Debug:input_type -> cc.arduino.cli.debug.v1.DebugRequest
+	7,  // 20: cc.arduino.cli.debug.v1.DebugService.GetDebugConfig:input_type -> cc.arduino.cli.debug.v1.DebugConfigRequest
+	8,  // 21: cc.arduino.cli.debug.v1.DebugService.Debug:output_type -> cc.arduino.cli.debug.v1.DebugResponse
+	13, // 22: cc.arduino.cli.debug.v1.DebugService.GetDebugConfig:output_type -> cc.arduino.cli.debug.v1.GetDebugConfigResponse
+	21, // [21:23] is the sub-list for method output_type
+	19, // [19:21] is the sub-list for method input_type
+	19, // [19:19] is the sub-list for extension type_name
+	19, // [19:19] is the sub-list for extension extendee
+	0,  // [0:19] is the sub-list for field type_name
 }
 
 func init() { file_cc_arduino_cli_debug_v1_debug_proto_init() }
@@ -550,7 +1497,7 @@ func file_cc_arduino_cli_debug_v1_debug_proto_init() {
 			}
 		}
 		file_cc_arduino_cli_debug_v1_debug_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DebugConfigRequest); i {
+			switch v := v.(*DebugCommand); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -562,7 +1509,7 @@ func file_cc_arduino_cli_debug_v1_debug_proto_init() {
 			}
 		}
 		file_cc_arduino_cli_debug_v1_debug_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DebugResponse); i {
+			switch v := v.(*InsertBreakpoint); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -574,6 +1521,114 @@ func file_cc_arduino_cli_debug_v1_debug_proto_init() {
 			}
 		}
 		file_cc_arduino_cli_debug_v1_debug_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Continue); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cc_arduino_cli_debug_v1_debug_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReadMemory); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cc_arduino_cli_debug_v1_debug_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MICommand); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cc_arduino_cli_debug_v1_debug_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DebugConfigRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cc_arduino_cli_debug_v1_debug_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DebugResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cc_arduino_cli_debug_v1_debug_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DebugEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cc_arduino_cli_debug_v1_debug_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DebugResultRecord); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cc_arduino_cli_debug_v1_debug_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DebugAsyncRecord); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cc_arduino_cli_debug_v1_debug_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DebugStreamRecord); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cc_arduino_cli_debug_v1_debug_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetDebugConfigResponse); i {
 			case 0:
 				return &v.state
@@ -586,18 +1641,32 @@ func file_cc_arduino_cli_debug_v1_debug_proto_init() {
 			}
 		}
 	}
+	file_cc_arduino_cli_debug_v1_debug_proto_msgTypes[1].OneofWrappers = []interface{}{
+		(*DebugCommand_InsertBreakpoint)(nil),
+		(*DebugCommand_Continue)(nil),
+		(*DebugCommand_ReadMemory)(nil),
+		(*DebugCommand_MiCommand)(nil),
+	}
+	file_cc_arduino_cli_debug_v1_debug_proto_msgTypes[8].OneofWrappers = []interface{}{
+		(*DebugEvent_Result)(nil),
+		(*DebugEvent_ExecAsync)(nil),
+		(*DebugEvent_StatusAsync)(nil),
+		(*DebugEvent_NotifyAsync)(nil),
+		(*DebugEvent_Stream)(nil),
+	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_cc_arduino_cli_debug_v1_debug_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   6,
+			NumEnums:      1,
+			NumMessages:   15,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_cc_arduino_cli_debug_v1_debug_proto_goTypes,
 		DependencyIndexes: file_cc_arduino_cli_debug_v1_debug_proto_depIdxs,
+		EnumInfos:         file_cc_arduino_cli_debug_v1_debug_proto_enumTypes,
 		MessageInfos:      file_cc_arduino_cli_debug_v1_debug_proto_msgTypes,
 	}.Build()
 	File_cc_arduino_cli_debug_v1_debug_proto = out.File